@@ -0,0 +1,883 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/expiry"
+	"github.com/milonoir/schwer/resource/guard"
+	"github.com/milonoir/schwer/resource/history"
+	"github.com/milonoir/schwer/scenario"
+)
+
+const (
+	apiPrefix = "/api/v1"
+
+	maxBodySize = 1 << 20
+
+	contentTypeJSON = "application/json; charset=utf-8"
+)
+
+// Error codes of the versioned API.
+const (
+	errInvalidJSON      = "invalid_json"
+	errInvalidValue     = "invalid_value"
+	errNotFound         = "not_found"
+	errMethodNotAllowed = "method_not_allowed"
+	errNotSupported     = "not_supported"
+	errGuardrail        = "guardrail"
+	errPaused           = "paused"
+	errInternal         = "internal_error"
+)
+
+// apiError is the error returned by the versioned API in the body of every failed request.
+type apiError struct {
+	status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+// invalidJSON returns an error for a request body which could not be decoded.
+func invalidJSON(err error) *apiError {
+	return &apiError{status: http.StatusBadRequest, Code: errInvalidJSON, Message: err.Error()}
+}
+
+// invalidValue returns an error for a request which was decoded but is not valid.
+func invalidValue(format string, a ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, Code: errInvalidValue, Message: fmt.Sprintf(format, a...)}
+}
+
+// asInvalid turns an error of the controller into an invalid value error, a guardrail error
+// if the update would break a guardrail, or a paused error if the loads are paused.
+func asInvalid(err error) *apiError {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*guard.Error); ok {
+		return &apiError{status: http.StatusConflict, Code: errGuardrail, Message: err.Error()}
+	}
+	if err == errLoadsPaused {
+		return &apiError{status: http.StatusConflict, Code: errPaused, Message: err.Error()}
+	}
+	return invalidValue("%s", err)
+}
+
+// applyFunc applies a decoded and validated request and returns a message describing what
+// was updated.
+type applyFunc func() (string, error)
+
+// decodeFunc decodes and validates a request body, so it can be applied later.
+type decodeFunc func(body []byte) (applyFunc, error)
+
+// apiEndpoint is a resource of the versioned API. Methods which are nil are not allowed.
+type apiEndpoint struct {
+	get    func(r *http.Request) (interface{}, error)
+	post   decodeFunc
+	delete func() string
+}
+
+// ServeHTTP implements http.Handler.
+func (e apiEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && e.get != nil:
+		v, err := e.get(r)
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	case r.Method == http.MethodPost && e.post != nil:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			writeAPIError(w, invalidJSON(err))
+			return
+		}
+		apply, err := e.post(body)
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		msg, err := apply()
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusAccepted, apiMessage{Message: msg})
+	case r.Method == http.MethodDelete && e.delete != nil:
+		writeJSON(w, http.StatusAccepted, apiMessage{Message: e.delete()})
+	default:
+		w.Header().Set("Allow", strings.Join(e.methods(), ", "))
+		writeAPIError(w, &apiError{
+			status:  http.StatusMethodNotAllowed,
+			Code:    errMethodNotAllowed,
+			Message: fmt.Sprintf("method %s is not allowed", r.Method),
+		})
+	}
+}
+
+// methods returns the methods allowed on the endpoint.
+func (e apiEndpoint) methods() []string {
+	var m []string
+	if e.get != nil {
+		m = append(m, http.MethodGet)
+	}
+	if e.post != nil {
+		m = append(m, http.MethodPost)
+	}
+	if e.delete != nil {
+		m = append(m, http.MethodDelete)
+	}
+	return m
+}
+
+// apiMessage is the body of successful updates.
+type apiMessage struct {
+	Message string `json:"message"`
+}
+
+// writeJSON writes v as the JSON body of the response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// writeAPIError writes err as a JSON error. Errors other than apiError are internal errors.
+func writeAPIError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{status: http.StatusInternalServerError, Code: errInternal, Message: err.Error()}
+	}
+	b, _ := json.Marshal(struct {
+		Error *apiError `json:"error"`
+	}{e})
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(e.status)
+	w.Write(b)
+}
+
+// decodeStrict decodes a JSON document into v, rejecting unknown fields and trailing data.
+func decodeStrict(b []byte, v interface{}) error {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return invalidJSON(errors.New("request body is empty"))
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return invalidJSON(err)
+	}
+	if dec.More() {
+		return invalidJSON(errors.New("unexpected data after JSON document"))
+	}
+	return nil
+}
+
+// apiHandler returns the handler of the versioned API.
+func apiHandler(c *Controller) http.Handler {
+	endpoints := apiEndpoints(c)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e, ok := endpoints[strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")]
+		if !ok {
+			writeAPIError(w, &apiError{
+				status:  http.StatusNotFound,
+				Code:    errNotFound,
+				Message: fmt.Sprintf("no such endpoint: %s", r.URL.Path),
+			})
+			return
+		}
+		e.ServeHTTP(w, r)
+	})
+}
+
+// apiEndpoints returns the endpoints of the versioned API by path, relative to apiPrefix.
+func apiEndpoints(c *Controller) map[string]apiEndpoint {
+	return map[string]apiEndpoint{
+		"/cpu": {
+			get:  func(*http.Request) (interface{}, error) { return c.PreciseCPUStatus(), nil },
+			post: c.decodeCPURequest,
+		},
+		"/cpu/profile": {
+			get:    func(*http.Request) (interface{}, error) { return c.CPUProfileStatus(), nil },
+			post:   c.decodeProfileRequest,
+			delete: func() string { c.CancelCPUProfile(); return "CPU load profile cancelled" },
+		},
+		"/mem": {
+			get:  func(*http.Request) (interface{}, error) { return c.PreciseMemStats(), nil },
+			post: c.decodeMemRequest,
+		},
+		"/mem/bandwidth": {
+			get:    func(*http.Request) (interface{}, error) { return c.MemBandwidthStatus(), nil },
+			post:   c.decodeBandwidthRequest,
+			delete: func() string { c.SetMemBandwidth(resource.BandwidthConfig{}); return "Memory bandwidth stopped" },
+		},
+		"/disk": {
+			get:  func(*http.Request) (interface{}, error) { return c.DiskStats(), nil },
+			post: c.decodeDiskRequest,
+		},
+		"/net": {
+			get:  func(*http.Request) (interface{}, error) { return c.NetStats(), nil },
+			post: c.decodeNetRequest,
+		},
+		"/proc": {
+			get:  func(*http.Request) (interface{}, error) { return c.ProcStats(), nil },
+			post: c.decodeProcRequest,
+		},
+		"/loads": {
+			post: c.decodeLoadsRequest,
+		},
+		"/monitor": {
+			get:  func(*http.Request) (interface{}, error) { return c.MonitorStatus(), nil },
+			post: c.decodeMonitorRequest,
+		},
+		"/sample": {
+			get: c.getSample,
+		},
+		"/guard": {
+			get: func(*http.Request) (interface{}, error) { return c.GuardStatus(), nil },
+		},
+		"/stop-all": {
+			post: c.decodeStopAllRequest,
+		},
+		"/pause": {
+			get:    func(*http.Request) (interface{}, error) { return c.PauseStatus(), nil },
+			post:   c.decodePauseRequest,
+			delete: func() string { c.Resume(); return "Load updates resumed" },
+		},
+		"/history": {
+			get: c.getHistory,
+		},
+		"/scenario": {
+			get:    func(*http.Request) (interface{}, error) { return c.ScenarioStatus(), nil },
+			post:   c.decodeScenarioRequest,
+			delete: func() string { c.AbortScenario(); return "Scenario aborted" },
+		},
+	}
+}
+
+// jsonAlias routes requests with a JSON body to api, and everything else to legacy. It
+// lets the form-based endpoints accept the request bodies of the versioned API.
+func jsonAlias(legacy, api http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			api.ServeHTTP(w, r)
+			return
+		}
+		legacy.ServeHTTP(w, r)
+	})
+}
+
+// loadTTL is the time to live of a load update, which is embedded in the requests of the
+// loads.
+type loadTTL struct {
+	TTL    *scenario.Duration `json:"ttl"`
+	Revert string             `json:"revert"`
+}
+
+// ttl validates and returns the time to live of the update. Only the loads which are
+// updated can expire, so a TTL is rejected if none of them is.
+func (t loadTTL) ttl(c *Controller, updated bool) (expiry.TTL, error) {
+	ttl := expiry.TTL{Revert: t.Revert}
+	if t.TTL != nil {
+		ttl.Duration = time.Duration(*t.TTL)
+	}
+	if !updated && (t.TTL != nil || t.Revert != "") {
+		return ttl, invalidValue("ttl and revert require a load to be updated")
+	}
+	if err := c.ValidateTTL(ttl); err != nil {
+		return ttl, asInvalid(err)
+	}
+	return ttl, nil
+}
+
+// cpuRequest updates the CPU load. Pct sets the load of every core, or only of Core if it
+// is given, Pcts sets the load of each core separately.
+type cpuRequest struct {
+	Pct      *int64             `json:"pct"`
+	Core     *int               `json:"core"`
+	Pcts     []int64            `json:"pcts"`
+	Period   *scenario.Duration `json:"period"`
+	Feedback *bool              `json:"feedback"`
+	loadTTL
+}
+
+// decodeCPURequest decodes a cpuRequest.
+func (c *Controller) decodeCPURequest(body []byte) (applyFunc, error) {
+	var req cpuRequest
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateCPURequest(req)
+}
+
+// validateCPURequest validates req and returns the function applying it.
+func (c *Controller) validateCPURequest(req cpuRequest) (applyFunc, error) {
+	switch {
+	case req.Pct == nil && req.Pcts == nil && req.Period == nil && req.Feedback == nil:
+		return nil, invalidValue("one of pct, pcts, period or feedback is required")
+	case req.Pct != nil && req.Pcts != nil:
+		return nil, invalidValue("pct and pcts cannot be combined")
+	case req.Core != nil && req.Pct == nil:
+		return nil, invalidValue("core requires pct")
+	}
+	if req.Pct != nil {
+		if err := validatePct(*req.Pct); err != nil {
+			return nil, asInvalid(err)
+		}
+	}
+	for _, pct := range req.Pcts {
+		if err := validatePct(pct); err != nil {
+			return nil, asInvalid(err)
+		}
+	}
+	if req.Period != nil {
+		if err := c.ValidateCPUPeriod(time.Duration(*req.Period)); err != nil {
+			return nil, asInvalid(err)
+		}
+	}
+	if req.Pcts != nil || req.Core != nil {
+		feedback := c.CPUStatus().Feedback.Enabled
+		if req.Feedback != nil {
+			feedback = *req.Feedback
+		}
+		if err := c.ValidateCPUCores(feedback, req.Core, len(req.Pcts)); err != nil {
+			return nil, asInvalid(err)
+		}
+	}
+	ttl, err := req.ttl(c, req.Pct != nil || req.Pcts != nil)
+	if err != nil {
+		return nil, err
+	}
+	// Checked up front, so a rejected part keeps the rest of a /loads request from being
+	// applied.
+	switch {
+	case req.Pcts != nil:
+		err = c.CheckCPUCoreLoads(req.Pcts)
+	case req.Core != nil:
+		err = c.CheckCPUCoreLoads(c.cpuCoreTargets(*req.Core, *req.Pct))
+	case req.Pct != nil:
+		err = c.CheckCPULoad(*req.Pct)
+	}
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+
+	return func() (string, error) {
+		msg := "CPU load settings updated"
+		update := func() error { return nil }
+		switch {
+		case req.Pcts != nil:
+			update = func() error { return c.UpdateCPUCoreLoads(req.Pcts) }
+			msg = "Per-core CPU load percentages updated"
+		case req.Core != nil:
+			update = func() error { return c.UpdateCPUCoreLoad(*req.Core, *req.Pct) }
+			msg = fmt.Sprintf("CPU load percentage of core %d updated", *req.Core)
+		case req.Pct != nil:
+			update = func() error { return c.UpdateCPULoad(*req.Pct) }
+			msg = "CPU load percentage updated"
+		}
+		var res []string
+		if req.Pct != nil || req.Pcts != nil {
+			res = []string{"cpu"}
+		}
+		// The period and the feedback mode are applied through Timed as well, so they are
+		// rejected while the loads are paused.
+		err := c.Timed(ttl, res, func() error {
+			if req.Period != nil {
+				if err := c.SetCPUPeriod(time.Duration(*req.Period)); err != nil {
+					return err
+				}
+			}
+			if req.Feedback != nil {
+				c.SetCPUFeedback(*req.Feedback)
+			}
+			return update()
+		})
+		if err != nil {
+			return "", asInvalid(err)
+		}
+		return msg, nil
+	}, nil
+}
+
+// profileRequest starts a CPU load profile.
+type profileRequest struct {
+	scenario.ProfileSpec
+	loadTTL
+}
+
+// decodeProfileRequest decodes a profileRequest.
+func (c *Controller) decodeProfileRequest(body []byte) (applyFunc, error) {
+	var req profileRequest
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateProfileRequest(req)
+}
+
+// validateProfileRequest validates req and returns the function applying it.
+func (c *Controller) validateProfileRequest(req profileRequest) (applyFunc, error) {
+	p, err := req.Spec().Build(0, 100)
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	ttl, err := req.ttl(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.Timed(ttl, []string{"cpu"}, func() error { return c.startCPUProfile(p) }); err != nil {
+			return "", asInvalid(err)
+		}
+		return "CPU load profile started", nil
+	}, nil
+}
+
+// memRequest updates the memory load. Size and Leak cannot be combined, Config replaces
+// the current settings.
+type memRequest struct {
+	Size   *int64
+	Leak   *resource.LeakConfig
+	Config *resource.MemConfig
+	loadTTL
+}
+
+// decodeMemRequest decodes a memRequest. The config is applied to the current settings, so
+// only the fields to change need to be given, and a leak grows every second unless told
+// otherwise.
+func (c *Controller) decodeMemRequest(body []byte) (applyFunc, error) {
+	var raw struct {
+		Size   *int64          `json:"size"`
+		Leak   json.RawMessage `json:"leak"`
+		Config json.RawMessage `json:"config"`
+		loadTTL
+	}
+	if err := decodeStrict(body, &raw); err != nil {
+		return nil, err
+	}
+	req := memRequest{Size: raw.Size, loadTTL: raw.loadTTL}
+	if raw.Config != nil {
+		cfg := c.MemLoadConfig()
+		if err := decodeStrict(raw.Config, &cfg); err != nil {
+			return nil, err
+		}
+		req.Config = &cfg
+	}
+	if raw.Leak != nil {
+		leak := resource.LeakConfig{IntervalMs: 1000}
+		if err := decodeStrict(raw.Leak, &leak); err != nil {
+			return nil, err
+		}
+		req.Leak = &leak
+	}
+	return c.validateMemRequest(req)
+}
+
+// validateMemRequest validates req and returns the function applying it.
+func (c *Controller) validateMemRequest(req memRequest) (applyFunc, error) {
+	switch {
+	case req.Size == nil && req.Leak == nil && req.Config == nil:
+		return nil, invalidValue("one of size, leak or config is required")
+	case req.Size != nil && req.Leak != nil:
+		return nil, invalidValue("size and leak cannot be combined")
+	case req.Size != nil && *req.Size < 0:
+		return nil, invalidValue("size must be positive, got: %d", *req.Size)
+	}
+	ttl, err := req.ttl(c, req.Size != nil || req.Leak != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Config != nil {
+		if err := c.ValidateMemConfig(*req.Config); err != nil {
+			return nil, asInvalid(err)
+		}
+	}
+	var (
+		res    []string
+		check  = func() error { return nil }
+		update = func() error { return nil }
+		msg    = "Memory load settings updated"
+	)
+	switch {
+	case req.Leak != nil:
+		leak := *req.Leak
+		if err := c.ValidateMemLeak(leak); err != nil {
+			return nil, asInvalid(err)
+		}
+		res = []string{"mem"}
+		check = func() error { return c.CheckMemLeak(leak) }
+		update = func() error { return c.LeakMemLoad(leak) }
+		msg = "Memory leak updated"
+	case req.Size != nil:
+		res = []string{"mem"}
+		check = func() error { return c.CheckMemLoad(*req.Size) }
+		update = func() error { return c.UpdateMemLoad(*req.Size) }
+		msg = "Memory allocation size updated"
+	}
+	if err := check(); err != nil {
+		return nil, asInvalid(err)
+	}
+
+	return func() (string, error) {
+		err := c.Timed(ttl, res, func() error {
+			// Checked again before the configuration is applied, so a rejected update leaves
+			// the configuration unchanged.
+			if err := check(); err != nil {
+				return err
+			}
+			if req.Config != nil {
+				if err := c.ConfigureMemLoad(*req.Config); err != nil {
+					return err
+				}
+			}
+			return update()
+		})
+		if err != nil {
+			return "", asInvalid(err)
+		}
+		return msg, nil
+	}, nil
+}
+
+// bandwidthRequest starts streaming over the memory load allocation.
+type bandwidthRequest struct {
+	resource.BandwidthConfig
+	loadTTL
+}
+
+// defaultBandwidth is the memory bandwidth mode of requests which do not tell otherwise: a
+// single worker copying memory.
+var defaultBandwidth = resource.BandwidthConfig{Workers: 1, Op: "copy"}
+
+// decodeBandwidthRequest decodes a bandwidthRequest.
+func (c *Controller) decodeBandwidthRequest(body []byte) (applyFunc, error) {
+	req := bandwidthRequest{BandwidthConfig: defaultBandwidth}
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateBandwidthRequest(req)
+}
+
+// validateBandwidthRequest validates req and returns the function applying it.
+func (c *Controller) validateBandwidthRequest(req bandwidthRequest) (applyFunc, error) {
+	ttl, err := req.ttl(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.Timed(ttl, []string{"bandwidth"}, func() error { return c.SetMemBandwidth(req.BandwidthConfig) }); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Memory bandwidth updated", nil
+	}, nil
+}
+
+// diskRequest replaces the disk load configuration.
+type diskRequest struct {
+	resource.DiskConfig
+	loadTTL
+}
+
+// decodeDiskRequest decodes the fields of the disk load configuration to change.
+func (c *Controller) decodeDiskRequest(body []byte) (applyFunc, error) {
+	req := diskRequest{DiskConfig: c.DiskLoadConfig()}
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateDiskRequest(req)
+}
+
+// validateDiskRequest validates req and returns the function applying it.
+func (c *Controller) validateDiskRequest(req diskRequest) (applyFunc, error) {
+	ttl, err := req.ttl(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.Timed(ttl, []string{"disk"}, func() error { return c.ConfigureDiskLoad(req.DiskConfig) }); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Disk load updated", nil
+	}, nil
+}
+
+// netRequest replaces the network load configuration.
+type netRequest struct {
+	resource.NetConfig
+	loadTTL
+}
+
+// decodeNetRequest decodes the fields of the network load configuration to change.
+func (c *Controller) decodeNetRequest(body []byte) (applyFunc, error) {
+	req := netRequest{NetConfig: c.NetLoadConfig()}
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateNetRequest(req)
+}
+
+// validateNetRequest validates req and returns the function applying it.
+func (c *Controller) validateNetRequest(req netRequest) (applyFunc, error) {
+	ttl, err := req.ttl(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.Timed(ttl, []string{"net"}, func() error { return c.ConfigureNetLoad(req.NetConfig) }); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Network load updated", nil
+	}, nil
+}
+
+// procRequest sets the number of resources the process loads should hold, e.g.
+// {"fds": 1000}.
+type procRequest struct {
+	FDs        *int64 `json:"fds"`
+	Threads    *int64 `json:"threads"`
+	Goroutines *int64 `json:"goroutines"`
+	loadTTL
+}
+
+// decodeProcRequest decodes a procRequest.
+func (c *Controller) decodeProcRequest(body []byte) (applyFunc, error) {
+	var req procRequest
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateProcRequest(req)
+}
+
+// validateProcRequest validates req and returns the function applying it.
+func (c *Controller) validateProcRequest(req procRequest) (applyFunc, error) {
+	var (
+		names  []string
+		counts = make(map[string]int64)
+	)
+	for _, p := range []struct {
+		name  string
+		count *int64
+	}{
+		{"fds", req.FDs},
+		{"threads", req.Threads},
+		{"goroutines", req.Goroutines},
+	} {
+		if p.count != nil {
+			names = append(names, p.name)
+			counts[p.name] = *p.count
+		}
+	}
+	if len(counts) == 0 {
+		return nil, invalidValue("one of fds, threads or goroutines is required")
+	}
+	if err := c.ValidateProcLoads(counts); err != nil {
+		return nil, asInvalid(err)
+	}
+	ttl, err := req.ttl(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.Timed(ttl, names, func() error { return c.UpdateProcLoads(counts) }); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Process load updated", nil
+	}, nil
+}
+
+// loadsRequest updates several loads at once, e.g. {"cpu": {"pct": 40}, "mem": {"size": 512}}.
+// Every part is decoded and validated before any of them is applied.
+type loadsRequest struct {
+	CPU  json.RawMessage `json:"cpu"`
+	Mem  json.RawMessage `json:"mem"`
+	Disk json.RawMessage `json:"disk"`
+	Net  json.RawMessage `json:"net"`
+	Proc json.RawMessage `json:"proc"`
+}
+
+// decodeLoadsRequest decodes a loadsRequest.
+func (c *Controller) decodeLoadsRequest(body []byte) (applyFunc, error) {
+	var req loadsRequest
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+
+	parts := []struct {
+		name   string
+		body   json.RawMessage
+		decode decodeFunc
+	}{
+		{"cpu", req.CPU, c.decodeCPURequest},
+		{"mem", req.Mem, c.decodeMemRequest},
+		{"disk", req.Disk, c.decodeDiskRequest},
+		{"net", req.Net, c.decodeNetRequest},
+		{"proc", req.Proc, c.decodeProcRequest},
+	}
+	var (
+		names   []string
+		applies []applyFunc
+	)
+	for _, p := range parts {
+		if p.body == nil {
+			continue
+		}
+		apply, err := p.decode(p.body)
+		if err != nil {
+			return nil, prefixError(p.name, err)
+		}
+		names = append(names, p.name)
+		applies = append(applies, apply)
+	}
+	if len(applies) == 0 {
+		return nil, invalidValue("one of cpu, mem, disk, net or proc is required")
+	}
+
+	return func() (string, error) {
+		for i, apply := range applies {
+			if _, err := apply(); err != nil {
+				return "", prefixError(names[i], err)
+			}
+		}
+		return fmt.Sprintf("Loads updated: %s", strings.Join(names, ", ")), nil
+	}, nil
+}
+
+// prefixError prefixes the message of err with the name of the part of the request it
+// belongs to.
+func prefixError(name string, err error) error {
+	if e, ok := err.(*apiError); ok {
+		return &apiError{status: e.status, Code: e.Code, Message: name + ": " + e.Message}
+	}
+	return fmt.Errorf("%s: %s", name, err)
+}
+
+// decodeMonitorRequest decodes the sampling intervals of monitors, e.g. {"cpu": "250ms"}.
+func (c *Controller) decodeMonitorRequest(body []byte) (applyFunc, error) {
+	var req map[string]scenario.Duration
+	if err := decodeStrict(body, &req); err != nil {
+		return nil, err
+	}
+	return c.validateMonitorRequest(req)
+}
+
+// validateMonitorRequest validates the sampling intervals by resource and returns the
+// function applying them.
+func (c *Controller) validateMonitorRequest(req map[string]scenario.Duration) (applyFunc, error) {
+	if len(req) == 0 {
+		return nil, invalidValue("one of cpu, mem, disk, net or proc is required")
+	}
+	intervals := make(map[string]time.Duration, len(req))
+	for res, d := range req {
+		intervals[res] = time.Duration(d)
+	}
+	return func() (string, error) {
+		if err := c.SetMonitorIntervals(intervals); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Monitor intervals updated", nil
+	}, nil
+}
+
+// decodeStopAllRequest decodes a request to stop every load. The body is empty or {}.
+func (c *Controller) decodeStopAllRequest(body []byte) (applyFunc, error) {
+	if err := decodeEmpty(body); err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		if err := c.StopAll(); err != nil {
+			return "", err
+		}
+		return "All loads stopped and paused", nil
+	}, nil
+}
+
+// decodePauseRequest decodes a request to pause load updates. The body is empty or {}.
+func (c *Controller) decodePauseRequest(body []byte) (applyFunc, error) {
+	if err := decodeEmpty(body); err != nil {
+		return nil, err
+	}
+	return func() (string, error) {
+		c.Pause()
+		return "Load updates paused", nil
+	}, nil
+}
+
+// decodeEmpty accepts an empty body or an empty JSON object.
+func decodeEmpty(body []byte) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	var req struct{}
+	return decodeStrict(body, &req)
+}
+
+// decodeScenarioRequest decodes a YAML or JSON scenario.
+func (c *Controller) decodeScenarioRequest(body []byte) (applyFunc, error) {
+	p, err := scenario.Parse(body)
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	return func() (string, error) {
+		if err := c.RunScenario(p); err != nil {
+			return "", asInvalid(err)
+		}
+		return "Scenario started", nil
+	}, nil
+}
+
+// getSample returns a raw sample of the resource given in the res query parameter.
+func (c *Controller) getSample(r *http.Request) (interface{}, error) {
+	res := r.FormValue("res")
+	switch res {
+	case "cpu", "load", "mem", "disk", "net":
+	default:
+		return nil, invalidValue("res must be one of cpu, load, mem, disk or net, got: %q", res)
+	}
+	sample, err := c.Sample(res)
+	if err == backend.ErrNotSupported {
+		return nil, &apiError{
+			status:  http.StatusNotImplemented,
+			Code:    errNotSupported,
+			Message: fmt.Sprintf("sampling %s is not supported by the backend", res),
+		}
+	}
+	return sample, err
+}
+
+// getHistory returns the history of the resource given in the query parameters.
+func (c *Controller) getHistory(r *http.Request) (interface{}, error) {
+	res, since, step, err := parseHistoryQuery(r)
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	series, err := c.History(res, since, step)
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	return historyResponse{res, step.Seconds(), series}, nil
+}
+
+// historyResponse is the body of history queries.
+type historyResponse struct {
+	Resource string           `json:"resource"`
+	Step     float64          `json:"step"`
+	Series   []history.Series `json:"series"`
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// openAPIPath is the source of the OpenAPI document, which is embedded into the binary by
+// go generate.
+const openAPIPath = "web/api/openapi.json"
+
+// openAPIMethods returns the methods of every path in the OpenAPI document, upper case and
+// sorted.
+func openAPIMethods(t *testing.T, doc []byte) map[string][]string {
+	t.Helper()
+
+	var d struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(doc, &d); err != nil {
+		t.Fatal(err)
+	}
+	paths := make(map[string][]string, len(d.Paths))
+	for path, ops := range d.Paths {
+		for m := range ops {
+			paths[path] = append(paths[path], strings.ToUpper(m))
+		}
+		sort.Strings(paths[path])
+	}
+	return paths
+}
+
+func TestOpenAPIMatchesEndpoints(t *testing.T) {
+	doc, err := ioutil.ReadFile(openAPIPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	documented := openAPIMethods(t, doc)
+
+	endpoints := apiEndpoints(nil)
+	for path, e := range endpoints {
+		want := e.methods()
+		sort.Strings(want)
+		got, ok := documented[apiPrefix+path]
+		if !ok {
+			t.Errorf("%s%s is not documented", apiPrefix, path)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%s%s: documented methods %v, handled methods %v", apiPrefix, path, got, want)
+		}
+	}
+	for path := range documented {
+		if strings.HasPrefix(path, apiPrefix+"/") && endpoints[strings.TrimPrefix(path, apiPrefix)].methods() == nil {
+			t.Errorf("%s is documented, but not handled", path)
+		}
+	}
+}
+
+func TestOpenAPIServed(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+	srv := httptest.NewServer(newServer(0, c, nil).Handler)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/api/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	served, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := ioutil.ReadFile(openAPIPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(served) != string(doc) {
+		t.Errorf("served OpenAPI document differs from %s, run go generate", openAPIPath)
+	}
+}
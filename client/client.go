@@ -0,0 +1,131 @@
+// Package client is a Go client of the versioned Schwer API (/api/v1), described by the
+// OpenAPI document served at /api/openapi.json. The client is written by hand, not generated
+// from the document; the tests of the server check both against the handlers of the API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// apiPrefix is the path of the versioned API.
+const apiPrefix = "/api/v1"
+
+// Error codes returned by the API.
+const (
+	CodeInvalidJSON      = "invalid_json"
+	CodeInvalidValue     = "invalid_value"
+	CodeNotFound         = "not_found"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeNotSupported     = "not_supported"
+	CodeGuardrail        = "guardrail"
+	CodePaused           = "paused"
+	CodeInternal         = "internal_error"
+)
+
+// Error is an error returned by the API.
+type Error struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("schwer: %s (%s)", e.Message, e.Code)
+}
+
+// Client calls the API of a Schwer instance. It is safe for concurrent use.
+type Client struct {
+	base string
+	hc   *http.Client
+}
+
+// New returns a client of the Schwer instance at addr, e.g. "http://localhost:9999". Requests
+// are sent with hc, or http.DefaultClient if it is nil.
+func New(addr string, hc *http.Client) (*Client, error) {
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid address: %q", addr)
+	}
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &Client{
+		base: strings.TrimSuffix(addr, "/") + apiPrefix,
+		hc:   hc,
+	}, nil
+}
+
+// get fetches a resource and decodes it into v.
+func (c *Client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.do(ctx, http.MethodGet, path, nil, v)
+}
+
+// post sends body encoded as JSON to a resource.
+func (c *Client) post(ctx context.Context, path string, body interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPost, path, bytes.NewReader(b), nil)
+}
+
+// delete deletes a resource.
+func (c *Client) delete(ctx context.Context, path string) error {
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// do sends a request and decodes the response into v, unless it is nil. Responses other
+// than 200 and 202 are returned as an *Error.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, v interface{}) error {
+	req, err := http.NewRequest(method, c.base+path, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if v == nil {
+			return nil
+		}
+		return json.NewDecoder(resp.Body).Decode(v)
+	case http.StatusAccepted:
+		return nil
+	}
+
+	b, _ := ioutil.ReadAll(resp.Body)
+	var e struct {
+		Error *Error `json:"error"`
+	}
+	if err := json.Unmarshal(b, &e); err != nil || e.Error == nil {
+		// Not an API error, e.g. a server timeout.
+		msg := strings.TrimSpace(string(b))
+		if msg == "" {
+			msg = resp.Status
+		}
+		return &Error{StatusCode: resp.StatusCode, Code: CodeInternal, Message: msg}
+	}
+	e.Error.StatusCode = resp.StatusCode
+	return e.Error
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/scenario"
+)
+
+// Expiry makes a load update expire: once TTL runs out, the load reverts to zero, or to
+// its state before the update if Revert is "previous". Without a TTL, the maximum TTL of
+// the server applies, if it has one.
+type Expiry struct {
+	TTL    scenario.Duration `json:"ttl,omitempty"`
+	Revert string            `json:"revert,omitempty"`
+}
+
+// CPURequest updates the CPU load. Pct sets the load of every core, or only of Core if it
+// is given, Pcts sets the load of each core separately. Fields which are not set are left
+// unchanged.
+type CPURequest struct {
+	Pct      *int64             `json:"pct,omitempty"`
+	Core     *int               `json:"core,omitempty"`
+	Pcts     []int64            `json:"pcts,omitempty"`
+	Period   *scenario.Duration `json:"period,omitempty"`
+	Feedback *bool              `json:"feedback,omitempty"`
+	Expiry
+}
+
+// ProfileRequest starts a CPU load profile. The TTL of the profile is counted from its
+// start, however its level changes.
+type ProfileRequest struct {
+	scenario.ProfileSpec
+	Expiry
+}
+
+// MemRequest updates the memory load. Size and Leak cannot be combined. Config replaces
+// the allocation settings of the memory load.
+type MemRequest struct {
+	Size   *int64               `json:"size,omitempty"`
+	Leak   *resource.LeakConfig `json:"leak,omitempty"`
+	Config *resource.MemConfig  `json:"config,omitempty"`
+	Expiry
+}
+
+// BandwidthRequest starts streaming over the memory load allocation.
+type BandwidthRequest struct {
+	resource.BandwidthConfig
+	Expiry
+}
+
+// DiskRequest replaces the settings of the disk load.
+type DiskRequest struct {
+	resource.DiskConfig
+	Expiry
+}
+
+// NetRequest replaces the settings of the network load.
+type NetRequest struct {
+	resource.NetConfig
+	Expiry
+}
+
+// ProcRequest sets the number of resources the process loads hold. Loads which are not
+// set are left unchanged.
+type ProcRequest struct {
+	FDs        *int64 `json:"fds,omitempty"`
+	Threads    *int64 `json:"threads,omitempty"`
+	Goroutines *int64 `json:"goroutines,omitempty"`
+	Expiry
+}
+
+// Loads updates several loads at once. Every load which is set is validated before any of
+// them is applied.
+type Loads struct {
+	CPU  *CPURequest  `json:"cpu,omitempty"`
+	Mem  *MemRequest  `json:"mem,omitempty"`
+	Disk *DiskRequest `json:"disk,omitempty"`
+	Net  *NetRequest  `json:"net,omitempty"`
+	Proc *ProcRequest `json:"proc,omitempty"`
+}
+
+// UpdateCPU sends an update to the CPU load.
+func (c *Client) UpdateCPU(ctx context.Context, req CPURequest) error {
+	return c.post(ctx, "/cpu", req)
+}
+
+// SetCPULoad sets the load level of every core in percent.
+func (c *Client) SetCPULoad(ctx context.Context, pct int64) error {
+	return c.UpdateCPU(ctx, CPURequest{Pct: &pct})
+}
+
+// SetCPUCoreLoad sets the load level of a single core in percent (Linux only).
+func (c *Client) SetCPUCoreLoad(ctx context.Context, core int, pct int64) error {
+	return c.UpdateCPU(ctx, CPURequest{Pct: &pct, Core: &core})
+}
+
+// SetCPUCoreLoads sets the load level of each core separately (Linux only).
+func (c *Client) SetCPUCoreLoads(ctx context.Context, pcts []int64) error {
+	return c.UpdateCPU(ctx, CPURequest{Pcts: pcts})
+}
+
+// SetCPUPeriod sets the duty cycle period of the CPU load workers.
+func (c *Client) SetCPUPeriod(ctx context.Context, period time.Duration) error {
+	d := scenario.Duration(period)
+	return c.UpdateCPU(ctx, CPURequest{Period: &d})
+}
+
+// SetCPUFeedback turns feedback mode on or off.
+func (c *Client) SetCPUFeedback(ctx context.Context, enabled bool) error {
+	return c.UpdateCPU(ctx, CPURequest{Feedback: &enabled})
+}
+
+// StartCPUProfile starts driving the CPU load through a time-based profile.
+func (c *Client) StartCPUProfile(ctx context.Context, spec scenario.ProfileSpec) error {
+	return c.RunCPUProfile(ctx, ProfileRequest{ProfileSpec: spec})
+}
+
+// RunCPUProfile starts a CPU load profile.
+func (c *Client) RunCPUProfile(ctx context.Context, req ProfileRequest) error {
+	return c.post(ctx, "/cpu/profile", req)
+}
+
+// CancelCPUProfile cancels the running CPU load profile, leaving the load at its last level.
+func (c *Client) CancelCPUProfile(ctx context.Context) error {
+	return c.delete(ctx, "/cpu/profile")
+}
+
+// UpdateMem sends an update to the memory load.
+func (c *Client) UpdateMem(ctx context.Context, req MemRequest) error {
+	return c.post(ctx, "/mem", req)
+}
+
+// SetMemLoad sets the size of the memory load in MB.
+func (c *Client) SetMemLoad(ctx context.Context, size int64) error {
+	return c.UpdateMem(ctx, MemRequest{Size: &size})
+}
+
+// LeakMem grows the memory load at a steady rate.
+func (c *Client) LeakMem(ctx context.Context, cfg resource.LeakConfig) error {
+	return c.UpdateMem(ctx, MemRequest{Leak: &cfg})
+}
+
+// ConfigureMemLoad sets how the memory load allocates and uses memory.
+func (c *Client) ConfigureMemLoad(ctx context.Context, cfg resource.MemConfig) error {
+	return c.UpdateMem(ctx, MemRequest{Config: &cfg})
+}
+
+// SetMemBandwidth starts streaming over the memory load allocation, replacing the running
+// settings.
+func (c *Client) SetMemBandwidth(ctx context.Context, cfg resource.BandwidthConfig) error {
+	return c.UpdateMemBandwidth(ctx, BandwidthRequest{BandwidthConfig: cfg})
+}
+
+// UpdateMemBandwidth sends an update to the memory bandwidth mode.
+func (c *Client) UpdateMemBandwidth(ctx context.Context, req BandwidthRequest) error {
+	return c.post(ctx, "/mem/bandwidth", req)
+}
+
+// StopMemBandwidth stops streaming over the memory load allocation.
+func (c *Client) StopMemBandwidth(ctx context.Context) error {
+	return c.delete(ctx, "/mem/bandwidth")
+}
+
+// ConfigureDiskLoad replaces the settings of the disk load.
+func (c *Client) ConfigureDiskLoad(ctx context.Context, cfg resource.DiskConfig) error {
+	return c.UpdateDisk(ctx, DiskRequest{DiskConfig: cfg})
+}
+
+// UpdateDisk sends an update to the disk load.
+func (c *Client) UpdateDisk(ctx context.Context, req DiskRequest) error {
+	return c.post(ctx, "/disk", req)
+}
+
+// ConfigureNetLoad replaces the settings of the network load.
+func (c *Client) ConfigureNetLoad(ctx context.Context, cfg resource.NetConfig) error {
+	return c.UpdateNet(ctx, NetRequest{NetConfig: cfg})
+}
+
+// UpdateNet sends an update to the network load.
+func (c *Client) UpdateNet(ctx context.Context, req NetRequest) error {
+	return c.post(ctx, "/net", req)
+}
+
+// SetProcLoads sets the number of resources the process loads ("fds", "threads" and
+// "goroutines") hold. Loads which are not given are left unchanged.
+func (c *Client) SetProcLoads(ctx context.Context, counts map[string]int64) error {
+	return c.post(ctx, "/proc", counts)
+}
+
+// UpdateProc sends an update to the process loads.
+func (c *Client) UpdateProc(ctx context.Context, req ProcRequest) error {
+	return c.post(ctx, "/proc", req)
+}
+
+// UpdateLoads updates several loads at once.
+func (c *Client) UpdateLoads(ctx context.Context, loads Loads) error {
+	return c.post(ctx, "/loads", loads)
+}
+
+// StopAll stops every load, cancels the running CPU load profile and scenario and pauses
+// load updates. It returns once the loads have released what they hold.
+func (c *Client) StopAll(ctx context.Context) error {
+	return c.post(ctx, "/stop-all", struct{}{})
+}
+
+// Pause cancels the running CPU load profile and scenario and rejects load updates until
+// Resume is called. The loads keep running at their current levels.
+func (c *Client) Pause(ctx context.Context) error {
+	return c.post(ctx, "/pause", struct{}{})
+}
+
+// Resume accepts load updates again.
+func (c *Client) Resume(ctx context.Context) error {
+	return c.delete(ctx, "/pause")
+}
+
+// SetMonitorIntervals sets the sampling interval of the given monitors ("cpu", "mem",
+// "disk", "net" and "proc").
+func (c *Client) SetMonitorIntervals(ctx context.Context, intervals map[string]time.Duration) error {
+	req := make(map[string]scenario.Duration, len(intervals))
+	for res, d := range intervals {
+		req[res] = scenario.Duration(d)
+	}
+	return c.post(ctx, "/monitor", req)
+}
+
+// RunScenario validates and starts a scenario, aborting the running one.
+func (c *Client) RunScenario(ctx context.Context, p *scenario.Plan) error {
+	return c.post(ctx, "/scenario", p)
+}
+
+// AbortScenario aborts the running scenario, leaving loads at their current levels.
+func (c *Client) AbortScenario(ctx context.Context) error {
+	return c.delete(ctx, "/scenario")
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/history"
+	"github.com/milonoir/schwer/resource/profile"
+	"github.com/milonoir/schwer/scenario"
+)
+
+// History is the recorded history of a resource, downsampled into min/avg/max series of
+// Step seconds.
+type History struct {
+	Resource string           `json:"resource"`
+	Step     float64          `json:"step"`
+	Series   []history.Series `json:"series"`
+}
+
+// CPUStatus returns the utilisation levels of the cores and the state of the CPU load.
+func (c *Client) CPUStatus(ctx context.Context) (*resource.PreciseCPUStatus, error) {
+	s := &resource.PreciseCPUStatus{}
+	return s, c.get(ctx, "/cpu", nil, s)
+}
+
+// CPUProfile returns the running CPU load profile and its progress.
+func (c *Client) CPUProfile(ctx context.Context) (*profile.Status, error) {
+	s := &profile.Status{}
+	return s, c.get(ctx, "/cpu/profile", nil, s)
+}
+
+// MemStats returns the memory usage of the host and the state of the memory load.
+func (c *Client) MemStats(ctx context.Context) (*resource.PreciseMemStats, error) {
+	s := &resource.PreciseMemStats{}
+	return s, c.get(ctx, "/mem", nil, s)
+}
+
+// MemBandwidth returns the settings of the memory bandwidth mode and the bandwidth it
+// achieved.
+func (c *Client) MemBandwidth(ctx context.Context) (*resource.BandwidthStatus, error) {
+	s := &resource.BandwidthStatus{}
+	return s, c.get(ctx, "/mem/bandwidth", nil, s)
+}
+
+// DiskStats returns the throughput and latency of the block devices and the state of the
+// disk load.
+func (c *Client) DiskStats(ctx context.Context) (*resource.DiskStats, error) {
+	s := &resource.DiskStats{}
+	return s, c.get(ctx, "/disk", nil, s)
+}
+
+// NetStats returns the traffic of the network interfaces and the state of the network load.
+func (c *Client) NetStats(ctx context.Context) (*resource.NetStats, error) {
+	s := &resource.NetStats{}
+	return s, c.get(ctx, "/net", nil, s)
+}
+
+// ProcStats returns the resources held by the Schwer process and the state of the process
+// loads.
+func (c *Client) ProcStats(ctx context.Context) (*resource.ProcStats, error) {
+	s := &resource.ProcStats{}
+	return s, c.get(ctx, "/proc", nil, s)
+}
+
+// Monitors returns the sampling interval and backend of every monitor.
+func (c *Client) Monitors(ctx context.Context) (map[string]resource.MonitorStatus, error) {
+	var s map[string]resource.MonitorStatus
+	return s, c.get(ctx, "/monitor", nil, &s)
+}
+
+// Guard returns the guardrails of the loads and the backoffs they caused.
+func (c *Client) Guard(ctx context.Context) (*resource.GuardStatus, error) {
+	s := &resource.GuardStatus{}
+	return s, c.get(ctx, "/guard", nil, s)
+}
+
+// PauseStatus returns whether load updates are paused.
+func (c *Client) PauseStatus(ctx context.Context) (*resource.PauseStatus, error) {
+	s := &resource.PauseStatus{}
+	return s, c.get(ctx, "/pause", nil, s)
+}
+
+// CPUSample returns a raw CPU sample from the monitor backend.
+func (c *Client) CPUSample(ctx context.Context) (*backend.CPUSample, error) {
+	s := &backend.CPUSample{}
+	return s, c.sample(ctx, "cpu", s)
+}
+
+// LoadSample returns a raw load average sample from the monitor backend.
+func (c *Client) LoadSample(ctx context.Context) (*backend.LoadSample, error) {
+	s := &backend.LoadSample{}
+	return s, c.sample(ctx, "load", s)
+}
+
+// MemSample returns a raw memory sample from the monitor backend.
+func (c *Client) MemSample(ctx context.Context) (*backend.MemSample, error) {
+	s := &backend.MemSample{}
+	return s, c.sample(ctx, "mem", s)
+}
+
+// DiskSample returns a raw disk sample from the monitor backend.
+func (c *Client) DiskSample(ctx context.Context) (*backend.DiskSample, error) {
+	s := &backend.DiskSample{}
+	return s, c.sample(ctx, "disk", s)
+}
+
+// NetSample returns a raw network sample from the monitor backend.
+func (c *Client) NetSample(ctx context.Context) (*backend.NetSample, error) {
+	s := &backend.NetSample{}
+	return s, c.sample(ctx, "net", s)
+}
+
+// sample fetches a raw sample of a resource.
+func (c *Client) sample(ctx context.Context, res string, v interface{}) error {
+	return c.get(ctx, "/sample", url.Values{"res": {res}}, v)
+}
+
+// History returns the recorded history of a resource ("cpu" or "mem") since the given
+// time, downsampled into steps. A zero since returns the whole history, a zero step uses
+// the default of the server.
+func (c *Client) History(ctx context.Context, res string, since time.Time, step time.Duration) (*History, error) {
+	q := url.Values{"resource": {res}}
+	if !since.IsZero() {
+		q.Set("since", since.Format(time.RFC3339))
+	}
+	if step > 0 {
+		q.Set("step", step.String())
+	}
+	h := &History{}
+	return h, c.get(ctx, "/history", q, h)
+}
+
+// ScenarioStatus returns the running scenario and its current phase.
+func (c *Client) ScenarioStatus(ctx context.Context) (*scenario.Status, error) {
+	s := &scenario.Status{}
+	return s, c.get(ctx, "/scenario", nil, s)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/client"
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/scenario"
+)
+
+// newTestClient returns a client of a server running the handlers of the API over c.
+func newTestClient(t *testing.T, c *Controller) (*client.Client, func()) {
+	t.Helper()
+
+	srv := httptest.NewServer(newServer(0, c, nil).Handler)
+	cl, err := client.New(srv.URL, srv.Client())
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return cl, srv.Close
+}
+
+func TestClientSetCPULoad(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+	cl, closeServer := newTestClient(t, c)
+	defer closeServer()
+	ctx := context.Background()
+
+	// Updates are answered with 202 Accepted.
+	if err := cl.SetCPULoad(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	// Stats are answered with 200 OK.
+	s, err := cl.CPUStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Feedback.Target != 10 {
+		t.Errorf("target: got %d, want 10", s.Feedback.Target)
+	}
+	if len(s.Levels) != fakeCores {
+		t.Errorf("levels: got %v, want %d cores", s.Levels, fakeCores)
+	}
+
+	if err := cl.SetCPULoad(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientMemStats(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+	cl, closeServer := newTestClient(t, c)
+	defer closeServer()
+
+	s, err := cl.MemStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Total != 16384 {
+		t.Errorf("total: got %.2f MB, want 16384 MB", s.Total)
+	}
+	if s.Load == nil {
+		t.Error("memory load status is missing")
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{CPUCeiling: 60}, time.Minute)
+	defer c.Stop()
+	cl, closeServer := newTestClient(t, c)
+	defer closeServer()
+	ctx := context.Background()
+
+	pct := int64(10)
+	for _, tc := range []struct {
+		name   string
+		call   func() error
+		status int
+		code   string
+	}{
+		{"invalid value", func() error { return cl.SetCPULoad(ctx, 500) }, http.StatusBadRequest, client.CodeInvalidValue},
+		{"ttl above the maximum", func() error {
+			return cl.UpdateCPU(ctx, client.CPURequest{Pct: &pct, Expiry: client.Expiry{TTL: scenario.Duration(time.Hour)}})
+		}, http.StatusBadRequest, client.CodeInvalidValue},
+		// The fake host is 50% busy without any load.
+		{"guardrail", func() error { return cl.SetCPULoad(ctx, 20) }, http.StatusConflict, client.CodeGuardrail},
+		{"paused", func() error {
+			if err := cl.Pause(ctx); err != nil {
+				return err
+			}
+			defer cl.Resume(ctx)
+			return cl.SetCPULoad(ctx, 5)
+		}, http.StatusConflict, client.CodePaused},
+	} {
+		err := tc.call()
+		e, ok := err.(*client.Error)
+		if !ok {
+			t.Errorf("%s: got %v, want a *client.Error", tc.name, err)
+			continue
+		}
+		if e.StatusCode != tc.status || e.Code != tc.code {
+			t.Errorf("%s: got %d %s, want %d %s", tc.name, e.StatusCode, e.Code, tc.status, tc.code)
+		}
+	}
+}
+
+func TestClientCPUSettings(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+	cl, closeServer := newTestClient(t, c)
+	defer closeServer()
+	ctx := context.Background()
+
+	period := scenario.Duration(50 * time.Millisecond)
+	enabled := true
+	invalid := int64(500)
+	for _, tc := range []struct {
+		name string
+		req  client.CPURequest
+		code string
+	}{
+		{"invalid pct", client.CPURequest{Period: &period, Feedback: &enabled, Pct: &invalid}, client.CodeInvalidValue},
+		{"paused", client.CPURequest{Period: &period, Feedback: &enabled}, client.CodePaused},
+	} {
+		if tc.code == client.CodePaused {
+			if err := cl.Pause(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+		err := cl.UpdateCPU(ctx, tc.req)
+		if e, ok := err.(*client.Error); !ok || e.Code != tc.code {
+			t.Errorf("%s: got %v, want the %s error code", tc.name, err, tc.code)
+		}
+		// A rejected request leaves every setting unchanged.
+		if s := c.CPUStatus(); s.PeriodMs != 100 || s.Feedback.Enabled {
+			t.Errorf("%s: period %.0f ms, feedback %t after the rejected request", tc.name, s.PeriodMs, s.Feedback.Enabled)
+		}
+	}
+
+	if err := cl.Resume(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := cl.UpdateCPU(ctx, client.CPURequest{Period: &period}); err != nil {
+		t.Fatal(err)
+	}
+	if s := c.CPUStatus(); s.PeriodMs != 50 {
+		t.Errorf("period: got %.0f ms, want 50 ms", s.PeriodMs)
+	}
+}
+
+func TestClientMemConfig(t *testing.T) {
+	// The fake host never has more than 10 GiB available.
+	c := newTestController(t, resource.GuardConfig{MemFloorMB: 12 << 10}, 0)
+	defer c.Stop()
+	cl, closeServer := newTestClient(t, c)
+	defer closeServer()
+	ctx := context.Background()
+
+	want := c.MemLoadConfig()
+	cfg := want
+	cfg.Hot = 50
+	size := int64(1)
+	if err := cl.UpdateMem(ctx, client.MemRequest{Size: &size, Config: &cfg}); err == nil {
+		t.Error("update below the floor: got no error")
+	}
+	if got := c.MemLoadConfig(); got != want {
+		t.Errorf("config after the rejected update: got %+v, want %+v", got, want)
+	}
+
+	if err := cl.Pause(ctx); err != nil {
+		t.Fatal(err)
+	}
+	err := cl.ConfigureMemLoad(ctx, cfg)
+	if e, ok := err.(*client.Error); !ok || e.Code != client.CodePaused {
+		t.Errorf("config while paused: got %v, want the %s error code", err, client.CodePaused)
+	}
+	if got := c.MemLoadConfig(); got != want {
+		t.Errorf("config after the rejected update: got %+v, want %+v", got, want)
+	}
+}
@@ -1,59 +1,933 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cpu"
+	"github.com/milonoir/schwer/resource/event"
+	"github.com/milonoir/schwer/resource/expiry"
+	"github.com/milonoir/schwer/resource/guard"
+	"github.com/milonoir/schwer/resource/history"
+	"github.com/milonoir/schwer/resource/memory"
+	"github.com/milonoir/schwer/resource/profile"
+	"github.com/milonoir/schwer/scenario"
+)
+
+// loadNames are the names of the loads which can expire.
+var loadNames = []string{"cpu", "mem", "bandwidth", "disk", "net", "fds", "threads", "goroutines"}
+
+const (
+	// stopAllTimeout is how long StopAll waits for the loads to release what they hold.
+	stopAllTimeout = 5 * time.Second
+	// releasePollInterval is how often StopAll checks whether the loads are released.
+	releasePollInterval = 10 * time.Millisecond
 )
 
+// errLoadsPaused is returned by load updates while the loads are paused.
+var errLoadsPaused = errors.New("loads are paused, resume them first")
+
 // Controller controls resource loads and monitors.
 type Controller struct {
-	cpuLoad    resource.Load
-	memLoad    resource.Load
-	cpuMonitor resource.Monitor
-	memMonitor resource.Monitor
+	// Load update counters. Kept first for 64-bit alignment of atomic operations.
+	cpuUpdates  uint64
+	memUpdates  uint64
+	diskUpdates uint64
+	netUpdates  uint64
+	procUpdates uint64
+
+	cpuLoad       resource.CoreLoad
+	memLoad       resource.MemLoad
+	diskLoad      resource.DiskLoad
+	netLoad       resource.NetLoad
+	fdLoad        resource.CountLoad
+	threadLoad    resource.CountLoad
+	goroutineLoad resource.CountLoad
+	cpuMonitor    resource.CPUMonitor
+	memMonitor    resource.MemMonitor
+	diskMonitor   resource.DiskMonitor
+	netMonitor    resource.NetMonitor
+	procMonitor   resource.ProcMonitor
+	backend       backend.Backend
+	cpuRegulator  *cpu.Regulator
+	cpuProfile    *profile.Runner
+	scenario      *scenario.Runner
+	hub           *event.Hub
+	history       *history.Recorder
+	expiry        *expiry.Expiry
+	guard         *guard.Guard
+
+	paused      bool
+	pausedSince time.Time
+	mtx         sync.RWMutex
 }
 
 // NewController returns a new Controller.
-func NewController(cpuLoad, memLoad resource.Load, cpuMonitor, memMonitor resource.Monitor) *Controller {
-	return &Controller{
-		cpuLoad:    cpuLoad,
-		memLoad:    memLoad,
-		cpuMonitor: cpuMonitor,
-		memMonitor: memMonitor,
+func NewController(cpuLoad resource.CoreLoad, memLoad resource.MemLoad, diskLoad resource.DiskLoad, netLoad resource.NetLoad, fdLoad, threadLoad, goroutineLoad resource.CountLoad, cpuMonitor resource.CPUMonitor, memMonitor resource.MemMonitor, diskMonitor resource.DiskMonitor, netMonitor resource.NetMonitor, procMonitor resource.ProcMonitor, b backend.Backend, cpuRegulator *cpu.Regulator, cpuProfile *profile.Runner, scenario *scenario.Runner, hub *event.Hub, history *history.Recorder, expiry *expiry.Expiry, guard *guard.Guard) *Controller {
+	c := &Controller{
+		cpuLoad:       cpuLoad,
+		memLoad:       memLoad,
+		diskLoad:      diskLoad,
+		netLoad:       netLoad,
+		fdLoad:        fdLoad,
+		threadLoad:    threadLoad,
+		goroutineLoad: goroutineLoad,
+		cpuMonitor:    cpuMonitor,
+		memMonitor:    memMonitor,
+		diskMonitor:   diskMonitor,
+		netMonitor:    netMonitor,
+		procMonitor:   procMonitor,
+		backend:       b,
+		cpuRegulator:  cpuRegulator,
+		cpuProfile:    cpuProfile,
+		scenario:      scenario,
+		hub:           hub,
+		history:       history,
+		expiry:        expiry,
+		guard:         guard,
 	}
+	guard.SetBackoff(c.backoff)
+	return c
 }
 
-// Start starts up resource monitors and loads.
+// Start starts up the history recorder, resource monitors, guardrails and loads.
 func (c *Controller) Start() {
+	c.history.Start()
 	c.cpuMonitor.Start()
 	c.memMonitor.Start()
+	c.diskMonitor.Start()
+	c.netMonitor.Start()
+	c.procMonitor.Start()
+	c.guard.Start()
 	c.cpuLoad.Start()
 	c.memLoad.Start()
+	c.diskLoad.Start()
+	c.netLoad.Start()
+	c.fdLoad.Start()
+	c.threadLoad.Start()
+	c.goroutineLoad.Start()
+	c.cpuRegulator.Start()
 }
 
-// Stop stops resource loads and monitors.
+// Stop stops guardrails, resource loads, monitors and the history recorder.
 func (c *Controller) Stop() {
+	c.expiry.Stop()
+	c.guard.Stop()
+	c.scenario.Abort()
+	c.cpuProfile.Cancel()
+	c.cpuRegulator.Stop()
 	c.cpuLoad.Stop()
 	c.memLoad.Stop()
+	c.diskLoad.Stop()
+	c.netLoad.Stop()
+	c.fdLoad.Stop()
+	c.threadLoad.Stop()
+	c.goroutineLoad.Stop()
 	c.cpuMonitor.Stop()
 	c.memMonitor.Stop()
+	c.diskMonitor.Stop()
+	c.netMonitor.Stop()
+	c.procMonitor.Stop()
+	c.history.Stop()
+}
+
+// UpdateCPULoad sends an update to the CPU load. A running CPU load profile is cancelled.
+func (c *Controller) UpdateCPULoad(pct int64) error {
+	if err := c.CheckCPULoad(pct); err != nil {
+		return err
+	}
+	c.cpuProfile.Cancel()
+	c.setCPULoad(pct)
+	c.expire("cpu", pct > 0)
+	return nil
+}
+
+// CheckCPULoad returns an error if setting the CPU load of every core to pct would break
+// the CPU ceiling.
+func (c *Controller) CheckCPULoad(pct int64) error {
+	if fb := c.cpuRegulator.Status(); fb.Enabled {
+		// The target is the total utilisation, which the measured one converges to.
+		return c.guard.CheckCPU(fb.Measured, float64(pct))
+	}
+	return c.guard.CheckCPU(c.cpuTarget(), float64(pct))
+}
+
+// CheckCPUCoreLoads returns an error if setting the CPU load of each core to pcts would
+// break the CPU ceiling.
+func (c *Controller) CheckCPUCoreLoads(pcts []int64) error {
+	return c.guard.CheckCPU(c.cpuTarget(), mean(pcts))
+}
+
+// UpdateCPUCoreLoad sends an update to the load of a single CPU core. A running CPU load
+// profile is cancelled.
+func (c *Controller) UpdateCPUCoreLoad(core int, pct int64) error {
+	if err := c.CheckCPUCoreLoads(c.cpuCoreTargets(core, pct)); err != nil {
+		return err
+	}
+	c.cpuProfile.Cancel()
+	if err := c.cpuRegulator.UpdateCore(core, pct); err != nil {
+		return err
+	}
+	atomic.AddUint64(&c.cpuUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "cpu", Core: &core, Value: pct})
+	c.expire("cpu", c.cpuActive())
+	return nil
+}
+
+// UpdateCPUCoreLoads sends an update to the load of every CPU core. A running CPU load
+// profile is cancelled.
+func (c *Controller) UpdateCPUCoreLoads(pcts []int64) error {
+	if err := c.CheckCPUCoreLoads(pcts); err != nil {
+		return err
+	}
+	c.cpuProfile.Cancel()
+	if err := c.cpuRegulator.UpdateCores(pcts); err != nil {
+		return err
+	}
+	atomic.AddUint64(&c.cpuUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "cpu", Values: pcts})
+	c.expire("cpu", c.cpuActive())
+	return nil
+}
+
+// setCPULoad updates the CPU load of all cores without touching the running profile or the
+// expiry of the CPU load.
+func (c *Controller) setCPULoad(pct int64) {
+	c.cpuRegulator.Update(pct)
+	atomic.AddUint64(&c.cpuUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "cpu", Value: pct})
+}
+
+// SetCPUPeriod updates the duty cycle period of the CPU load.
+func (c *Controller) SetCPUPeriod(period time.Duration) error {
+	return c.cpuLoad.SetPeriod(period)
+}
+
+// ValidateCPUPeriod returns an error if period is out of the bounds of the duty cycle period.
+func (c *Controller) ValidateCPUPeriod(period time.Duration) error {
+	return cpu.ValidatePeriod(period)
+}
+
+// ValidateCPUCores returns an error if per-core loads cannot be set with feedback mode in the
+// given state, see cpu.Regulator.ValidateCores.
+func (c *Controller) ValidateCPUCores(feedback bool, core *int, n int) error {
+	return c.cpuRegulator.ValidateCores(feedback, core, n)
+}
+
+// SetCPUFeedback turns the closed-loop CPU load controller on or off.
+func (c *Controller) SetCPUFeedback(enabled bool) {
+	c.cpuRegulator.SetFeedback(enabled)
+}
+
+// RunCPUProfile starts driving the CPU load through p, replacing any running profile. The
+// profile expires once the maximum TTL runs out, counted from its start.
+func (c *Controller) RunCPUProfile(p profile.Profile) error {
+	return c.Timed(expiry.TTL{}, []string{"cpu"}, func() error { return c.startCPUProfile(p) })
+}
+
+// startCPUProfile starts driving the CPU load through p without checking whether the loads
+// are paused, so it must be applied through Timed.
+func (c *Controller) startCPUProfile(p profile.Profile) error {
+	// The levels bypass the guardrails once the profile runs, so its highest one is checked
+	// up front.
+	if err := c.CheckCPULoad(p.Max()); err != nil {
+		return err
+	}
+	c.cpuProfile.Run(p, c.setCPULoad)
+	// Level changes of the profile do not re-arm the expiry, so it runs out even if the
+	// profile has no end.
+	c.expire("cpu", true)
+	return nil
+}
+
+// CancelCPUProfile stops the running CPU load profile, leaving the load at its last level.
+func (c *Controller) CancelCPUProfile() {
+	c.cpuProfile.Cancel()
 }
 
-// UpdateCPULoad sends an update to the CPU load.
-func (c *Controller) UpdateCPULoad(pct int64) {
-	c.cpuLoad.Update(pct)
+// CPUProfileStatus returns the state of the running CPU load profile.
+func (c *Controller) CPUProfileStatus() interface{} {
+	return c.cpuProfile.Status()
 }
 
 // UpdateMemLoad sends an update to the memory load.
-func (c *Controller) UpdateMemLoad(size int64) {
+func (c *Controller) UpdateMemLoad(size int64) error {
+	if err := c.CheckMemLoad(size); err != nil {
+		return err
+	}
 	c.memLoad.Update(size)
+	atomic.AddUint64(&c.memUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "mem", Value: size})
+	c.expire("mem", size > 0)
+	return nil
+}
+
+// CheckMemLoad returns an error if resizing the memory load to size MB would break the
+// memory floor or the cgroup headroom.
+func (c *Controller) CheckMemLoad(size int64) error {
+	return c.guard.CheckMem(c.memLoad.Status().Requested, size)
+}
+
+// LeakMemLoad starts growing the memory load in leak mode, or stops growing it if the
+// rate is 0.
+func (c *Controller) LeakMemLoad(cfg resource.LeakConfig) error {
+	if err := c.CheckMemLeak(cfg); err != nil {
+		return err
+	}
+	if err := c.memLoad.Leak(cfg); err != nil {
+		return err
+	}
+	requested := c.memLoad.Status().Requested
+	atomic.AddUint64(&c.memUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "mem", Value: requested})
+	c.expire("mem", cfg.Rate > 0 || requested > 0)
+	return nil
+}
+
+// CheckMemLeak returns an error if growing the memory load up to the ceiling of cfg would
+// break the memory floor or the cgroup headroom.
+func (c *Controller) CheckMemLeak(cfg resource.LeakConfig) error {
+	// A leak without a ceiling grows until the guardrails back it off.
+	if cfg.Rate > 0 && cfg.Ceiling > 0 {
+		return c.CheckMemLoad(cfg.Ceiling)
+	}
+	return nil
+}
+
+// ValidateMemLeak returns an error if the memory load cannot grow in leak mode with cfg.
+func (c *Controller) ValidateMemLeak(cfg resource.LeakConfig) error {
+	return memory.ValidateLeak(cfg)
+}
+
+// ValidateMemConfig returns an error if the memory load cannot run with cfg.
+func (c *Controller) ValidateMemConfig(cfg resource.MemConfig) error {
+	return memory.Validate(cfg)
+}
+
+// ConfigureMemLoad validates and applies a new memory load configuration.
+func (c *Controller) ConfigureMemLoad(cfg resource.MemConfig) error {
+	return c.memLoad.Configure(cfg)
+}
+
+// MemLoadConfig returns the current memory load configuration.
+func (c *Controller) MemLoadConfig() resource.MemConfig {
+	return c.memLoad.Config()
+}
+
+// SetMemBandwidth starts streaming over the memory load at the given bandwidth, or stops
+// streaming if there are no workers.
+func (c *Controller) SetMemBandwidth(cfg resource.BandwidthConfig) error {
+	if err := c.memLoad.SetBandwidth(cfg); err != nil {
+		return err
+	}
+	c.expire("bandwidth", cfg.Workers > 0)
+	return nil
+}
+
+// MemBandwidthStatus returns the state of the memory bandwidth mode.
+func (c *Controller) MemBandwidthStatus() resource.BandwidthStatus {
+	s := resource.BandwidthStatus{}
+	if b := c.memLoad.Status().Bandwidth; b != nil {
+		s = *b
+	}
+	s.TTL = c.expiry.Status("bandwidth")
+	return s
+}
+
+// RunScenario starts executing p, aborting any running scenario.
+func (c *Controller) RunScenario(p *scenario.Plan) error {
+	if c.Paused() {
+		return errLoadsPaused
+	}
+	c.scenario.Run(p, c)
+	return nil
+}
+
+// AbortScenario stops the running scenario, leaving loads at their current levels.
+func (c *Controller) AbortScenario() {
+	c.scenario.Abort()
+}
+
+// ScenarioStatus returns the state of the running scenario.
+func (c *Controller) ScenarioStatus() interface{} {
+	return c.scenario.Status()
+}
+
+// UpdateDiskLoad sends a throughput update (in MB/s) to the disk load.
+func (c *Controller) UpdateDiskLoad(mbps int64) {
+	c.diskLoad.Update(mbps)
+	atomic.AddUint64(&c.diskUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "disk", Value: mbps})
+	c.expire("disk", mbps > 0)
+}
+
+// ConfigureDiskLoad validates and applies a new disk load configuration.
+func (c *Controller) ConfigureDiskLoad(cfg resource.DiskConfig) error {
+	if err := c.diskLoad.Configure(cfg); err != nil {
+		return err
+	}
+	atomic.AddUint64(&c.diskUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "disk", Value: cfg.Throughput})
+	c.expire("disk", cfg.Throughput > 0)
+	return nil
+}
+
+// DiskLoadConfig returns the current disk load configuration.
+func (c *Controller) DiskLoadConfig() resource.DiskConfig {
+	return c.diskLoad.Config()
+}
+
+// UpdateNetLoad sends a throughput update (in Mbit/s) to the network load.
+func (c *Controller) UpdateNetLoad(mbps int64) {
+	c.netLoad.Update(mbps)
+	atomic.AddUint64(&c.netUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "net", Value: mbps})
+	c.expire("net", mbps > 0)
+}
+
+// ConfigureNetLoad validates and applies a new network load configuration.
+func (c *Controller) ConfigureNetLoad(cfg resource.NetConfig) error {
+	if err := c.netLoad.Configure(cfg); err != nil {
+		return err
+	}
+	atomic.AddUint64(&c.netUpdates, 1)
+	c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: "net", Value: cfg.Throughput})
+	c.expire("net", cfg.Throughput > 0)
+	return nil
+}
+
+// NetLoadConfig returns the current network load configuration.
+func (c *Controller) NetLoadConfig() resource.NetConfig {
+	return c.netLoad.Config()
+}
+
+// UpdateProcLoads sends updates to the file descriptor ("fds"), thread ("threads") and
+// goroutine ("goroutines") loads. Nothing is updated if any of the counts is invalid.
+func (c *Controller) UpdateProcLoads(counts map[string]int64) error {
+	if err := c.ValidateProcLoads(counts); err != nil {
+		return err
+	}
+
+	for res, n := range counts {
+		c.procLoad(res).Update(n)
+		atomic.AddUint64(&c.procUpdates, 1)
+		c.hub.Publish(event.TypeLoad, resource.LoadChange{Resource: res, Value: n})
+		c.expire(res, n > 0)
+	}
+	return nil
+}
+
+// Pause stops the running scenario and CPU load profile and rejects load updates until
+// Resume is called. The loads keep running at their current levels.
+func (c *Controller) Pause() {
+	c.mtx.Lock()
+	if !c.paused {
+		c.paused = true
+		c.pausedSince = time.Now()
+	}
+	c.mtx.Unlock()
+
+	// The scenario applies its phases through the controller, so it must not be aborted
+	// while holding the lock.
+	c.scenario.Abort()
+	c.cpuProfile.Cancel()
+}
+
+// Resume accepts load updates again.
+func (c *Controller) Resume() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.paused = false
+}
+
+// Paused tells whether load updates are rejected.
+func (c *Controller) Paused() bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.paused
+}
+
+// PauseStatus returns whether the loads are paused and since when.
+func (c *Controller) PauseStatus() resource.PauseStatus {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	s := resource.PauseStatus{Paused: c.paused}
+	if c.paused {
+		since := c.pausedSince
+		s.Since = &since
+	}
+	return s
+}
+
+// StopAll pauses the loads (see Pause), turns feedback mode off, cancels every pending
+// expiry and sets every load to zero. It returns once the memory and process loads have
+// released what they hold and the CPU load workers have finished their duty cycle, or an
+// error if that takes longer than stopAllTimeout.
+func (c *Controller) StopAll() error {
+	c.Pause()
+	for _, res := range loadNames {
+		c.expiry.Clear(res)
+	}
+
+	c.cpuRegulator.SetFeedback(false)
+	c.setCPULoad(0)
+	c.UpdateMemLoad(0)
+	c.SetMemBandwidth(resource.BandwidthConfig{})
+	c.UpdateDiskLoad(0)
+	c.UpdateNetLoad(0)
+	c.UpdateProcLoads(map[string]int64{"fds": 0, "threads": 0, "goroutines": 0})
+
+	// A duty cycle which has already started runs until the end of its period.
+	deadline := time.Now().Add(stopAllTimeout)
+	time.Sleep(c.cpuLoad.Period())
+	for !c.released() {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("loads not released after %s", stopAllTimeout)
+		}
+		time.Sleep(releasePollInterval)
+	}
+	return nil
+}
+
+// released tells whether the memory and process loads hold nothing.
+func (c *Controller) released() bool {
+	if s := c.memLoad.Status(); s.Allocated > 0 || s.Bandwidth != nil {
+		return false
+	}
+	for _, l := range []resource.CountLoad{c.fdLoad, c.threadLoad, c.goroutineLoad} {
+		if l.Status().Held > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// unlessPaused returns a function calling fn unless the loads are paused.
+func (c *Controller) unlessPaused(fn func()) func() {
+	return func() {
+		c.mtx.RLock()
+		defer c.mtx.RUnlock()
+
+		if !c.paused {
+			fn()
+		}
+	}
+}
+
+// Timed applies update to the loads of res (see loadNames) and schedules them to revert
+// once ttl runs out. A TTL without a duration falls back to the maximum TTL, if any.
+func (c *Controller) Timed(ttl expiry.TTL, res []string, update func() error) error {
+	// Held until the update is applied, so StopAll cannot run in between.
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	if c.paused {
+		return errLoadsPaused
+	}
+	if err := c.expiry.Validate(ttl); err != nil {
+		return err
+	}
+
+	// The previous state has to be captured before it is overwritten by the update.
+	reverts := make([]func(), len(res))
+	for i, r := range res {
+		if ttl.Revert == expiry.RevertPrevious {
+			reverts[i] = c.previous(r)
+		} else {
+			reverts[i] = c.zero(r)
+		}
+	}
+	if err := update(); err != nil {
+		return err
+	}
+
+	// Otherwise the update has scheduled the default expiry.
+	if ttl.Duration > 0 || ttl.Revert == expiry.RevertPrevious {
+		for i, r := range res {
+			c.expiry.Set(r, ttl, c.unlessPaused(reverts[i]))
+		}
+	}
+	return nil
+}
+
+// ValidateTTL returns an error if ttl is negative, exceeds the maximum TTL or has an
+// unknown revert mode.
+func (c *Controller) ValidateTTL(ttl expiry.TTL) error {
+	return c.expiry.Validate(ttl)
+}
+
+// MonitorStatus returns the state of every resource monitor by resource.
+func (c *Controller) MonitorStatus() map[string]resource.MonitorStatus {
+	status := make(map[string]resource.MonitorStatus)
+	for res, m := range c.monitors() {
+		s := resource.MonitorStatus{
+			IntervalMs: float64(m.Interval()) / float64(time.Millisecond),
+		}
+		// The process monitor watches Schwer itself, so it does not read from the backend.
+		if res != "proc" {
+			s.Backend = c.backend.Name()
+		}
+		status[res] = s
+	}
+	return status
+}
+
+// Sample returns a raw sample of a resource (cpu, load, mem, disk or net) from the monitor
+// backend.
+func (c *Controller) Sample(res string) (interface{}, error) {
+	return backend.Sample(c.backend, res)
+}
+
+// SetMonitorIntervals changes the sampling interval of the monitors of the given
+// resources. Nothing is changed unless every resource and interval is valid.
+func (c *Controller) SetMonitorIntervals(intervals map[string]time.Duration) error {
+	monitors := c.monitors()
+	for res, d := range intervals {
+		if monitors[res] == nil {
+			return fmt.Errorf("unknown monitor: %q", res)
+		}
+		if err := resource.ValidateInterval(d); err != nil {
+			return err
+		}
+	}
+
+	for res, d := range intervals {
+		// Validated above, so this cannot fail.
+		_ = monitors[res].SetInterval(d)
+	}
+	return nil
+}
+
+// monitors returns the resource monitors by resource.
+func (c *Controller) monitors() map[string]resource.Monitor {
+	return map[string]resource.Monitor{
+		"cpu":  c.cpuMonitor,
+		"mem":  c.memMonitor,
+		"disk": c.diskMonitor,
+		"net":  c.netMonitor,
+		"proc": c.procMonitor,
+	}
+}
+
+// ValidateProcLoads returns an error if any of the process loads in counts does not exist
+// or the number of resources is out of its bounds.
+func (c *Controller) ValidateProcLoads(counts map[string]int64) error {
+	for res, n := range counts {
+		load := c.procLoad(res)
+		if load == nil {
+			return fmt.Errorf("unknown process load: %q", res)
+		}
+		if n < 0 || (load.Max() > 0 && n > load.Max()) {
+			return fmt.Errorf("%s must be between 0-%d, got: %d", res, load.Max(), n)
+		}
+	}
+	return nil
+}
+
+// procLoad returns the process load of the given name, nil if there is none.
+func (c *Controller) procLoad(res string) resource.CountLoad {
+	switch res {
+	case "fds":
+		return c.fdLoad
+	case "threads":
+		return c.threadLoad
+	case "goroutines":
+		return c.goroutineLoad
+	}
+	return nil
+}
+
+// expire schedules the load of res to revert to zero once the maximum TTL runs out, or
+// cancels its pending expiry if the load is idle.
+func (c *Controller) expire(res string, active bool) {
+	if !active {
+		c.expiry.Clear(res)
+		return
+	}
+	c.expiry.Set(res, expiry.TTL{}, c.zero(res))
+}
+
+// GuardStatus returns the guardrails and the backoffs they caused.
+func (c *Controller) GuardStatus() resource.GuardStatus {
+	return c.guard.Status()
+}
+
+// backoff reduces the CPU load by the given percent of total CPU capacity, keeping the
+// ratio of the loads of the cores, or the memory load by the given MB. A running CPU load
+// profile is cancelled, and leak mode ends.
+func (c *Controller) backoff(res string, by float64) bool {
+	switch res {
+	case "cpu":
+		if fb := c.cpuRegulator.Status(); fb.Enabled {
+			if fb.Target == 0 {
+				return false
+			}
+			c.UpdateCPULoad(int64(math.Max(0, float64(fb.Target)-by)))
+			return true
+		}
+		current := c.cpuTarget()
+		if current == 0 {
+			return false
+		}
+		scale := math.Max(0, current-by) / current
+		workers := c.cpuLoad.Workers()
+		pcts := make([]int64, len(workers))
+		uniform := true
+		for i, w := range workers {
+			pcts[i] = int64(math.Floor(float64(w.Target) * scale))
+			uniform = uniform && w.Target == workers[0].Target
+		}
+		if uniform {
+			c.UpdateCPULoad(pcts[0])
+		} else {
+			c.UpdateCPUCoreLoads(pcts)
+		}
+		return true
+	case "mem":
+		current := c.memLoad.Status().Requested
+		if current == 0 {
+			return false
+		}
+		c.UpdateMemLoad(int64(math.Max(0, float64(current)-math.Ceil(by))))
+		return true
+	}
+	return false
+}
+
+// cpuTarget returns the mean target load of all CPU cores.
+func (c *Controller) cpuTarget() float64 {
+	workers := c.cpuLoad.Workers()
+	pcts := make([]int64, len(workers))
+	for i, w := range workers {
+		pcts[i] = w.Target
+	}
+	return mean(pcts)
+}
+
+// cpuCoreTargets returns the target loads of all CPU cores with the load of core set to pct.
+func (c *Controller) cpuCoreTargets(core int, pct int64) []int64 {
+	workers := c.cpuLoad.Workers()
+	pcts := make([]int64, len(workers))
+	for i, w := range workers {
+		pcts[i] = w.Target
+		if w.Core == core {
+			pcts[i] = pct
+		}
+	}
+	return pcts
+}
+
+// mean returns the mean of pcts.
+func mean(pcts []int64) float64 {
+	if len(pcts) == 0 {
+		return 0
+	}
+	var sum int64
+	for _, v := range pcts {
+		sum += v
+	}
+	return float64(sum) / float64(len(pcts))
+}
+
+// cpuActive tells whether any CPU core is loaded.
+func (c *Controller) cpuActive() bool {
+	for _, w := range c.cpuLoad.Workers() {
+		if w.Target > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// zero returns the function reverting the load of res to zero.
+func (c *Controller) zero(res string) func() {
+	switch res {
+	case "cpu":
+		return func() { c.UpdateCPULoad(0) }
+	case "mem":
+		return func() { c.UpdateMemLoad(0) }
+	case "bandwidth":
+		return func() { c.SetMemBandwidth(resource.BandwidthConfig{}) }
+	case "disk":
+		return func() { c.UpdateDiskLoad(0) }
+	case "net":
+		return func() { c.UpdateNetLoad(0) }
+	}
+	return func() { c.UpdateProcLoads(map[string]int64{res: 0}) }
+}
+
+// previous returns the function reverting the load of res to its current state. The size
+// of the memory load is restored, but not its leak mode.
+func (c *Controller) previous(res string) func() {
+	switch res {
+	case "cpu":
+		if fb := c.cpuRegulator.Status(); fb.Enabled {
+			return func() { c.UpdateCPULoad(fb.Target) }
+		}
+		workers := c.cpuLoad.Workers()
+		pcts := make([]int64, len(workers))
+		uniform := true
+		for i, w := range workers {
+			pcts[i] = w.Target
+			uniform = uniform && w.Target == workers[0].Target
+		}
+		if uniform && len(pcts) > 0 {
+			return func() { c.UpdateCPULoad(pcts[0]) }
+		}
+		// Cores can only be loaded differently if per-core loads are supported, so this
+		// cannot fail.
+		return func() { c.UpdateCPUCoreLoads(pcts) }
+	case "mem":
+		size := c.memLoad.Status().Requested
+		return func() { c.UpdateMemLoad(size) }
+	case "bandwidth":
+		cfg := c.MemBandwidthStatus().Config
+		return func() { c.SetMemBandwidth(cfg) }
+	case "disk":
+		cfg := c.DiskLoadConfig()
+		return func() { c.ConfigureDiskLoad(cfg) }
+	case "net":
+		cfg := c.NetLoadConfig()
+		return func() { c.ConfigureNetLoad(cfg) }
+	}
+	n := c.procLoad(res).Status().Requested
+	return func() { c.UpdateProcLoads(map[string]int64{res: n}) }
 }
 
 // CPUUtilisationLevels returns the latest CPU utilisation levels from the CPU load monitor.
-func (c *Controller) CPUUtilisationLevels() interface{} {
+func (c *Controller) CPUUtilisationLevels() resource.CPULevels {
 	return c.cpuMonitor.Usage()
 }
 
-// MemStats returns the latest memory stats from the memory load monitor.
-func (c *Controller) MemStats() interface{} {
-	return c.memMonitor.Usage()
+// CPUStatus returns the latest CPU utilisation levels of the host and the container along
+// with the state of the closed-loop CPU load controller and the CPU load workers.
+func (c *Controller) CPUStatus() resource.CPUStatus {
+	return resource.CPUStatus{
+		Levels:     c.cpuMonitor.Usage(),
+		Feedback:   c.cpuRegulator.Status(),
+		PeriodMs:   float64(c.cpuLoad.Period()) / float64(time.Millisecond),
+		Workers:    c.cpuLoad.Workers(),
+		Container:  c.cpuMonitor.Container(),
+		TTL:        c.expiry.Status("cpu"),
+		CPUDetails: c.cpuMonitor.Details(),
+	}
+}
+
+// PreciseCPUStatus returns the same as CPUStatus, with the utilisation levels of the host
+// not rounded to integers.
+func (c *Controller) PreciseCPUStatus() resource.PreciseCPUStatus {
+	return resource.PreciseCPUStatus{
+		CPUStatus: c.CPUStatus(),
+		Levels:    c.cpuMonitor.Levels(),
+	}
+}
+
+// MemStats returns the latest memory stats from the memory load monitor along with the
+// state of the memory load.
+func (c *Controller) MemStats() resource.MemStats {
+	stats := c.memMonitor.Usage()
+	load := c.MemLoadStatus()
+	stats.Load = &load
+	return stats
+}
+
+// PreciseMemStats returns the same as MemStats, with the figures of the host not truncated
+// to whole MB.
+func (c *Controller) PreciseMemStats() resource.PreciseMemStats {
+	stats := c.memMonitor.Precise()
+	load := c.MemLoadStatus()
+	stats.Load = &load
+	return stats
+}
+
+// DiskStats returns the latest disk I/O stats from the disk monitor along with the state
+// of the disk load.
+func (c *Controller) DiskStats() resource.DiskStats {
+	stats := c.diskMonitor.Usage()
+	load := c.diskLoad.Status()
+	load.TTL = c.expiry.Status("disk")
+	stats.Load = &load
+	return stats
+}
+
+// NetStats returns the latest interface stats from the network monitor along with the
+// state of the network load.
+func (c *Controller) NetStats() resource.NetStats {
+	stats := c.netMonitor.Usage()
+	load := c.netLoad.Status()
+	load.TTL = c.expiry.Status("net")
+	stats.Load = &load
+	return stats
+}
+
+// ProcStats returns the latest file descriptor, thread and goroutine counts and limits of
+// the process along with the state of the process loads.
+func (c *Controller) ProcStats() resource.ProcStats {
+	stats := c.procMonitor.Usage()
+	stats.Loads = &resource.ProcLoads{
+		FDs:        c.countLoadStatus("fds"),
+		Threads:    c.countLoadStatus("threads"),
+		Goroutines: c.countLoadStatus("goroutines"),
+	}
+	return stats
+}
+
+// MemLoadStatus returns the requested and actually allocated size of the memory load.
+func (c *Controller) MemLoadStatus() resource.MemLoadStatus {
+	s := c.memLoad.Status()
+	s.TTL = c.expiry.Status("mem")
+	if s.Bandwidth != nil {
+		s.Bandwidth.TTL = c.expiry.Status("bandwidth")
+	}
+	return s
+}
+
+// countLoadStatus returns the state of the process load of the given name.
+func (c *Controller) countLoadStatus(res string) resource.CountLoadStatus {
+	s := c.procLoad(res).Status()
+	s.TTL = c.expiry.Status(res)
+	return s
+}
+
+// Subscribe returns a channel of monitor samples and load changes, and a function to
+// cancel the subscription.
+func (c *Controller) Subscribe() (<-chan event.Event, func()) {
+	return c.hub.Subscribe()
+}
+
+// History returns the recorded samples of a resource since the given time, downsampled
+// into steps.
+func (c *Controller) History(res string, since time.Time, step time.Duration) ([]history.Series, error) {
+	return c.history.Query(res, since, step)
+}
+
+// LoadTTLs returns the pending expiry of every load which has one.
+func (c *Controller) LoadTTLs() map[string]resource.TTLStatus {
+	ttls := make(map[string]resource.TTLStatus)
+	for _, res := range loadNames {
+		if s := c.expiry.Status(res); s != nil {
+			ttls[res] = *s
+		}
+	}
+	return ttls
+}
+
+// LoadUpdates returns the number of load updates applied so far per resource.
+func (c *Controller) LoadUpdates() map[string]uint64 {
+	return map[string]uint64{
+		"cpu":  atomic.LoadUint64(&c.cpuUpdates),
+		"mem":  atomic.LoadUint64(&c.memUpdates),
+		"disk": atomic.LoadUint64(&c.diskUpdates),
+		"net":  atomic.LoadUint64(&c.netUpdates),
+		"proc": atomic.LoadUint64(&c.procUpdates),
+	}
 }
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cpu"
+	"github.com/milonoir/schwer/resource/disk"
+	"github.com/milonoir/schwer/resource/event"
+	"github.com/milonoir/schwer/resource/expiry"
+	"github.com/milonoir/schwer/resource/guard"
+	"github.com/milonoir/schwer/resource/history"
+	"github.com/milonoir/schwer/resource/memory"
+	"github.com/milonoir/schwer/resource/network"
+	"github.com/milonoir/schwer/resource/process"
+	"github.com/milonoir/schwer/resource/profile"
+	"github.com/milonoir/schwer/scenario"
+)
+
+// fakeCores is the number of cores the fake backend reports. Their utilisation waves are
+// evenly out of phase, so the mean utilisation is always 50%.
+const fakeCores = 4
+
+// newTestController returns a started controller whose monitors read the fake backend.
+// The monitors sample as often as they can, so tests do not wait long for samples.
+func newTestController(t *testing.T, guardCfg resource.GuardConfig, maxTTL time.Duration) *Controller {
+	t.Helper()
+
+	b, err := backend.New("fake:4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := log.New(ioutil.Discard, "", 0)
+	hub := event.NewHub()
+	cores := runtime.NumCPU()
+	cpuLoad := cpu.NewLoad(cores, cpu.DefaultPeriod, l)
+	cpuMonitor := cpu.NewMonitor(cores, b, nil, hub, l)
+	memLoad := memory.NewLoad(l)
+	memMonitor := memory.NewMonitor(b, nil, memLoad, hub, l)
+	c := NewController(
+		cpuLoad,
+		memLoad,
+		disk.NewLoad(l),
+		network.NewLoad(l),
+		process.NewFDLoad(l),
+		process.NewThreadLoad(l),
+		process.NewGoroutineLoad(l),
+		cpuMonitor,
+		memMonitor,
+		disk.NewMonitor(b, hub, l),
+		network.NewMonitor(b, hub, l),
+		process.NewMonitor(hub, l),
+		b,
+		cpu.NewRegulator(cpuLoad, cpuMonitor, l),
+		profile.NewRunner(l),
+		scenario.NewRunner(l),
+		hub,
+		history.NewRecorder(hub, cpuMonitor, memMonitor, time.Minute, l),
+		expiry.New(maxTTL, l),
+		guard.New(guardCfg, cpuMonitor, memMonitor, hub, l),
+	)
+	intervals := make(map[string]time.Duration)
+	for res := range c.monitors() {
+		intervals[res] = resource.MinInterval
+	}
+	if err := c.SetMonitorIntervals(intervals); err != nil {
+		t.Fatal(err)
+	}
+	c.Start()
+
+	// The guardrails check the latest samples, so there must be some.
+	waitFor(t, "first samples", func() bool {
+		return len(c.CPUUtilisationLevels()) == fakeCores && c.MemStats().Total > 0
+	})
+	return c
+}
+
+// waitFor fails the test unless cond becomes true within a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestControllerReadsFakeBackend(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+
+	for i, v := range c.CPUStatus().Levels {
+		if v < 10 || v > 90 {
+			t.Errorf("core %d: utilisation %d%% out of the range of the fake backend", i, v)
+		}
+	}
+	if mem := c.MemStats(); mem.Total != 16384 || mem.Available < 6144 || mem.Available > 10240 {
+		t.Errorf("unexpected memory stats: total %d MB, available %d MB", mem.Total, mem.Available)
+	}
+	if s := c.MonitorStatus()["cpu"]; s.Backend != "fake" || s.IntervalMs != 100 {
+		t.Errorf("unexpected cpu monitor status: %+v", s)
+	}
+
+	sample, err := c.Sample("cpu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := sample.(backend.CPUSample); !ok || len(s.Cores) != fakeCores {
+		t.Errorf("unexpected cpu sample: %+v", sample)
+	}
+}
+
+func TestControllerUpdateCPULoad(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+
+	if err := c.UpdateCPULoad(10); err != nil {
+		t.Fatal(err)
+	}
+	s := c.CPUStatus()
+	if s.Feedback.Target != 10 {
+		t.Errorf("target: got %d, want 10", s.Feedback.Target)
+	}
+	for _, w := range s.Workers {
+		if w.Target != 10 {
+			t.Errorf("worker %+v: target: got %d, want 10", w, w.Target)
+		}
+	}
+	if n := c.LoadUpdates()["cpu"]; n != 1 {
+		t.Errorf("cpu updates: got %d, want 1", n)
+	}
+
+	if err := c.UpdateCPULoad(0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestControllerCPUCeiling(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{CPUCeiling: 60}, 0)
+	defer c.Stop()
+
+	// The fake host is 50% busy without any load.
+	err := c.UpdateCPULoad(20)
+	if _, ok := err.(*guard.Error); !ok {
+		t.Errorf("update above the ceiling: got %v, want a guardrail error", err)
+	}
+	if err := c.UpdateCPULoad(5); err != nil {
+		t.Errorf("update below the ceiling: %s", err)
+	}
+	c.UpdateCPULoad(0)
+
+	// The profile starts low, but reaches 20% later.
+	err = c.RunCPUProfile(profile.Ramp{From: 0, To: 20, Length: time.Minute})
+	if _, ok := err.(*guard.Error); !ok {
+		t.Errorf("profile above the ceiling: got %v, want a guardrail error", err)
+	}
+	if c.CPUProfileStatus().(profile.Status).Running {
+		t.Error("rejected profile is running")
+	}
+}
+
+func TestControllerMemFloor(t *testing.T) {
+	// The fake host never has more than 10 GiB available.
+	c := newTestController(t, resource.GuardConfig{MemFloorMB: 12 << 10}, 0)
+	defer c.Stop()
+
+	err := c.UpdateMemLoad(1)
+	if _, ok := err.(*guard.Error); !ok {
+		t.Errorf("update below the floor: got %v, want a guardrail error", err)
+	}
+	if err := c.UpdateMemLoad(0); err != nil {
+		t.Errorf("shrinking update: %s", err)
+	}
+}
+
+func TestControllerPause(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+
+	c.Pause()
+	if !c.PauseStatus().Paused {
+		t.Fatal("not paused")
+	}
+	err := c.Timed(expiry.TTL{}, []string{"cpu"}, func() error { return c.UpdateCPULoad(10) })
+	if err != errLoadsPaused {
+		t.Errorf("update while paused: got %v, want %v", err, errLoadsPaused)
+	}
+	if err := c.RunCPUProfile(profile.Ramp{To: 10, Length: time.Minute}); err != errLoadsPaused {
+		t.Errorf("profile while paused: got %v, want %v", err, errLoadsPaused)
+	}
+	if n := c.LoadUpdates()["cpu"]; n != 0 {
+		t.Errorf("cpu updates while paused: got %d, want 0", n)
+	}
+
+	c.Resume()
+	if err := c.Timed(expiry.TTL{}, []string{"cpu"}, func() error { return c.UpdateCPULoad(0) }); err != nil {
+		t.Errorf("update after resume: %s", err)
+	}
+}
+
+func TestControllerCPUProfileExpires(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 1500*time.Millisecond)
+	defer c.Stop()
+
+	// The level changes every second, which must not re-arm the expiry.
+	p := profile.Square{Low: 5, High: 10, Duty: 50, Period: 2 * time.Second}
+	if err := c.RunCPUProfile(p); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "profile to expire", func() bool {
+		return !c.CPUProfileStatus().(profile.Status).Running
+	})
+	if target := c.CPUStatus().Feedback.Target; target != 0 {
+		t.Errorf("target after expiry: got %d, want 0", target)
+	}
+}
@@ -9,10 +9,23 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"time"
 
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cgroup"
 	"github.com/milonoir/schwer/resource/cpu"
+	"github.com/milonoir/schwer/resource/disk"
+	"github.com/milonoir/schwer/resource/event"
+	"github.com/milonoir/schwer/resource/expiry"
+	"github.com/milonoir/schwer/resource/guard"
+	"github.com/milonoir/schwer/resource/history"
 	"github.com/milonoir/schwer/resource/memory"
+	"github.com/milonoir/schwer/resource/network"
+	"github.com/milonoir/schwer/resource/process"
+	"github.com/milonoir/schwer/resource/profile"
+	"github.com/milonoir/schwer/scenario"
 )
 
 const (
@@ -35,6 +48,19 @@ func main() {
 func _main() error {
 	// Parse command line args.
 	port := flag.Uint64("port", defaultPort, fmt.Sprintf("the port number (%d-%d) the server binds to", minPort, maxPort))
+	cpuPeriod := flag.Duration("cpu-period", cpu.DefaultPeriod, fmt.Sprintf("the duty cycle period (%s-%s) of the CPU load", cpu.MinPeriod, cpu.MaxPeriod))
+	historyWindow := flag.Duration("history", time.Hour, "how long monitor samples are retained for")
+	netSink := flag.String("net-sink", "", "address (host:port) to accept and discard network load traffic on")
+	backendSpec := flag.String("backend", backend.Default, fmt.Sprintf("the backend (%s) monitors read samples from, as name or name:arg", strings.Join(backend.Names(), ", ")))
+	scenarioPath := flag.String("scenario", "", "path to a YAML or JSON scenario file to execute on startup")
+	maxTTL := flag.Duration("max-ttl", 0, "the time after which loads revert to zero at the latest (0 is unlimited)")
+	memFloor := flag.String("mem-floor", "", "the available memory (in MB, or in % of total with a % suffix) the memory load never pushes the host below")
+	cgroupHeadroom := flag.Int64("cgroup-headroom", 0, "the memory (in MB) the memory load keeps free below the memory limit of the cgroup (0 is unchecked)")
+	cpuCeiling := flag.Int64("cpu-ceiling", 0, "the CPU utilisation (in %) the CPU load never pushes the host above (0 is unchecked)")
+	intervals := make(map[string]*time.Duration)
+	for _, res := range []string{"cpu", "mem", "disk", "net", "proc"} {
+		intervals[res] = flag.Duration(res+"-interval", resource.DefaultInterval, fmt.Sprintf("the sampling interval (%s-%s) of the %s monitor", resource.MinInterval, resource.MaxInterval, res))
+	}
 	flag.Parse()
 
 	// Validate port.
@@ -43,20 +69,151 @@ func _main() error {
 		return errors.New("invalid port number")
 	}
 
+	// Validate CPU load period.
+	if *cpuPeriod < cpu.MinPeriod || *cpuPeriod > cpu.MaxPeriod {
+		flag.Usage()
+		return errors.New("invalid CPU load period")
+	}
+
+	// Validate history window.
+	if *historyWindow <= 0 {
+		flag.Usage()
+		return errors.New("invalid history window")
+	}
+
+	// Validate maximum load TTL.
+	if *maxTTL < 0 {
+		flag.Usage()
+		return errors.New("invalid maximum load TTL")
+	}
+
+	// Validate guardrails.
+	guardCfg := resource.GuardConfig{CgroupHeadroomMB: *cgroupHeadroom, CPUCeiling: *cpuCeiling}
+	if err := guard.ParseMemFloor(*memFloor, &guardCfg); err != nil {
+		flag.Usage()
+		return fmt.Errorf("invalid guardrails: %s", err)
+	}
+	if err := guard.Validate(guardCfg); err != nil {
+		flag.Usage()
+		return fmt.Errorf("invalid guardrails: %s", err)
+	}
+
+	// Validate monitor sampling intervals.
+	for res, d := range intervals {
+		if err := resource.ValidateInterval(*d); err != nil {
+			flag.Usage()
+			return fmt.Errorf("invalid %s monitor interval", res)
+		}
+	}
+
+	// Load scenario up front, so an invalid file fails fast.
+	var plan *scenario.Plan
+	if *scenarioPath != "" {
+		var err error
+		if plan, err = scenario.Load(*scenarioPath); err != nil {
+			return fmt.Errorf("could not load scenario: %s", err)
+		}
+	}
+
 	// Setup logger.
 	logger := log.New(os.Stdout, "", log.LstdFlags)
 
 	// Setup load and monitoring.
+	cg := cgroup.Detect()
+	if cg != nil {
+		logger.Printf("monitoring cgroup v%d\n", cg.Version())
+	}
+	b, err := backend.New(*backendSpec)
+	if err != nil {
+		return fmt.Errorf("could not create monitor backend: %s", err)
+	}
+	if b.Name() != backend.Default {
+		logger.Printf("monitoring via %s backend\n", b.Name())
+	}
+	if *maxTTL > 0 {
+		logger.Printf("loads expire after %s at the latest\n", *maxTTL)
+	}
+	if guardCfg != (resource.GuardConfig{}) {
+		logger.Printf("guardrails: memory floor %d MB / %d%%, cgroup headroom %d MB, cpu ceiling %d%%\n", guardCfg.MemFloorMB, guardCfg.MemFloorPct, guardCfg.CgroupHeadroomMB, guardCfg.CPUCeiling)
+	}
+	hub := event.NewHub()
 	cores := runtime.NumCPU()
+	cpuLoad := cpu.NewLoad(cores, *cpuPeriod, logger)
+	cpuMonitor := cpu.NewMonitor(cores, b, cg, hub, logger)
+	memLoad := memory.NewLoad(logger)
+	memMonitor := memory.NewMonitor(b, cg, memLoad, hub, logger)
+	// The guardrails and the feedback loop keep the local loads in check, so they read the
+	// local host even if the monitors read another one (or fake samples).
+	var (
+		cpuMonitors resource.CPUMonitor = cpuMonitor
+		memMonitors resource.MemMonitor = memMonitor
+		localCPU    resource.CPUMonitor = cpuMonitor
+		localMem    resource.MemMonitor = memMonitor
+	)
+	if !b.Local() {
+		lb, err := backend.New(backend.Default)
+		if err != nil {
+			return fmt.Errorf("could not create local monitor backend: %s", err)
+		}
+		localCPU = cpu.NewMonitor(cores, lb, cg, nil, logger)
+		localMem = memory.NewMonitor(lb, cg, memLoad, nil, logger)
+		// The local monitors sample as often as the monitors of the backend.
+		cpuMonitors = localCPUMonitor{CPUMonitor: cpuMonitor, local: localCPU}
+		memMonitors = localMemMonitor{MemMonitor: memMonitor, local: localMem}
+		logger.Printf("guardrails and cpu feedback mode read the local host via %s backend\n", lb.Name())
+	}
+	netLoad := network.NewLoad(logger)
+	if *netSink != "" {
+		cfg := netLoad.Config()
+		cfg.Sink = *netSink
+		if err := netLoad.Configure(cfg); err != nil {
+			return fmt.Errorf("could not start network sink: %s", err)
+		}
+	}
 	c := NewController(
-		cpu.NewLoad(cores, logger),
-		memory.NewLoad(logger),
-		cpu.NewMonitor(cores, logger),
-		memory.NewMonitor(logger),
+		cpuLoad,
+		memLoad,
+		disk.NewLoad(logger),
+		netLoad,
+		process.NewFDLoad(logger),
+		process.NewThreadLoad(logger),
+		process.NewGoroutineLoad(logger),
+		cpuMonitors,
+		memMonitors,
+		disk.NewMonitor(b, hub, logger),
+		network.NewMonitor(b, hub, logger),
+		process.NewMonitor(hub, logger),
+		b,
+		cpu.NewRegulator(cpuLoad, localCPU, logger),
+		profile.NewRunner(logger),
+		scenario.NewRunner(logger),
+		hub,
+		history.NewRecorder(hub, cpuMonitor, memMonitor, *historyWindow, logger),
+		expiry.New(*maxTTL, logger),
+		guard.New(guardCfg, localCPU, localMem, hub, logger),
 	)
+	sampling := make(map[string]time.Duration, len(intervals))
+	for res, d := range intervals {
+		sampling[res] = *d
+	}
+	if err := c.SetMonitorIntervals(sampling); err != nil {
+		return err
+	}
+	if !b.Local() {
+		localCPU.Start()
+		defer localCPU.Stop()
+		localMem.Start()
+		defer localMem.Stop()
+	}
 	c.Start()
 	defer c.Stop()
 
+	if plan != nil {
+		if err := c.RunScenario(plan); err != nil {
+			return fmt.Errorf("could not run scenario: %s", err)
+		}
+	}
+
 	// Setup HTTP server.
 	server := newServer(*port, c, logger)
 
@@ -76,7 +233,52 @@ func _main() error {
 		}
 	}()
 
+	// Setup emergency stop signal handler.
+	if len(stopAllSignals) > 0 {
+		stopCh := make(chan os.Signal, 1)
+		signal.Notify(stopCh, stopAllSignals...)
+		defer signal.Stop(stopCh)
+		go func() {
+			for range stopCh {
+				logger.Println("stopping all loads...")
+				if err := c.StopAll(); err != nil {
+					logger.Printf("could not stop all loads: %s\n", err)
+				}
+			}
+		}()
+	}
+
 	// Run server.
 	logger.Printf("starting server on :%d\n", *port)
 	return server.ListenAndServe()
 }
+
+// localCPUMonitor is a CPU monitor which changes the sampling interval of the monitor
+// reading the local host along with its own.
+type localCPUMonitor struct {
+	resource.CPUMonitor
+	local resource.CPUMonitor
+}
+
+// SetInterval implements resource.Monitor.
+func (m localCPUMonitor) SetInterval(d time.Duration) error {
+	if err := m.CPUMonitor.SetInterval(d); err != nil {
+		return err
+	}
+	return m.local.SetInterval(d)
+}
+
+// localMemMonitor is a memory monitor which changes the sampling interval of the monitor
+// reading the local host along with its own.
+type localMemMonitor struct {
+	resource.MemMonitor
+	local resource.MemMonitor
+}
+
+// SetInterval implements resource.Monitor.
+func (m localMemMonitor) SetInterval(d time.Duration) error {
+	if err := m.MemMonitor.SetInterval(d); err != nil {
+		return err
+	}
+	return m.local.SetInterval(d)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cpu"
+	"github.com/milonoir/schwer/resource/memory"
+)
+
+func TestLocalMonitorIntervals(t *testing.T) {
+	b, err := backend.New("fake:4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := log.New(ioutil.Discard, "", 0)
+	memLoad := memory.NewLoad(l)
+	localCPU := cpu.NewMonitor(fakeCores, b, nil, nil, l)
+	localMem := memory.NewMonitor(b, nil, memLoad, nil, l)
+	cpuMonitor := localCPUMonitor{CPUMonitor: cpu.NewMonitor(fakeCores, b, nil, nil, l), local: localCPU}
+	memMonitor := localMemMonitor{MemMonitor: memory.NewMonitor(b, nil, memLoad, nil, l), local: localMem}
+
+	if err := cpuMonitor.SetInterval(250 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if err := memMonitor.SetInterval(5 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if d := localCPU.Interval(); d != 250*time.Millisecond {
+		t.Errorf("local cpu monitor interval: got %s, want 250ms", d)
+	}
+	if d := localMem.Interval(); d != 5*time.Second {
+		t.Errorf("local mem monitor interval: got %s, want 5s", d)
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// metricsHandler handles requests for:
+// - (GET) getting load targets and observed usage in Prometheus text exposition format.
+func metricsHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		m := &metricsWriter{w: bufio.NewWriter(w)}
+		writeMetrics(m, c)
+		m.w.Flush()
+	})
+}
+
+// writeMetrics writes every metric of the controller.
+func writeMetrics(m *metricsWriter, c *Controller) {
+	cpu := c.CPUStatus()
+	mem := c.MemStats()
+	memLoad := c.MemLoadStatus()
+	disk := c.DiskStats()
+	network := c.NetStats()
+	proc := c.ProcStats()
+	updates := c.LoadUpdates()
+
+	m.family("schwer_cpu_load_target_percent", "gauge", "Requested CPU load percentage.")
+	m.sample("schwer_cpu_load_target_percent", "", float64(cpu.Feedback.Target))
+
+	m.family("schwer_cpu_worker_target_percent", "gauge", "Target duty cycle of each CPU load worker.")
+	for i, wk := range cpu.Workers {
+		m.sample("schwer_cpu_worker_target_percent", workerLabels(i, wk), float64(wk.Target))
+	}
+
+	m.family("schwer_cpu_worker_achieved_percent", "gauge", "Achieved duty cycle of each CPU load worker.")
+	for i, wk := range cpu.Workers {
+		m.sample("schwer_cpu_worker_achieved_percent", workerLabels(i, wk), wk.Achieved)
+	}
+
+	m.family("schwer_cpu_feedback_enabled", "gauge", "Whether the closed-loop CPU load controller is enabled.")
+	m.sample("schwer_cpu_feedback_enabled", "", boolValue(cpu.Feedback.Enabled))
+
+	m.family("schwer_cpu_utilisation_percent", "gauge", "CPU utilisation of each core.")
+	for i, v := range cpu.Levels {
+		m.sample("schwer_cpu_utilisation_percent", label("core", strconv.Itoa(i)), float64(v))
+	}
+
+	m.family("schwer_cpu_mode_percent", "gauge", "Share of CPU time of all cores spent in each mode.")
+	for _, md := range []struct {
+		name  string
+		value float64
+	}{
+		{"user", cpu.Total.User},
+		{"nice", cpu.Total.Nice},
+		{"system", cpu.Total.System},
+		{"iowait", cpu.Total.Iowait},
+		{"irq", cpu.Total.Irq},
+		{"softirq", cpu.Total.Softirq},
+		{"steal", cpu.Total.Steal},
+		{"idle", cpu.Total.Idle},
+	} {
+		m.sample("schwer_cpu_mode_percent", label("mode", md.name), md.value)
+	}
+
+	m.family("schwer_cpu_steal_percent", "gauge", "Share of CPU time of each core stolen by the hypervisor.")
+	for i, md := range cpu.Modes {
+		m.sample("schwer_cpu_steal_percent", label("core", strconv.Itoa(i)), md.Steal)
+	}
+
+	if a := cpu.LoadAvg; a != nil {
+		m.family("schwer_load_average", "gauge", "Load average of the host.")
+		m.sample("schwer_load_average", label("period", "1m"), a.Load1)
+		m.sample("schwer_load_average", label("period", "5m"), a.Load5)
+		m.sample("schwer_load_average", label("period", "15m"), a.Load15)
+	}
+
+	if p := cpu.Process; p != nil {
+		m.family("schwer_process_cpu_percent", "gauge", "CPU usage of the Schwer process in percent of a single core.")
+		m.sample("schwer_process_cpu_percent", "", p.Percent)
+	}
+
+	if len(cpu.Frequency) > 0 {
+		m.family("schwer_cpu_frequency_mhz", "gauge", "Current frequency of each core.")
+		for i, f := range cpu.Frequency {
+			m.sample("schwer_cpu_frequency_mhz", label("core", strconv.Itoa(i)), f)
+		}
+	}
+
+	m.family("schwer_mem_load_target_megabytes", "gauge", "Requested memory allocation size.")
+	m.sample("schwer_mem_load_target_megabytes", "", float64(memLoad.Requested))
+
+	m.family("schwer_mem_load_allocated_bytes", "gauge", "Memory actually held by the memory load.")
+	m.sample("schwer_mem_load_allocated_bytes", "", float64(memLoad.Allocated))
+
+	m.family("schwer_mem_load_growth_mbps", "gauge", "Growth rate of the memory load in leak mode in MB/s.")
+	m.sample("schwer_mem_load_growth_mbps", "", memLoad.Growth)
+
+	if memLoad.Bandwidth != nil {
+		m.family("schwer_mem_bandwidth_target_gbps", "gauge", "Requested memory bandwidth in GB/s (0 is flat out).")
+		m.sample("schwer_mem_bandwidth_target_gbps", "", memLoad.Bandwidth.Config.Target)
+
+		m.family("schwer_mem_bandwidth_achieved_gbps", "gauge", "Memory bandwidth achieved over the last second in GB/s.")
+		m.sample("schwer_mem_bandwidth_achieved_gbps", "", memLoad.Bandwidth.Achieved)
+	}
+
+	m.family("schwer_memory_total_megabytes", "gauge", "Total memory of the host.")
+	m.sample("schwer_memory_total_megabytes", "", float64(mem.Total))
+
+	m.family("schwer_memory_available_megabytes", "gauge", "Available memory of the host.")
+	m.sample("schwer_memory_available_megabytes", "", float64(mem.Available))
+
+	m.family("schwer_memory_used_megabytes", "gauge", "Used memory of the host.")
+	m.sample("schwer_memory_used_megabytes", "", float64(mem.Used))
+
+	m.family("schwer_memory_used_percent", "gauge", "Used memory of the host in percent of total.")
+	m.sample("schwer_memory_used_percent", "", float64(mem.UsedPct))
+
+	m.family("schwer_memory_buffers_megabytes", "gauge", "Memory of the host used for kernel buffers.")
+	m.sample("schwer_memory_buffers_megabytes", "", float64(mem.Buffers))
+
+	m.family("schwer_memory_cached_megabytes", "gauge", "Memory of the host used for the page cache.")
+	m.sample("schwer_memory_cached_megabytes", "", float64(mem.Cached))
+
+	m.family("schwer_swap_total_megabytes", "gauge", "Total swap space of the host.")
+	m.sample("schwer_swap_total_megabytes", "", float64(mem.SwapTotal))
+
+	m.family("schwer_swap_used_megabytes", "gauge", "Used swap space of the host.")
+	m.sample("schwer_swap_used_megabytes", "", float64(mem.SwapUsed))
+
+	if p := mem.Process; p != nil {
+		m.family("schwer_process_resident_memory_megabytes", "gauge", "Resident set size of the Schwer process.")
+		m.sample("schwer_process_resident_memory_megabytes", "", float64(p.RSS))
+
+		m.family("schwer_process_virtual_memory_megabytes", "gauge", "Virtual memory size of the Schwer process.")
+		m.sample("schwer_process_virtual_memory_megabytes", "", float64(p.VMS))
+	}
+
+	m.family("schwer_go_heap_inuse_megabytes", "gauge", "Heap memory in use by the Go runtime.")
+	m.sample("schwer_go_heap_inuse_megabytes", "", float64(mem.Runtime.HeapInUse))
+
+	m.family("schwer_go_sys_megabytes", "gauge", "Memory obtained from the OS by the Go runtime.")
+	m.sample("schwer_go_sys_megabytes", "", float64(mem.Runtime.Sys))
+
+	m.family("schwer_go_gc_total", "counter", "Number of completed GC cycles.")
+	m.sample("schwer_go_gc_total", "", float64(mem.Runtime.NumGC))
+
+	m.family("schwer_go_gc_pause_milliseconds_total", "counter", "Total time spent in GC stop-the-world pauses.")
+	m.sample("schwer_go_gc_pause_milliseconds_total", "", mem.Runtime.PauseTotalMs)
+
+	m.family("schwer_disk_load_target_mbps", "gauge", "Requested disk load throughput in MB/s.")
+	m.sample("schwer_disk_load_target_mbps", "", float64(disk.Load.Config.Throughput))
+
+	m.family("schwer_disk_load_achieved_mbps", "gauge", "Disk load throughput achieved over the last second in MB/s.")
+	m.sample("schwer_disk_load_achieved_mbps", label("op", "read"), disk.Load.ReadMBps)
+	m.sample("schwer_disk_load_achieved_mbps", label("op", "write"), disk.Load.WriteMBps)
+
+	m.family("schwer_disk_throughput_mbps", "gauge", "Throughput of each block device in MB/s.")
+	for _, d := range disk.Devices {
+		m.sample("schwer_disk_throughput_mbps", fmt.Sprintf("{device=%q,op=\"read\"}", d.Name), d.ReadMBps)
+		m.sample("schwer_disk_throughput_mbps", fmt.Sprintf("{device=%q,op=\"write\"}", d.Name), d.WriteMBps)
+	}
+
+	m.family("schwer_net_load_target_mbits", "gauge", "Requested network load throughput in Mbit/s.")
+	m.sample("schwer_net_load_target_mbits", "", float64(network.Load.Config.Throughput))
+
+	m.family("schwer_net_load_achieved_mbits", "gauge", "Network load throughput achieved over the last second in Mbit/s.")
+	m.sample("schwer_net_load_achieved_mbits", label("direction", "tx"), network.Load.TxMbps)
+	m.sample("schwer_net_load_achieved_mbits", label("direction", "rx"), network.Load.RxMbps)
+
+	m.family("schwer_net_load_errors_total", "counter", "Number of network load send errors.")
+	m.sample("schwer_net_load_errors_total", "", float64(network.Load.Errors))
+
+	m.family("schwer_net_throughput_mbits", "gauge", "Throughput of each network interface in Mbit/s.")
+	for _, i := range network.Interfaces {
+		m.sample("schwer_net_throughput_mbits", fmt.Sprintf("{interface=%q,direction=\"rx\"}", i.Name), i.RxMbps)
+		m.sample("schwer_net_throughput_mbits", fmt.Sprintf("{interface=%q,direction=\"tx\"}", i.Name), i.TxMbps)
+	}
+
+	m.family("schwer_net_errors_total", "counter", "Number of errors of each network interface.")
+	for _, i := range network.Interfaces {
+		m.sample("schwer_net_errors_total", fmt.Sprintf("{interface=%q,direction=\"rx\"}", i.Name), float64(i.RxErrors))
+		m.sample("schwer_net_errors_total", fmt.Sprintf("{interface=%q,direction=\"tx\"}", i.Name), float64(i.TxErrors))
+	}
+
+	m.family("schwer_process_fds", "gauge", "Number of open file descriptors of the process.")
+	m.sample("schwer_process_fds", "", float64(proc.FDs))
+
+	m.family("schwer_process_threads", "gauge", "Number of OS threads of the process.")
+	m.sample("schwer_process_threads", "", float64(proc.Threads))
+
+	m.family("schwer_process_goroutines", "gauge", "Number of goroutines of the process.")
+	m.sample("schwer_process_goroutines", "", float64(proc.Goroutines))
+
+	if proc.Limits != nil {
+		m.family("schwer_process_fds_limit", "gauge", "Soft limit of open file descriptors of the process (-1 is unlimited).")
+		m.sample("schwer_process_fds_limit", "", float64(proc.Limits.FDs.Soft))
+	}
+
+	m.family("schwer_process_load_held", "gauge", "Number of items held by each process load.")
+	m.sample("schwer_process_load_held", label("load", "fds"), float64(proc.Loads.FDs.Held))
+	m.sample("schwer_process_load_held", label("load", "threads"), float64(proc.Loads.Threads.Held))
+	m.sample("schwer_process_load_held", label("load", "goroutines"), float64(proc.Loads.Goroutines.Held))
+
+	m.family("schwer_load_updates_total", "counter", "Number of load updates applied.")
+	for _, res := range []string{"cpu", "mem", "disk", "net", "proc"} {
+		m.sample("schwer_load_updates_total", label("resource", res), float64(updates[res]))
+	}
+
+	m.family("schwer_load_ttl_seconds", "gauge", "Time left until each load with a pending expiry reverts.")
+	ttls := c.LoadTTLs()
+	for _, res := range loadNames {
+		if s, ok := ttls[res]; ok {
+			m.sample("schwer_load_ttl_seconds", label("resource", res), s.Remaining)
+		}
+	}
+
+	m.family("schwer_guard_backoffs_total", "counter", "Number of times a guardrail backed off a load.")
+	backoffs := c.GuardStatus().Backoffs
+	for _, res := range []string{"cpu", "mem"} {
+		m.sample("schwer_guard_backoffs_total", label("resource", res), float64(backoffs[res]))
+	}
+
+	m.family("schwer_loads_paused", "gauge", "1 if load updates are paused.")
+	m.sample("schwer_loads_paused", "", boolValue(c.Paused()))
+}
+
+// metricsWriter writes metrics in Prometheus text exposition format.
+type metricsWriter struct {
+	w *bufio.Writer
+}
+
+// family writes the HELP and TYPE lines of a metric family.
+func (m *metricsWriter) family(name, typ, help string) {
+	fmt.Fprintf(m.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// sample writes a single sample. labels is either empty or a rendered label set.
+func (m *metricsWriter) sample(name, labels string, v float64) {
+	fmt.Fprintf(m.w, "%s%s %s\n", name, labels, strconv.FormatFloat(v, 'g', -1, 64))
+}
+
+// label renders a label set of a single label.
+func label(name, value string) string {
+	return fmt.Sprintf("{%s=%q}", name, value)
+}
+
+// workerLabels renders the label set of a CPU load worker.
+func workerLabels(i int, w resource.WorkerStatus) string {
+	return fmt.Sprintf("{worker=\"%d\",core=\"%d\"}", i, w.Core)
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
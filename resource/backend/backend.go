@@ -0,0 +1,81 @@
+// Package backend provides the sources monitors read raw resource samples from. Backends
+// are registered by name, so a monitor can read from gopsutil, from /proc directly, from
+// the cgroup filesystem, from a deterministic fake source or from a remote Schwer agent
+// without knowing which one it is.
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Default is the name of the backend used unless another one is selected.
+const Default = "gopsutil"
+
+// ErrNotSupported is returned by backends for resources they cannot sample.
+var ErrNotSupported = errors.New("not supported by backend")
+
+// Backend is implemented by sources of raw resource samples. Counters in samples are
+// cumulative, monitors derive rates from the difference of two samples.
+type Backend interface {
+	Name() string
+	// Local tells whether the samples are read from the host Schwer runs on.
+	Local() bool
+	CPU() (CPUSample, error)
+	Load() (LoadSample, error)
+	Memory() (MemSample, error)
+	Disk() (DiskSample, error)
+	Net() (NetSample, error)
+}
+
+// Factory creates a backend. arg is the part of the backend spec after the first colon,
+// e.g. the address of a remote agent, and is empty if there is none.
+type Factory func(arg string) (Backend, error)
+
+var (
+	factories = make(map[string]Factory)
+	mtx       sync.RWMutex
+)
+
+// Register makes a backend available by name. It panics if the name is already taken.
+func Register(name string, f Factory) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("backend %q is already registered", name))
+	}
+	factories[name] = f
+}
+
+// Names returns the names of the registered backends in alphabetical order.
+func Names() []string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates a backend from a spec of the form "name" or "name:arg".
+func New(spec string) (Backend, error) {
+	name, arg := spec, ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		name, arg = spec[:i], spec[i+1:]
+	}
+
+	mtx.RLock()
+	f, ok := factories[name]
+	mtx.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown backend: %q, available: %s", name, strings.Join(Names(), ", "))
+	}
+	return f(arg)
+}
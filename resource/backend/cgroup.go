@@ -0,0 +1,115 @@
+package backend
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"time"
+
+	"github.com/milonoir/schwer/resource/cgroup"
+)
+
+func init() {
+	Register("cgroup", func(string) (Backend, error) {
+		cg := cgroup.Detect()
+		if cg == nil {
+			return nil, errors.New("no cgroup detected")
+		}
+		stats, err := cg.CPU()
+		if err != nil {
+			return nil, err
+		}
+		return &cgroupBackend{
+			cg:    cg,
+			cores: runtime.NumCPU(),
+			start: time.Now(),
+			base:  stats.UsageUsec,
+		}, nil
+	})
+}
+
+// cgroupBackend reads samples of the cgroup Schwer runs in, so monitors report the usage
+// of the container relative to its limits instead of the usage of the host. The cgroup
+// only accounts total CPU time, which is reported as user time spread evenly across the
+// cores. Load averages, disk and network I/O are not supported.
+type cgroupBackend struct {
+	cg    *cgroup.Cgroup
+	cores int
+	start time.Time
+	// base is the CPU time the cgroup had used before the backend was created.
+	base uint64
+}
+
+// Name returns the name of the backend.
+func (*cgroupBackend) Name() string {
+	return "cgroup"
+}
+
+// Local tells whether the backend reads the local host.
+func (*cgroupBackend) Local() bool {
+	return true
+}
+
+// CPU returns the CPU time used by the cgroup since the backend was created. Idle time is
+// the rest of the time the cgroup could have used within its CPU limit.
+func (b *cgroupBackend) CPU() (CPUSample, error) {
+	stats, err := b.cg.CPU()
+	if err != nil {
+		return CPUSample{}, err
+	}
+	now := time.Now()
+
+	capacity := float64(b.cores)
+	if stats.QuotaCores > 0 {
+		capacity = stats.QuotaCores
+	}
+	used := float64(stats.UsageUsec-b.base) / float64(time.Second/time.Microsecond)
+	idle := math.Max(now.Sub(b.start).Seconds()*capacity-used, 0)
+
+	s := CPUSample{Time: now, Cores: make([]CPUTimes, b.cores)}
+	for i := range s.Cores {
+		s.Cores[i] = CPUTimes{
+			User: used / float64(b.cores),
+			Idle: idle / float64(b.cores),
+		}
+	}
+	return s, nil
+}
+
+// Load is not supported, the kernel does not track load averages per cgroup.
+func (*cgroupBackend) Load() (LoadSample, error) {
+	return LoadSample{}, ErrNotSupported
+}
+
+// Memory returns the memory usage of the cgroup. Total is the memory limit of the cgroup,
+// or the memory of the host if it has none.
+func (b *cgroupBackend) Memory() (MemSample, error) {
+	stats, err := b.cg.Memory()
+	if err != nil {
+		return MemSample{}, err
+	}
+
+	total := stats.Limit
+	if total == 0 {
+		host, err := gopsutilBackend{}.Memory()
+		if err != nil {
+			return MemSample{}, err
+		}
+		total = host.Total
+	}
+	s := MemSample{Time: time.Now(), Total: total, Used: stats.Usage}
+	if s.Used < s.Total {
+		s.Available = s.Total - s.Used
+	}
+	return s, nil
+}
+
+// Disk is not supported.
+func (*cgroupBackend) Disk() (DiskSample, error) {
+	return DiskSample{}, ErrNotSupported
+}
+
+// Net is not supported.
+func (*cgroupBackend) Net() (NetSample, error) {
+	return NetSample{}, ErrNotSupported
+}
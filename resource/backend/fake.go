@@ -0,0 +1,169 @@
+package backend
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	gibiBytes = 1 << 30
+	mebiBytes = 1 << 20
+
+	// fakePeriod is the period of the utilisation waves of the fake backend.
+	fakePeriod = 60
+)
+
+// fakeEpoch is the time of the first sample of the fake backend.
+var fakeEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func init() {
+	Register("fake", func(arg string) (Backend, error) {
+		cores := runtime.NumCPU()
+		if arg != "" {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("number of cores must be positive, got: %q", arg)
+			}
+			cores = n
+		}
+		return &fakeBackend{cpu: make([]CPUTimes, cores)}, nil
+	})
+}
+
+// fakeBackend generates deterministic samples. Every call advances the clock of the
+// resource by a second, regardless of how much time actually passed, so the same sequence
+// of calls always yields the same stats. The utilisation of each core and the memory usage
+// follow sine waves, disk and network traffic are constant.
+type fakeBackend struct {
+	cpu   []CPUTimes
+	steps map[string]int
+	disk  DiskCounters
+	net   NetCounters
+	mtx   sync.Mutex
+}
+
+// Name returns the name of the backend.
+func (*fakeBackend) Name() string {
+	return "fake"
+}
+
+// Local tells whether the backend reads the local host.
+func (*fakeBackend) Local() bool {
+	return false
+}
+
+// CPU returns the CPU times of every core. Busy time is split between user, system,
+// iowait and steal time in a fixed ratio.
+func (b *fakeBackend) CPU() (CPUSample, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t := b.step("cpu")
+	for i := range b.cpu {
+		busy := wave(t, float64(i)/float64(len(b.cpu)))
+		b.cpu[i] = b.cpu[i].Add(CPUTimes{
+			User:   busy * 0.7,
+			System: busy * 0.2,
+			Iowait: busy * 0.05,
+			Steal:  busy * 0.05,
+			Idle:   1 - busy,
+		})
+	}
+	s := CPUSample{Time: fakeTime(t), Cores: make([]CPUTimes, len(b.cpu))}
+	copy(s.Cores, b.cpu)
+	return s, nil
+}
+
+// Load returns load averages which follow the average utilisation of the cores.
+func (b *fakeBackend) Load() (LoadSample, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t := b.step("load")
+	cores := float64(len(b.cpu))
+	return LoadSample{
+		Time:   fakeTime(t),
+		Load1:  round(cores * wave(t, 0)),
+		Load5:  round(cores * 0.5),
+		Load15: round(cores * 0.5),
+	}, nil
+}
+
+// Memory returns the usage of 16 GiB of memory, of which 6-10 GiB is used.
+func (b *fakeBackend) Memory() (MemSample, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t := b.step("mem")
+	s := MemSample{
+		Time:      fakeTime(t),
+		Total:     16 * gibiBytes,
+		Used:      uint64(8*gibiBytes + 2*gibiBytes*math.Sin(2*math.Pi*float64(t)/fakePeriod)),
+		Buffers:   256 * mebiBytes,
+		Cached:    2 * gibiBytes,
+		SwapTotal: 4 * gibiBytes,
+	}
+	s.Available = s.Total - s.Used
+	return s, nil
+}
+
+// Disk returns the counters of a device reading 50 MB/s and writing 20 MB/s in 100 kB
+// requests, each of them taking 1ms.
+func (b *fakeBackend) Disk() (DiskSample, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t := b.step("disk")
+	b.disk.Name = "fake0"
+	b.disk.ReadCount += 500
+	b.disk.ReadBytes += 500 * 100000
+	b.disk.ReadTimeMs += 500
+	b.disk.WriteCount += 200
+	b.disk.WriteBytes += 200 * 100000
+	b.disk.WriteTimeMs += 200
+	return DiskSample{Time: fakeTime(t), Devices: []DiskCounters{b.disk}}, nil
+}
+
+// Net returns the counters of an interface receiving 80 Mbit/s and sending 40 Mbit/s in
+// 1000 byte packets.
+func (b *fakeBackend) Net() (NetSample, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	t := b.step("net")
+	b.net.Name = "fake0"
+	b.net.BytesRecv += 10000000
+	b.net.PktsRecv += 10000
+	b.net.BytesSent += 5000000
+	b.net.PktsSent += 5000
+	return NetSample{Time: fakeTime(t), Interfaces: []NetCounters{b.net}}, nil
+}
+
+// step advances the clock of a resource and returns its new value in seconds.
+func (b *fakeBackend) step(res string) int {
+	if b.steps == nil {
+		b.steps = make(map[string]int)
+	}
+	b.steps[res]++
+	return b.steps[res]
+}
+
+// wave returns a utilisation between 0.1 and 0.9 at time t, shifted by phase (0-1) of
+// fakePeriod.
+func wave(t int, phase float64) float64 {
+	return 0.5 + 0.4*math.Sin(2*math.Pi*(float64(t)/fakePeriod+phase))
+}
+
+// fakeTime returns the time of the sample taken t seconds after fakeEpoch.
+func fakeTime(t int) time.Time {
+	return fakeEpoch.Add(time.Duration(t) * time.Second)
+}
+
+// round rounds v to two decimals.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,128 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/load"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/net"
+)
+
+func init() {
+	Register("gopsutil", func(string) (Backend, error) {
+		return gopsutilBackend{}, nil
+	})
+}
+
+// gopsutilBackend reads samples of the local host with gopsutil, which works on every
+// platform it supports.
+type gopsutilBackend struct{}
+
+// Name returns the name of the backend.
+func (gopsutilBackend) Name() string {
+	return "gopsutil"
+}
+
+// Local tells whether the backend reads the local host.
+func (gopsutilBackend) Local() bool {
+	return true
+}
+
+// CPU returns the CPU times of every core.
+func (gopsutilBackend) CPU() (CPUSample, error) {
+	times, err := cpu.Times(true)
+	if err != nil {
+		return CPUSample{}, err
+	}
+	s := CPUSample{Time: time.Now(), Cores: make([]CPUTimes, len(times))}
+	for i, t := range times {
+		s.Cores[i] = CPUTimes{
+			User:    t.User,
+			Nice:    t.Nice,
+			System:  t.System,
+			Idle:    t.Idle,
+			Iowait:  t.Iowait,
+			Irq:     t.Irq,
+			Softirq: t.Softirq,
+			Steal:   t.Steal + t.Stolen,
+		}
+	}
+	return s, nil
+}
+
+// Load returns the load averages.
+func (gopsutilBackend) Load() (LoadSample, error) {
+	avg, err := load.Avg()
+	if err != nil {
+		return LoadSample{}, err
+	}
+	return LoadSample{Time: time.Now(), Load1: avg.Load1, Load5: avg.Load5, Load15: avg.Load15}, nil
+}
+
+// Memory returns the memory and swap usage.
+func (gopsutilBackend) Memory() (MemSample, error) {
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return MemSample{}, err
+	}
+	s := MemSample{
+		Time:      time.Now(),
+		Total:     vm.Total,
+		Available: vm.Available,
+		Used:      vm.Used,
+		Buffers:   vm.Buffers,
+		Cached:    vm.Cached,
+	}
+	swap, err := mem.SwapMemory()
+	if err != nil {
+		return MemSample{}, err
+	}
+	s.SwapTotal, s.SwapUsed = swap.Total, swap.Used
+	return s, nil
+}
+
+// Disk returns the I/O counters of every block device.
+func (gopsutilBackend) Disk() (DiskSample, error) {
+	counters, err := disk.IOCounters()
+	if err != nil {
+		return DiskSample{}, err
+	}
+	s := DiskSample{Time: time.Now(), Devices: make([]DiskCounters, 0, len(counters))}
+	for name, c := range counters {
+		s.Devices = append(s.Devices, DiskCounters{
+			Name:        name,
+			ReadCount:   c.ReadCount,
+			WriteCount:  c.WriteCount,
+			ReadBytes:   c.ReadBytes,
+			WriteBytes:  c.WriteBytes,
+			ReadTimeMs:  c.ReadTime,
+			WriteTimeMs: c.WriteTime,
+		})
+	}
+	return s, nil
+}
+
+// Net returns the traffic counters of every network interface.
+func (gopsutilBackend) Net() (NetSample, error) {
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		return NetSample{}, err
+	}
+	s := NetSample{Time: time.Now(), Interfaces: make([]NetCounters, len(counters))}
+	for i, c := range counters {
+		s.Interfaces[i] = NetCounters{
+			Name:      c.Name,
+			BytesSent: c.BytesSent,
+			BytesRecv: c.BytesRecv,
+			PktsSent:  c.PacketsSent,
+			PktsRecv:  c.PacketsRecv,
+			ErrIn:     c.Errin,
+			ErrOut:    c.Errout,
+			DropIn:    c.Dropin,
+			DropOut:   c.Dropout,
+		}
+	}
+	return s, nil
+}
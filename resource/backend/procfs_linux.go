@@ -0,0 +1,217 @@
+package backend
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// clockTicks is the unit of CPU times in /proc/stat (USER_HZ), which is 100 on every
+	// architecture Linux exposes to userspace.
+	clockTicks = 100
+
+	// sectorSize is the unit of /proc/diskstats, regardless of the device's sector size.
+	sectorSize = 512
+)
+
+func init() {
+	Register("procfs", func(arg string) (Backend, error) {
+		root := "/proc"
+		if arg != "" {
+			root = arg
+		}
+		if _, err := os.Stat(filepath.Join(root, "stat")); err != nil {
+			return nil, fmt.Errorf("could not read procfs: %s", err)
+		}
+		return procfsBackend{root: root}, nil
+	})
+}
+
+// procfsBackend reads samples of the local host from /proc directly, or from another
+// procfs mount (e.g. the host's /proc mounted into a container) given as arg.
+type procfsBackend struct {
+	root string
+}
+
+// Name returns the name of the backend.
+func (procfsBackend) Name() string {
+	return "procfs"
+}
+
+// Local tells whether the backend reads the local host.
+func (procfsBackend) Local() bool {
+	return true
+}
+
+// CPU returns the CPU times of every core from /proc/stat.
+func (b procfsBackend) CPU() (CPUSample, error) {
+	s := CPUSample{Time: time.Now()}
+	err := b.scan("stat", func(fields []string) error {
+		// The aggregate line is "cpu", per-core lines are "cpuN".
+		if len(fields) < 9 || !strings.HasPrefix(fields[0], "cpu") || fields[0] == "cpu" {
+			return nil
+		}
+		v, err := parseUints(fields[1:9])
+		if err != nil {
+			return err
+		}
+		s.Cores = append(s.Cores, CPUTimes{
+			User:    float64(v[0]) / clockTicks,
+			Nice:    float64(v[1]) / clockTicks,
+			System:  float64(v[2]) / clockTicks,
+			Idle:    float64(v[3]) / clockTicks,
+			Iowait:  float64(v[4]) / clockTicks,
+			Irq:     float64(v[5]) / clockTicks,
+			Softirq: float64(v[6]) / clockTicks,
+			Steal:   float64(v[7]) / clockTicks,
+		})
+		return nil
+	})
+	return s, err
+}
+
+// Load returns the load averages from /proc/loadavg.
+func (b procfsBackend) Load() (LoadSample, error) {
+	data, err := ioutil.ReadFile(filepath.Join(b.root, "loadavg"))
+	if err != nil {
+		return LoadSample{}, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 3 {
+		return LoadSample{}, fmt.Errorf("unexpected loadavg format: %q", data)
+	}
+	s := LoadSample{Time: time.Now()}
+	for i, v := range []*float64{&s.Load1, &s.Load5, &s.Load15} {
+		if *v, err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return LoadSample{}, err
+		}
+	}
+	return s, nil
+}
+
+// Memory returns the memory and swap usage from /proc/meminfo. Used memory is calculated
+// the way free(1) does.
+func (b procfsBackend) Memory() (MemSample, error) {
+	info := make(map[string]uint64)
+	err := b.scan("meminfo", func(fields []string) error {
+		if len(fields) < 2 {
+			return nil
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		// Values are in kB.
+		info[strings.TrimSuffix(fields[0], ":")] = v * 1024
+		return nil
+	})
+	if err != nil {
+		return MemSample{}, err
+	}
+
+	s := MemSample{
+		Time:      time.Now(),
+		Total:     info["MemTotal"],
+		Available: info["MemAvailable"],
+		Buffers:   info["Buffers"],
+		Cached:    info["Cached"] + info["SReclaimable"],
+		SwapTotal: info["SwapTotal"],
+		SwapUsed:  info["SwapTotal"] - info["SwapFree"],
+	}
+	if used := info["MemFree"] + s.Buffers + s.Cached; used < s.Total {
+		s.Used = s.Total - used
+	}
+	return s, nil
+}
+
+// Disk returns the I/O counters of every block device from /proc/diskstats.
+func (b procfsBackend) Disk() (DiskSample, error) {
+	s := DiskSample{Time: time.Now()}
+	err := b.scan("diskstats", func(fields []string) error {
+		if len(fields) < 11 {
+			return nil
+		}
+		v, err := parseUints(fields[3:11])
+		if err != nil {
+			return err
+		}
+		s.Devices = append(s.Devices, DiskCounters{
+			Name:        fields[2],
+			ReadCount:   v[0],
+			ReadBytes:   v[2] * sectorSize,
+			ReadTimeMs:  v[3],
+			WriteCount:  v[4],
+			WriteBytes:  v[6] * sectorSize,
+			WriteTimeMs: v[7],
+		})
+		return nil
+	})
+	return s, err
+}
+
+// Net returns the traffic counters of every network interface from /proc/net/dev.
+func (b procfsBackend) Net() (NetSample, error) {
+	s := NetSample{Time: time.Now()}
+	err := b.scan(filepath.Join("net", "dev"), func(fields []string) error {
+		// Skip the two header lines, which have no colon after the first field.
+		if len(fields) < 17 || !strings.HasSuffix(fields[0], ":") {
+			return nil
+		}
+		v, err := parseUints(fields[1:17])
+		if err != nil {
+			return err
+		}
+		s.Interfaces = append(s.Interfaces, NetCounters{
+			Name:      strings.TrimSuffix(fields[0], ":"),
+			BytesRecv: v[0],
+			PktsRecv:  v[1],
+			ErrIn:     v[2],
+			DropIn:    v[3],
+			BytesSent: v[8],
+			PktsSent:  v[9],
+			ErrOut:    v[10],
+			DropOut:   v[11],
+		})
+		return nil
+	})
+	return s, err
+}
+
+// scan calls fn with the whitespace separated fields of every line of a procfs file.
+func (b procfsBackend) scan(name string, fn func(fields []string) error) error {
+	f, err := os.Open(filepath.Join(b.root, name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		// Interface names in /proc/net/dev may be glued to their first counter.
+		line = strings.Replace(line, ":", ": ", 1)
+		if err := fn(strings.Fields(line)); err != nil {
+			return fmt.Errorf("could not parse %s: %s", name, err)
+		}
+	}
+	return sc.Err()
+}
+
+// parseUints parses every field as an unsigned integer.
+func parseUints(fields []string) ([]uint64, error) {
+	v := make([]uint64, len(fields))
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		v[i] = n
+	}
+	return v, nil
+}
@@ -0,0 +1,115 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// remoteTimeout bounds every request to a remote agent.
+const remoteTimeout = 5 * time.Second
+
+func init() {
+	Register("remote", func(arg string) (Backend, error) {
+		if arg == "" {
+			return nil, errors.New("address of the remote agent is required, e.g. remote:http://host:9999")
+		}
+		u, err := url.Parse(arg)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("invalid address of the remote agent: %q", arg)
+		}
+		return &remoteBackend{
+			url:    strings.TrimSuffix(arg, "/") + "/sample",
+			client: &http.Client{Timeout: remoteTimeout},
+		}, nil
+	})
+}
+
+// remoteBackend reads samples from the /sample endpoint of another Schwer instance, so
+// the host it runs on can be monitored (and loaded) from here.
+type remoteBackend struct {
+	url    string
+	client *http.Client
+}
+
+// Name returns the name of the backend.
+func (*remoteBackend) Name() string {
+	return "remote"
+}
+
+// Local tells whether the backend reads the local host.
+func (*remoteBackend) Local() bool {
+	return false
+}
+
+// CPU returns the CPU times of every core of the remote host.
+func (b *remoteBackend) CPU() (CPUSample, error) {
+	var s CPUSample
+	return s, b.get("cpu", &s)
+}
+
+// Load returns the load averages of the remote host.
+func (b *remoteBackend) Load() (LoadSample, error) {
+	var s LoadSample
+	return s, b.get("load", &s)
+}
+
+// Memory returns the memory and swap usage of the remote host.
+func (b *remoteBackend) Memory() (MemSample, error) {
+	var s MemSample
+	return s, b.get("mem", &s)
+}
+
+// Disk returns the I/O counters of every block device of the remote host.
+func (b *remoteBackend) Disk() (DiskSample, error) {
+	var s DiskSample
+	return s, b.get("disk", &s)
+}
+
+// Net returns the traffic counters of every network interface of the remote host.
+func (b *remoteBackend) Net() (NetSample, error) {
+	var s NetSample
+	return s, b.get("net", &s)
+}
+
+// get fetches a sample of a resource and decodes it into v.
+func (b *remoteBackend) get(res string, v interface{}) error {
+	resp, err := b.client.Get(b.url + "?res=" + res)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(v)
+	case http.StatusNotImplemented:
+		return ErrNotSupported
+	default:
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("remote agent responded with %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+}
+
+// Sample returns the sample of a resource from b. It is the counterpart of the remote
+// backend, serving samples to other instances.
+func Sample(b Backend, res string) (interface{}, error) {
+	switch res {
+	case "cpu":
+		return b.CPU()
+	case "load":
+		return b.Load()
+	case "mem":
+		return b.Memory()
+	case "disk":
+		return b.Disk()
+	case "net":
+		return b.Net()
+	}
+	return nil, fmt.Errorf("unknown resource: %q", res)
+}
@@ -0,0 +1,108 @@
+package backend
+
+import (
+	"time"
+)
+
+// CPUSample holds the cumulative CPU times of every core.
+type CPUSample struct {
+	Time  time.Time  `json:"time"`
+	Cores []CPUTimes `json:"cores"`
+}
+
+// CPUTimes is the time (in seconds) a core spent in each mode. Guest time is accounted in
+// User and Nice by the kernel, so it is not reported separately.
+type CPUTimes struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Idle    float64 `json:"idle"`
+	Iowait  float64 `json:"iowait"`
+	Irq     float64 `json:"irq"`
+	Softirq float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
+}
+
+// Total returns the time spent in all modes.
+func (t CPUTimes) Total() float64 {
+	return t.User + t.Nice + t.System + t.Idle + t.Iowait + t.Irq + t.Softirq + t.Steal
+}
+
+// Add returns the sum of the times of t and o.
+func (t CPUTimes) Add(o CPUTimes) CPUTimes {
+	return CPUTimes{
+		User:    t.User + o.User,
+		Nice:    t.Nice + o.Nice,
+		System:  t.System + o.System,
+		Idle:    t.Idle + o.Idle,
+		Iowait:  t.Iowait + o.Iowait,
+		Irq:     t.Irq + o.Irq,
+		Softirq: t.Softirq + o.Softirq,
+		Steal:   t.Steal + o.Steal,
+	}
+}
+
+// LoadSample holds the 1, 5 and 15 minute load averages.
+type LoadSample struct {
+	Time   time.Time `json:"time"`
+	Load1  float64   `json:"load1"`
+	Load5  float64   `json:"load5"`
+	Load15 float64   `json:"load15"`
+}
+
+// MemSample holds memory and swap usage in bytes.
+type MemSample struct {
+	Time      time.Time `json:"time"`
+	Total     uint64    `json:"total"`
+	Available uint64    `json:"available"`
+	Used      uint64    `json:"used"`
+	Buffers   uint64    `json:"buffers"`
+	Cached    uint64    `json:"cached"`
+	SwapTotal uint64    `json:"swap_total"`
+	SwapUsed  uint64    `json:"swap_used"`
+}
+
+// UsedPct returns the used memory in percent of total.
+func (s MemSample) UsedPct() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Used) / float64(s.Total) * 100
+}
+
+// DiskSample holds the cumulative I/O counters of every block device.
+type DiskSample struct {
+	Time    time.Time      `json:"time"`
+	Devices []DiskCounters `json:"devices"`
+}
+
+// DiskCounters are the I/O counters of a block device. Read and write times are the total
+// time spent on completed requests in milliseconds.
+type DiskCounters struct {
+	Name        string `json:"name"`
+	ReadCount   uint64 `json:"read_count"`
+	WriteCount  uint64 `json:"write_count"`
+	ReadBytes   uint64 `json:"read_bytes"`
+	WriteBytes  uint64 `json:"write_bytes"`
+	ReadTimeMs  uint64 `json:"read_time_ms"`
+	WriteTimeMs uint64 `json:"write_time_ms"`
+}
+
+// NetSample holds the cumulative traffic counters of every network interface.
+type NetSample struct {
+	Time       time.Time     `json:"time"`
+	Interfaces []NetCounters `json:"interfaces"`
+}
+
+// NetCounters are the traffic counters of a network interface.
+type NetCounters struct {
+	Name      string `json:"name"`
+	BytesSent uint64 `json:"bytes_sent"`
+	BytesRecv uint64 `json:"bytes_recv"`
+	PktsSent  uint64 `json:"packets_sent"`
+	PktsRecv  uint64 `json:"packets_recv"`
+	ErrIn     uint64 `json:"errin"`
+	ErrOut    uint64 `json:"errout"`
+	DropIn    uint64 `json:"dropin"`
+	DropOut   uint64 `json:"dropout"`
+}
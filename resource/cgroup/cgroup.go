@@ -0,0 +1,299 @@
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	root = "/sys/fs/cgroup"
+
+	// unlimited is the lowest v1 limit value considered "no limit". The kernel reports
+	// the largest page-aligned int64 when no limit is set.
+	unlimited = math.MaxInt64 / 2
+)
+
+// errNoStat is returned when a stat file has no value for a key.
+var errNoStat = errors.New("key not found")
+
+// Cgroup reads container-scoped resource usage and limits of the current process from
+// the cgroup filesystem. Both cgroup v1 and v2 are supported.
+type Cgroup struct {
+	version    int
+	cpuDir     string
+	cpuacctDir string
+	memDir     string
+}
+
+// CPUStats is a snapshot of the cgroup's CPU accounting.
+type CPUStats struct {
+	// UsageUsec is the total CPU time consumed by the cgroup.
+	UsageUsec uint64
+	// QuotaCores is the CPU limit in cores. Zero means unlimited.
+	QuotaCores    float64
+	NrPeriods     uint64
+	NrThrottled   uint64
+	ThrottledUsec uint64
+}
+
+// MemStats is a snapshot of the cgroup's memory accounting.
+type MemStats struct {
+	Usage uint64
+	// Limit is the memory limit in bytes. Zero means unlimited.
+	Limit uint64
+	// Pressure is only available on cgroup v2 with PSI enabled.
+	Pressure *Pressure
+}
+
+// Pressure holds the memory pressure stall averages (in %) over 10s and 60s windows.
+type Pressure struct {
+	SomeAvg10 float64
+	SomeAvg60 float64
+	FullAvg10 float64
+	FullAvg60 float64
+}
+
+// Detect returns the cgroup of the current process, or nil if it cannot be determined
+// (e.g. not running on Linux).
+func Detect() *Cgroup {
+	paths, err := procCgroups("/proc/self/cgroup")
+	if err != nil {
+		return nil
+	}
+
+	if exists(filepath.Join(root, "cgroup.controllers")) {
+		dir := resolve(root, paths[""])
+		return &Cgroup{version: 2, cpuDir: dir, cpuacctDir: dir, memDir: dir}
+	}
+
+	c := &Cgroup{
+		version:    1,
+		cpuDir:     resolve(filepath.Join(root, "cpu"), paths["cpu"]),
+		cpuacctDir: resolve(filepath.Join(root, "cpuacct"), paths["cpuacct"]),
+		memDir:     resolve(filepath.Join(root, "memory"), paths["memory"]),
+	}
+	if !exists(c.cpuDir) || !exists(c.memDir) {
+		return nil
+	}
+	return c
+}
+
+// Version returns the cgroup version (1 or 2).
+func (c *Cgroup) Version() int {
+	return c.version
+}
+
+// CPU returns the cgroup's CPU usage, limit and throttling counters.
+func (c *Cgroup) CPU() (CPUStats, error) {
+	if c.version == 2 {
+		return c.cpuV2()
+	}
+	return c.cpuV1()
+}
+
+// Memory returns the cgroup's memory usage, limit and pressure.
+func (c *Cgroup) Memory() (MemStats, error) {
+	if c.version == 2 {
+		return c.memoryV2()
+	}
+	return c.memoryV1()
+}
+
+func (c *Cgroup) cpuV2() (CPUStats, error) {
+	var s CPUStats
+
+	stat, err := readKeyValues(filepath.Join(c.cpuDir, "cpu.stat"))
+	if err != nil {
+		return s, err
+	}
+	s.UsageUsec = stat["usage_usec"]
+	s.NrPeriods = stat["nr_periods"]
+	s.NrThrottled = stat["nr_throttled"]
+	s.ThrottledUsec = stat["throttled_usec"]
+
+	// cpu.max is "$MAX $PERIOD", where $MAX may be "max". It does not exist in the root cgroup.
+	b, err := ioutil.ReadFile(filepath.Join(c.cpuDir, "cpu.max"))
+	if err == nil {
+		f := strings.Fields(string(b))
+		if len(f) == 2 && f[0] != "max" {
+			quota, err1 := strconv.ParseFloat(f[0], 64)
+			period, err2 := strconv.ParseFloat(f[1], 64)
+			if err1 == nil && err2 == nil && period > 0 {
+				s.QuotaCores = quota / period
+			}
+		}
+	}
+	return s, nil
+}
+
+func (c *Cgroup) cpuV1() (CPUStats, error) {
+	var s CPUStats
+
+	usage, err := readUint(filepath.Join(c.cpuacctDir, "cpuacct.usage"))
+	if err != nil {
+		return s, err
+	}
+	s.UsageUsec = usage / 1000
+
+	stat, err := readKeyValues(filepath.Join(c.cpuDir, "cpu.stat"))
+	if err != nil {
+		return s, err
+	}
+	s.NrPeriods = stat["nr_periods"]
+	s.NrThrottled = stat["nr_throttled"]
+	s.ThrottledUsec = stat["throttled_time"] / 1000
+
+	// cfs_quota_us is -1 when unlimited, which fails to parse as uint.
+	quota, err1 := readUint(filepath.Join(c.cpuDir, "cpu.cfs_quota_us"))
+	period, err2 := readUint(filepath.Join(c.cpuDir, "cpu.cfs_period_us"))
+	if err1 == nil && err2 == nil && period > 0 {
+		s.QuotaCores = float64(quota) / float64(period)
+	}
+	return s, nil
+}
+
+func (c *Cgroup) memoryV2() (MemStats, error) {
+	var s MemStats
+
+	usage, err := readUint(filepath.Join(c.memDir, "memory.current"))
+	if err != nil {
+		return s, err
+	}
+	s.Usage = usage
+
+	// memory.max is "max" when unlimited, which fails to parse as uint.
+	if limit, err := readUint(filepath.Join(c.memDir, "memory.max")); err == nil {
+		s.Limit = limit
+	}
+
+	if p, err := readPressure(filepath.Join(c.memDir, "memory.pressure")); err == nil {
+		s.Pressure = p
+	}
+	return s, nil
+}
+
+func (c *Cgroup) memoryV1() (MemStats, error) {
+	var s MemStats
+
+	usage, err := readUint(filepath.Join(c.memDir, "memory.usage_in_bytes"))
+	if err != nil {
+		return s, err
+	}
+	s.Usage = usage
+
+	if limit, err := readUint(filepath.Join(c.memDir, "memory.limit_in_bytes")); err == nil && limit < unlimited {
+		s.Limit = limit
+	}
+	return s, nil
+}
+
+// procCgroups parses /proc/<pid>/cgroup into a map of controller to cgroup path. The
+// cgroup v2 hierarchy is stored under the empty controller name.
+func procCgroups(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	paths := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		// Lines are "hierarchy-ID:controller-list:cgroup-path".
+		parts := strings.SplitN(sc.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		for _, ctrl := range strings.Split(parts[1], ",") {
+			paths[ctrl] = parts[2]
+		}
+	}
+	return paths, sc.Err()
+}
+
+// resolve joins the cgroup path to the mount point. Inside a container with its own
+// cgroup namespace the path may not exist under the mount point, in which case the mount
+// point itself is the process's cgroup.
+func resolve(mount, path string) string {
+	if dir := filepath.Join(mount, path); exists(dir) {
+		return dir
+	}
+	return mount
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func readUint(path string) (uint64, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+}
+
+// readKeyValues parses flat keyed files such as cpu.stat.
+func readKeyValues(path string) (map[string]uint64, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := make(map[string]uint64)
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(f[1], 10, 64); err == nil {
+			kv[f[0]] = v
+		}
+	}
+	return kv, nil
+}
+
+// readPressure parses a PSI file, e.g.:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPressure(path string) (*Pressure, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	avgs := make(map[string]float64)
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		for _, kv := range f[1:] {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			if v, err := strconv.ParseFloat(parts[1], 64); err == nil {
+				avgs[f[0]+"."+parts[0]] = v
+			}
+		}
+	}
+	if _, ok := avgs["some.avg10"]; !ok {
+		return nil, errNoStat
+	}
+
+	return &Pressure{
+		SomeAvg10: avgs["some.avg10"],
+		SomeAvg60: avgs["some.avg60"],
+		FullAvg10: avgs["full.avg10"],
+		FullAvg60: avgs["full.avg60"],
+	}, nil
+}
@@ -0,0 +1,31 @@
+package cpu
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// affinitySupported tells whether worker threads can be pinned to CPU cores.
+const affinitySupported = true
+
+// allowedCPUs returns the IDs of the CPU cores the process is allowed to run on.
+func allowedCPUs() ([]int, error) {
+	var set unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &set); err != nil {
+		return nil, err
+	}
+
+	var cpus []int
+	for i := 0; len(cpus) < set.Count(); i++ {
+		if set.IsSet(i) {
+			cpus = append(cpus, i)
+		}
+	}
+	return cpus, nil
+}
+
+// pin binds the calling OS thread to the given CPU core.
+func pin(cpu int) error {
+	var set unix.CPUSet
+	set.Set(cpu)
+	return unix.SchedSetaffinity(0, &set)
+}
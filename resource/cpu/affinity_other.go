@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package cpu
+
+import (
+	"errors"
+)
+
+// affinitySupported tells whether worker threads can be pinned to CPU cores.
+const affinitySupported = false
+
+// allowedCPUs returns the IDs of the CPU cores the process is allowed to run on.
+func allowedCPUs() ([]int, error) {
+	return nil, errors.New("CPU affinity is not supported on this platform")
+}
+
+// pin binds the calling OS thread to the given CPU core.
+func pin(cpu int) error {
+	return errors.New("CPU affinity is not supported on this platform")
+}
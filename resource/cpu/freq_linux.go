@@ -0,0 +1,27 @@
+package cpu
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// frequencies returns the current frequency of each core in MHz, or nil if it cannot be
+// read. cpufreq is preferred as it follows frequency scaling; without it the (possibly
+// static) value of /proc/cpuinfo is used.
+func frequencies(cores int) []float64 {
+	freq := make([]float64, 0, cores)
+	for i := 0; i < cores; i++ {
+		b, err := ioutil.ReadFile(fmt.Sprintf("/sys/devices/system/cpu/cpu%d/cpufreq/scaling_cur_freq", i))
+		if err != nil {
+			return infoFrequencies()
+		}
+		khz, err := strconv.ParseFloat(strings.TrimSpace(string(b)), 64)
+		if err != nil {
+			return infoFrequencies()
+		}
+		freq = append(freq, khz/1000)
+	}
+	return freq
+}
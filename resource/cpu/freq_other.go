@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package cpu
+
+// frequencies returns the frequency of each core in MHz, or nil if it cannot be read.
+func frequencies(cores int) []float64 {
+	return infoFrequencies()
+}
@@ -1,29 +1,77 @@
 package cpu
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"math"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+const (
+	// MinPeriod and MaxPeriod are the bounds of the duty cycle period.
+	MinPeriod = 10 * time.Millisecond
+	MaxPeriod = time.Second
+
+	// DefaultPeriod is the default duty cycle period.
+	DefaultPeriod = 100 * time.Millisecond
+
+	// reportWindow is the minimum length of time the achieved duty cycle is measured over.
+	reportWindow = time.Second
 )
 
-// Load represents a CPU load.
+// errNoAffinity is returned by per-core updates when worker threads cannot be pinned.
+var errNoAffinity = errors.New("per-core CPU load is not supported on this platform")
+
+// worker holds the state of a CPU load goroutine shared with the rest of the load.
+type worker struct {
+	// pct is the target duty cycle in %.
+	pct int64
+	// achieved holds the bits of the float64 duty cycle (in %) measured over the last
+	// report window.
+	achieved uint64
+}
+
+// Load represents a CPU load. Each worker goroutine runs a duty cycle: it is busy for pct%
+// of every period and sleeps for the rest of it.
 type Load struct {
 	cancel chan struct{}
 	wg     sync.WaitGroup
 	l      *log.Logger
 
-	cores  int
-	change []chan time.Duration
+	cores   int
+	cpus    []int
+	period  int64
+	workers []worker
 }
 
-// NewLoad returns a configured CPU load.
-func NewLoad(cores int, l *log.Logger) *Load {
-	return &Load{
-		cores:  cores,
-		change: make([]chan time.Duration, cores),
-		l:      l,
+// NewLoad returns a configured CPU load. Where supported, each worker thread is pinned
+// to one of the CPU cores the process is allowed to run on.
+func NewLoad(cores int, period time.Duration, l *log.Logger) *Load {
+	load := &Load{
+		cores:   cores,
+		period:  int64(period),
+		workers: make([]worker, cores),
+		l:       l,
 	}
+
+	if affinitySupported {
+		cpus, err := allowedCPUs()
+		if err != nil {
+			l.Printf("unable to get CPU affinity, worker threads will not be pinned: %s\n", err)
+		} else if len(cpus) != cores {
+			l.Printf("%d allowed CPU cores for %d worker threads, worker threads will not be pinned\n", len(cpus), cores)
+		} else {
+			load.cpus = cpus
+		}
+	}
+
+	return load
 }
 
 // Start starts up the load goroutines.
@@ -32,11 +80,8 @@ func (l *Load) Start() {
 
 	l.wg.Add(l.cores)
 	for i := 0; i < l.cores; i++ {
-		ch := make(chan time.Duration, 1)
-		l.change[i] = ch
-		go l.load(i, ch)
+		go l.load(i)
 	}
-
 }
 
 // Stop signals all goroutines to stop and waits for them to return.
@@ -48,37 +93,174 @@ func (l *Load) Stop() {
 // Update updates the load percentage of all goroutines.
 func (l *Load) Update(pct int64) {
 	l.l.Printf("updating cpu load percentage: %d%%\n", pct)
-	for _, ch := range l.change {
-		ch <- l.sleepDuration(pct)
+	for i := range l.workers {
+		atomic.StoreInt64(&l.workers[i].pct, pct)
+	}
+}
+
+// UpdateCore updates the load percentage of the goroutine pinned to the given CPU core.
+func (l *Load) UpdateCore(core int, pct int64) error {
+	i, err := l.worker(core)
+	if err != nil {
+		return err
 	}
+
+	l.l.Printf("updating cpu load percentage of core %d: %d%%\n", core, pct)
+	atomic.StoreInt64(&l.workers[i].pct, pct)
+	return nil
+}
+
+// UpdateCores updates the load percentage of every pinned goroutine. The i-th value is
+// applied to the i-th allowed CPU core.
+func (l *Load) UpdateCores(pcts []int64) error {
+	if err := l.ValidateCores(nil, len(pcts)); err != nil {
+		return err
+	}
+
+	l.l.Printf("updating per-core cpu load percentages: %v\n", pcts)
+	for i, pct := range pcts {
+		atomic.StoreInt64(&l.workers[i].pct, pct)
+	}
+	return nil
+}
+
+// ValidatePeriod checks that period is within the bounds of the duty cycle period.
+func ValidatePeriod(period time.Duration) error {
+	if period < MinPeriod || period > MaxPeriod {
+		return fmt.Errorf("period must be between %s-%s, got: %s", MinPeriod, MaxPeriod, period)
+	}
+	return nil
+}
+
+// SetPeriod updates the duty cycle period of all goroutines.
+func (l *Load) SetPeriod(period time.Duration) error {
+	if err := ValidatePeriod(period); err != nil {
+		return err
+	}
+
+	l.l.Printf("updating cpu load duty cycle period: %s\n", period)
+	atomic.StoreInt64(&l.period, int64(period))
+	return nil
+}
+
+// Period returns the duty cycle period.
+func (l *Load) Period() time.Duration {
+	return time.Duration(atomic.LoadInt64(&l.period))
 }
 
-// cpuLoad is a CPU load goroutine.
-func (l *Load) load(n int, changed <-chan time.Duration) {
+// Workers returns the target and achieved duty cycle of every goroutine.
+func (l *Load) Workers() []resource.WorkerStatus {
+	ws := make([]resource.WorkerStatus, len(l.workers))
+	for i := range l.workers {
+		ws[i] = resource.WorkerStatus{
+			Core:     -1,
+			Target:   atomic.LoadInt64(&l.workers[i].pct),
+			Achieved: math.Round(math.Float64frombits(atomic.LoadUint64(&l.workers[i].achieved))*10) / 10,
+		}
+		if l.cpus != nil {
+			ws[i].Core = l.cpus[i]
+		}
+	}
+	return ws
+}
+
+// ValidateCores returns an error if per-core loads cannot be set: for a core which is not
+// pinned, or with a number of values not matching the pinned cores. core is nil for an
+// update of every core with n values.
+func (l *Load) ValidateCores(core *int, n int) error {
+	if core != nil {
+		_, err := l.worker(*core)
+		return err
+	}
+	if l.cpus == nil {
+		return errNoAffinity
+	}
+	if n != len(l.cpus) {
+		return fmt.Errorf("expected %d per-core percentages, got: %d", len(l.cpus), n)
+	}
+	return nil
+}
+
+// worker returns the index of the goroutine pinned to the given CPU core.
+func (l *Load) worker(core int) (int, error) {
+	if l.cpus == nil {
+		return 0, errNoAffinity
+	}
+	for i, cpu := range l.cpus {
+		if cpu == core {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("CPU core %d is not available, allowed cores: %v", core, l.cpus)
+}
+
+// load is a CPU load goroutine. It does not allocate in its loop.
+func (l *Load) load(n int) {
 	defer l.wg.Done()
 
 	// Bind the goroutine to an OS thread, so the scheduler won't move it around.
 	runtime.LockOSThread()
-	defer runtime.UnlockOSThread()
 
-	sleep := l.sleepDuration(0)
+	// Pin the OS thread to its CPU core, so the OS won't move it around either. A pinned
+	// thread is never unlocked: it exits with the goroutine instead of being reused.
+	if l.cpus == nil {
+		defer runtime.UnlockOSThread()
+	} else if err := pin(l.cpus[n]); err != nil {
+		l.l.Printf("thread %d could not be pinned to core %d: %s\n", n, l.cpus[n], err)
+	}
+
+	w := &l.workers[n]
+
+	var (
+		// debt is the busy time owed from previous cycles, e.g. because the goroutine
+		// overslept. It is paid back in the next cycle.
+		debt time.Duration
+		// windowBusy and windowTotal accumulate the current report window.
+		windowBusy, windowTotal time.Duration
+	)
 
-	// TODO: improve busy loop!
 	for {
 		select {
 		case <-l.cancel:
 			return
-		case sleep = <-changed:
-			l.l.Printf("thread %d sleep duration: %s\n", n, sleep)
-		case <-time.After(time.Millisecond - sleep):
-			time.Sleep(sleep)
 		default:
-			// Default branch is required to keep the infinite loop busy.
 		}
-	}
-}
 
-// sleepDuration returns how long the CPU goroutine should sleep in a second.
-func (l *Load) sleepDuration(pct int64) time.Duration {
-	return time.Duration(100-pct) * 10 * time.Millisecond
+		period := time.Duration(atomic.LoadInt64(&l.period))
+		pct := atomic.LoadInt64(&w.pct)
+
+		// Busy phase: spin until the busy share of the period has elapsed.
+		busy := period*time.Duration(pct)/100 + debt
+		if busy > period {
+			busy = period
+		}
+		start := time.Now()
+		for time.Since(start) < busy {
+			// Spinning is the point.
+		}
+		busy = time.Since(start)
+
+		// Idle phase: sleep for the rest of the period.
+		if idle := period - busy; idle > 0 {
+			time.Sleep(idle)
+		}
+		total := time.Since(start)
+
+		// Carry the difference between the wanted and the actual busy time over to the
+		// next cycle, bounded so a long stall does not turn into a long burst.
+		debt = total*time.Duration(pct)/100 - busy
+		if debt > period {
+			debt = period
+		} else if debt < -period {
+			debt = -period
+		}
+
+		windowBusy += busy
+		windowTotal += total
+		if windowTotal >= reportWindow {
+			achieved := 100 * float64(windowBusy) / float64(windowTotal)
+			atomic.StoreUint64(&w.achieved, math.Float64bits(achieved))
+			windowBusy, windowTotal = 0, 0
+		}
+	}
 }
@@ -3,11 +3,16 @@ package cpu
 import (
 	"log"
 	"math"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cgroup"
+	"github.com/milonoir/schwer/resource/event"
 	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/process"
 )
 
 // Monitor represents a CPU load monitor.
@@ -16,16 +21,44 @@ type Monitor struct {
 	wg     sync.WaitGroup
 	l      *log.Logger
 
-	usage resource.CPULevels
-	mtx   sync.RWMutex
+	cores    int
+	b        backend.Backend
+	cg       *cgroup.Cgroup
+	hub      *event.Hub
+	interval *resource.Interval
+	prev     cgroup.CPUStats
+	at       time.Time
+	times    []backend.CPUTimes
+	proc     *process.Process
+
+	usage     resource.CPULevels
+	levels    []float64
+	container *resource.ContainerCPU
+	details   resource.CPUDetails
+	mtx       sync.RWMutex
 }
 
-// NewMonitor returns a configured CPU load monitor.
-func NewMonitor(cores int, l *log.Logger) *Monitor {
-	return &Monitor{
-		l:     l,
-		usage: make(resource.CPULevels, cores),
+// NewMonitor returns a configured CPU load monitor reading CPU times and load averages from
+// b. If cg is not nil, the usage of the cgroup is monitored as well. The CPU usage of the
+// process and the frequency of the cores are always read locally. Every new sample is
+// published to hub.
+func NewMonitor(cores int, b backend.Backend, cg *cgroup.Cgroup, hub *event.Hub, l *log.Logger) *Monitor {
+	m := &Monitor{
+		l:        l,
+		cores:    cores,
+		b:        b,
+		cg:       cg,
+		hub:      hub,
+		interval: resource.NewInterval(),
+		usage:    make(resource.CPULevels, cores),
+		levels:   make([]float64, cores),
+	}
+	if p, err := process.NewProcess(int32(os.Getpid())); err != nil {
+		l.Printf("error in getting process: %s\n", err)
+	} else {
+		m.proc = p
 	}
+	return m
 }
 
 // Start starts up the monitoring goroutine.
@@ -42,8 +75,19 @@ func (m *Monitor) Stop() {
 	m.wg.Wait()
 }
 
+// Interval returns the sampling interval of the monitor.
+func (m *Monitor) Interval() time.Duration {
+	return m.interval.Get()
+}
+
+// SetInterval changes the sampling interval of the monitor. It takes effect immediately,
+// even while the monitor is running.
+func (m *Monitor) SetInterval(d time.Duration) error {
+	return m.interval.Set(d)
+}
+
 // Usage returns the latest set of CPU utilisation levels.
-func (m *Monitor) Usage() interface{} {
+func (m *Monitor) Usage() resource.CPULevels {
 	m.mtx.RLock()
 	defer m.mtx.RUnlock()
 
@@ -53,31 +97,203 @@ func (m *Monitor) Usage() interface{} {
 	return u
 }
 
+// Levels returns the latest set of CPU utilisation levels with two decimals.
+func (m *Monitor) Levels() []float64 {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	l := make([]float64, len(m.levels))
+	copy(l, m.levels)
+	return l
+}
+
+// Container returns the latest CPU usage of the cgroup, or nil if it is not monitored.
+func (m *Monitor) Container() *resource.ContainerCPU {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	if m.container == nil {
+		return nil
+	}
+	c := *m.container
+	return &c
+}
+
+// Details returns the latest breakdown of CPU utilisation.
+func (m *Monitor) Details() resource.CPUDetails {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	// Slices and pointers are replaced on every sample, never modified, so a shallow copy
+	// is safe to hand out.
+	return m.details
+}
+
 // monitor is the CPU load monitoring goroutine.
 func (m *Monitor) monitor() {
 	defer m.wg.Done()
 
+	// CPU times are cumulative, so the first reading only sets the baseline.
+	m.readTimes()
 	for {
 		select {
 		case <-m.cancel:
 			return
-		default:
-			vals, err := cpu.Percent(time.Second, true)
-			if err != nil {
-				m.l.Printf("error in getting CPU utilisation levels: %s\n", err)
+		case <-m.interval.Changed():
+		case <-time.After(m.interval.Get()):
+			prev := m.times
+			if !m.readTimes() || len(prev) != len(m.times) {
 				continue
 			}
-			m.saveUsage(vals)
+			m.saveUsage(prev, m.times)
+			m.saveDetails()
+			if m.cg != nil {
+				m.saveContainer()
+			}
+			m.hub.Publish(event.TypeCPU, m.Usage())
+		}
+	}
+}
+
+// readTimes stores the current per-core CPU times and tells whether they could be read.
+func (m *Monitor) readTimes() bool {
+	s, err := m.b.CPU()
+	if err != nil {
+		m.l.Printf("error in getting CPU utilisation levels: %s\n", err)
+		return false
+	}
+	m.times = s.Cores
+	return true
+}
+
+// saveUsage calculates the utilisation of each core between two readings of CPU times. The
+// busy share is stored both rounded to the closest integer and with two decimals, like the
+// breakdown by mode.
+func (m *Monitor) saveUsage(prev, cur []backend.CPUTimes) {
+	modes := make([]resource.CPUModes, len(cur))
+	levels := make([]float64, len(cur))
+	usage := make(resource.CPULevels, len(cur))
+	var total, totalPrev backend.CPUTimes
+	for i := range cur {
+		modes[i] = breakdown(prev[i], cur[i])
+		levels[i] = round2(100 - modes[i].Idle)
+		usage[i] = int(math.Round(levels[i]))
+		total = total.Add(cur[i])
+		totalPrev = totalPrev.Add(prev[i])
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// The backend may report a different number of cores than the host has, e.g. if it
+	// reads a remote host.
+	m.usage = usage
+	m.levels = levels
+	m.details.Modes = modes
+	m.details.Total = breakdown(totalPrev, total)
+}
+
+// saveDetails reads the load averages, the CPU usage of the process and the frequency of
+// the cores. Values which cannot be read are omitted.
+func (m *Monitor) saveDetails() {
+	var avg *resource.LoadAvg
+	if a, err := m.b.Load(); err == nil {
+		avg = &resource.LoadAvg{Load1: a.Load1, Load5: a.Load5, Load15: a.Load15}
+	}
+
+	var proc *resource.ProcessCPU
+	if m.proc != nil {
+		if pct, err := m.proc.Percent(0); err == nil {
+			proc = &resource.ProcessCPU{Percent: round2(pct)}
 		}
 	}
+
+	freq := frequencies(m.cores)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.details.LoadAvg = avg
+	m.details.Process = proc
+	m.details.Frequency = freq
 }
 
-// saveUsage rounds values to the closest integer and stores them in a slice.
-func (m *Monitor) saveUsage(values []float64) {
+// breakdown returns the share of each mode (in %) of the CPU time elapsed between t1 and t2.
+func breakdown(t1, t2 backend.CPUTimes) resource.CPUModes {
+	all := t2.Total() - t1.Total()
+	if all <= 0 {
+		return resource.CPUModes{Idle: 100}
+	}
+	pct := func(v1, v2 float64) float64 {
+		return round2(math.Max(v2-v1, 0) / all * 100)
+	}
+	return resource.CPUModes{
+		User:    pct(t1.User, t2.User),
+		Nice:    pct(t1.Nice, t2.Nice),
+		System:  pct(t1.System, t2.System),
+		Iowait:  pct(t1.Iowait, t2.Iowait),
+		Irq:     pct(t1.Irq, t2.Irq),
+		Softirq: pct(t1.Softirq, t2.Softirq),
+		Steal:   pct(t1.Steal, t2.Steal),
+		Idle:    pct(t1.Idle, t2.Idle),
+	}
+}
+
+// round2 rounds v to two decimals.
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// saveContainer reads the cgroup's CPU accounting and stores its usage since the previous
+// reading.
+func (m *Monitor) saveContainer() {
+	stats, err := m.cg.CPU()
+	if err != nil {
+		m.l.Printf("error in getting cgroup CPU stats: %s\n", err)
+		return
+	}
+	now := time.Now()
+	prev, at := m.prev, m.at
+	m.prev, m.at = stats, now
+
+	// Usage is a delta, so the first reading only sets the baseline.
+	if at.IsZero() {
+		return
+	}
+
+	c := &resource.ContainerCPU{
+		Cgroup:        m.cg.Version(),
+		UsageCores:    float64(stats.UsageUsec-prev.UsageUsec) / float64(now.Sub(at)/time.Microsecond),
+		LimitCores:    stats.QuotaCores,
+		NrPeriods:     stats.NrPeriods,
+		NrThrottled:   stats.NrThrottled,
+		ThrottledUsec: stats.ThrottledUsec,
+	}
+	limit := float64(m.cores)
+	if c.LimitCores > 0 {
+		limit = c.LimitCores
+	}
+	c.UsagePct = int(math.Round(100 * c.UsageCores / limit))
+	c.UsageCores = math.Round(c.UsageCores*100) / 100
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	for i, v := range values {
-		m.usage[i] = int(math.Round(v))
+	m.container = c
+}
+
+// infoFrequencies returns the frequency of each core in MHz as reported by the CPU info of
+// the platform, or nil if it is not available.
+func infoFrequencies() []float64 {
+	info, err := cpu.Info()
+	if err != nil {
+		return nil
+	}
+	var freq []float64
+	for _, c := range info {
+		if c.Mhz > 0 {
+			freq = append(freq, c.Mhz)
+		}
 	}
+	return freq
 }
@@ -0,0 +1,254 @@
+package cpu
+
+import (
+	"errors"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+const (
+	// PID gains of the feedback loop.
+	kp = 0.4
+	ki = 0.15
+	kd = 0.05
+
+	// convergenceTolerance is the largest error (in %) considered on target.
+	convergenceTolerance = 5
+	// convergenceSamples is how many consecutive on-target samples make the loop converged.
+	convergenceSamples = 3
+)
+
+// errFeedbackEnabled is returned by per-core updates while the feedback loop drives the load.
+var errFeedbackEnabled = errors.New("per-core CPU load cannot be set while feedback mode is enabled")
+
+// Regulator sets the CPU load either directly (open loop) or, when feedback mode is
+// enabled, adjusts it until the total CPU utilisation measured by the monitor reaches
+// the target.
+type Regulator struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	load    resource.CoreLoad
+	monitor resource.CPUMonitor
+
+	enabled   bool
+	target    int64
+	output    float64
+	measured  float64
+	integral  float64
+	prevErr   float64
+	onTarget  int
+	converged bool
+	mtx       sync.Mutex
+}
+
+// NewRegulator returns a configured CPU load regulator. The feedback loop adjusts the load
+// once per sampling interval of monitor.
+func NewRegulator(load resource.CoreLoad, monitor resource.CPUMonitor, l *log.Logger) *Regulator {
+	return &Regulator{
+		load:    load,
+		monitor: monitor,
+		l:       l,
+	}
+}
+
+// Start starts up the feedback loop goroutine.
+func (r *Regulator) Start() {
+	r.cancel = make(chan struct{})
+
+	r.wg.Add(1)
+	go r.regulate()
+}
+
+// Stop signals the feedback loop goroutine to stop and waits for it to return.
+func (r *Regulator) Stop() {
+	close(r.cancel)
+	r.wg.Wait()
+}
+
+// Update sets the target CPU load percentage.
+func (r *Regulator) Update(pct int64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.target = pct
+	if !r.enabled {
+		r.load.Update(pct)
+		return
+	}
+	r.l.Printf("updating cpu utilisation target: %d%%\n", pct)
+	r.converged = false
+	r.onTarget = 0
+}
+
+// UpdateCore updates the load of a single CPU core. It fails in feedback mode. The target
+// becomes the mean load of all cores.
+func (r *Regulator) UpdateCore(core int, pct int64) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.enabled {
+		return errFeedbackEnabled
+	}
+	if err := r.load.UpdateCore(core, pct); err != nil {
+		return err
+	}
+	r.target = r.meanTarget()
+	return nil
+}
+
+// UpdateCores updates the load of every CPU core separately. It fails in feedback mode. The
+// target becomes the mean load of all cores.
+func (r *Regulator) UpdateCores(pcts []int64) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.enabled {
+		return errFeedbackEnabled
+	}
+	if err := r.load.UpdateCores(pcts); err != nil {
+		return err
+	}
+	r.target = r.meanTarget()
+	return nil
+}
+
+// ValidateCores returns an error if per-core loads cannot be set with feedback mode in the
+// given state: never in feedback mode, otherwise as allowed by the load.
+func (r *Regulator) ValidateCores(feedback bool, core *int, n int) error {
+	if feedback {
+		return errFeedbackEnabled
+	}
+	return r.load.ValidateCores(core, n)
+}
+
+// meanTarget returns the mean target load of the CPU load workers, rounded to the closest
+// integer.
+func (r *Regulator) meanTarget() int64 {
+	workers := r.load.Workers()
+	if len(workers) == 0 {
+		return 0
+	}
+	var sum int64
+	for _, w := range workers {
+		sum += w.Target
+	}
+	return int64(math.Round(float64(sum) / float64(len(workers))))
+}
+
+// SetFeedback turns feedback mode on or off. When turned on, the loop starts from the
+// current target, which is the mean load of all cores after per-core updates. When turned
+// off, the load is set to the target directly.
+func (r *Regulator) SetFeedback(enabled bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.enabled == enabled {
+		return
+	}
+	r.enabled = enabled
+	r.l.Printf("cpu load feedback mode enabled: %t\n", enabled)
+
+	if !enabled {
+		r.load.Update(r.target)
+		return
+	}
+	r.output = float64(r.target)
+	r.integral = 0
+	r.prevErr = 0
+	r.onTarget = 0
+	r.converged = false
+}
+
+// Status returns the state of the feedback loop.
+func (r *Regulator) Status() resource.FeedbackStatus {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	s := resource.FeedbackStatus{
+		Enabled: r.enabled,
+		Target:  r.target,
+	}
+	if r.enabled {
+		s.Measured = round(r.measured)
+		s.Error = round(float64(r.target) - r.measured)
+		s.Output = round(r.output)
+		s.Converged = r.converged
+	}
+	return s
+}
+
+// regulate is the feedback loop goroutine. It follows the sampling interval of the monitor,
+// so every iteration sees a new sample, even after the interval has been changed.
+func (r *Regulator) regulate() {
+	defer r.wg.Done()
+
+	for {
+		interval := r.monitor.Interval()
+		select {
+		case <-r.cancel:
+			return
+		case <-time.After(interval):
+			r.adjust(average(r.monitor.Levels()), interval)
+		}
+	}
+}
+
+// adjust runs one iteration of the PID loop using the measured CPU utilisation, dt after
+// the previous one.
+func (r *Regulator) adjust(measured float64, dt time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.measured = measured
+	if !r.enabled {
+		return
+	}
+
+	e := float64(r.target) - measured
+	secs := dt.Seconds()
+	derivative := (e - r.prevErr) / secs
+	r.prevErr = e
+
+	// Only integrate while the output is not saturated, so the integral does not wind up.
+	integral := r.integral + e*secs
+	output := float64(r.target) + kp*e + ki*integral + kd*derivative
+	if output >= 0 && output <= 100 {
+		r.integral = integral
+	}
+	output = math.Max(0, math.Min(100, output))
+
+	if math.Abs(e) <= convergenceTolerance {
+		r.onTarget++
+	} else {
+		r.onTarget = 0
+	}
+	r.converged = r.onTarget >= convergenceSamples
+
+	if math.Round(output) != math.Round(r.output) {
+		r.load.Update(int64(math.Round(output)))
+	}
+	r.output = output
+}
+
+// average returns the mean utilisation of all CPU cores.
+func average(levels []float64) float64 {
+	if len(levels) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range levels {
+		sum += v
+	}
+	return sum / float64(len(levels))
+}
+
+// round rounds v to one decimal place.
+func round(v float64) float64 {
+	return math.Round(v*10) / 10
+}
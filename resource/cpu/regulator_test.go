@@ -0,0 +1,164 @@
+package cpu
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// fakeLoad records the load percentages set by the regulator.
+type fakeLoad struct {
+	resource.CoreLoad
+	updates []int64
+	cores   []int64
+}
+
+func (l *fakeLoad) Update(pct int64) {
+	l.updates = append(l.updates, pct)
+	for i := range l.cores {
+		l.cores[i] = pct
+	}
+}
+
+func (l *fakeLoad) UpdateCore(core int, pct int64) error {
+	l.cores[core] = pct
+	return nil
+}
+
+func (l *fakeLoad) Workers() []resource.WorkerStatus {
+	ws := make([]resource.WorkerStatus, len(l.cores))
+	for i, pct := range l.cores {
+		ws[i] = resource.WorkerStatus{Core: i, Target: pct}
+	}
+	return ws
+}
+
+func newTestRegulator(target int64) (*Regulator, *fakeLoad) {
+	load := &fakeLoad{cores: make([]int64, 2)}
+	r := NewRegulator(load, nil, log.New(ioutil.Discard, "", 0))
+	r.Update(target)
+	r.SetFeedback(true)
+	load.updates = nil
+	return r, load
+}
+
+func TestRegulatorAdjust(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		target   int64
+		measured []float64
+		// loads are the load updates expected after each step, 0 if the load is unchanged.
+		loads []int64
+	}{
+		{
+			// e=10: 50 + 0.4*10 + 0.15*10 + 0.05*10; e=5: 50 + 0.4*5 + 0.15*15 + 0.05*-5.
+			name:     "below target",
+			target:   50,
+			measured: []float64{40, 45},
+			loads:    []int64{56, 54},
+		},
+		{
+			name:     "above target",
+			target:   50,
+			measured: []float64{60},
+			loads:    []int64{44},
+		},
+		{
+			name:     "on target",
+			target:   50,
+			measured: []float64{50, 50},
+			loads:    []int64{0, 0},
+		},
+		{
+			name:     "saturated",
+			target:   90,
+			measured: []float64{10},
+			loads:    []int64{100},
+		},
+	} {
+		r, load := newTestRegulator(tc.target)
+		for i, m := range tc.measured {
+			load.updates = nil
+			r.adjust(m, time.Second)
+			var got int64
+			if len(load.updates) > 0 {
+				got = load.updates[len(load.updates)-1]
+			}
+			if got != tc.loads[i] {
+				t.Errorf("%s: step %d: got load %d, want %d", tc.name, i+1, got, tc.loads[i])
+			}
+		}
+	}
+}
+
+func TestRegulatorAntiWindup(t *testing.T) {
+	r, load := newTestRegulator(100)
+
+	// The output is saturated, so the error is not integrated.
+	for i := 0; i < 5; i++ {
+		r.adjust(0, time.Second)
+	}
+	if r.integral != 0 {
+		t.Errorf("integral after saturation: got %g, want 0", r.integral)
+	}
+
+	// With a wound up integral the output would stay at 100.
+	load.updates = nil
+	r.adjust(100, time.Second)
+	if len(load.updates) != 1 || load.updates[0] != 95 {
+		t.Errorf("load after reaching the target: got %v, want [95]", load.updates)
+	}
+}
+
+func TestRegulatorConvergence(t *testing.T) {
+	r, _ := newTestRegulator(50)
+
+	for i, m := range []float64{30, 48, 53, 44, 52, 50, 49} {
+		r.adjust(m, time.Second)
+		// The last three samples are on target.
+		want := i >= 6
+		if got := r.Status().Converged; got != want {
+			t.Errorf("after sample %d (%g%%): converged %t, want %t", i+1, m, got, want)
+		}
+	}
+	if s := r.Status(); s.Measured != 49 || s.Error != 1 {
+		t.Errorf("status: got measured %g, error %g, want 49, 1", s.Measured, s.Error)
+	}
+}
+
+func TestRegulatorOpenLoop(t *testing.T) {
+	load := &fakeLoad{cores: make([]int64, 2)}
+	r := NewRegulator(load, nil, log.New(ioutil.Discard, "", 0))
+
+	r.Update(30)
+	r.adjust(80, time.Second)
+	if len(load.updates) != 1 || load.updates[0] != 30 {
+		t.Errorf("open loop updates: got %v, want [30]", load.updates)
+	}
+
+	// The target follows per-core updates.
+	if err := r.UpdateCore(1, 50); err != nil {
+		t.Fatal(err)
+	}
+	if s := r.Status(); s.Target != 40 {
+		t.Errorf("target after a per-core update: got %d, want 40", s.Target)
+	}
+
+	r.SetFeedback(true)
+	if err := r.UpdateCore(0, 10); err != errFeedbackEnabled {
+		t.Errorf("per-core update in feedback mode: got %v, want %v", err, errFeedbackEnabled)
+	}
+	if err := r.ValidateCores(true, nil, 2); err != errFeedbackEnabled {
+		t.Errorf("validating per-core updates in feedback mode: got %v, want %v", err, errFeedbackEnabled)
+	}
+
+	// Turning feedback mode off sets the target directly.
+	load.updates = nil
+	r.SetFeedback(false)
+	if len(load.updates) != 1 || load.updates[0] != 40 {
+		t.Errorf("updates after feedback mode: got %v, want [40]", load.updates)
+	}
+}
@@ -0,0 +1,15 @@
+package disk
+
+import (
+	"time"
+)
+
+const (
+	megaBytes = 1 << 20
+
+	// alignment is the buffer and block size alignment required by O_DIRECT.
+	alignment = 4096
+
+	// tick is how often the disk load issues a batch of operations.
+	tick = 100 * time.Millisecond
+)
@@ -0,0 +1,10 @@
+package disk
+
+import (
+	"syscall"
+)
+
+// directFlag returns the open flag bypassing the page cache.
+func directFlag() (int, error) {
+	return syscall.O_DIRECT, nil
+}
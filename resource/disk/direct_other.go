@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package disk
+
+import (
+	"errors"
+)
+
+// directFlag returns the open flag bypassing the page cache.
+func directFlag() (int, error) {
+	return 0, errors.New("direct I/O is not supported on this platform")
+}
@@ -0,0 +1,415 @@
+package disk
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// DefaultConfig returns the configuration of an idle disk load.
+func DefaultConfig() resource.DiskConfig {
+	return resource.DiskConfig{
+		Dir:       os.TempDir(),
+		BlockSize: 64 << 10,
+		FileSize:  256,
+	}
+}
+
+// Validate checks that the disk load can run with the given configuration.
+func Validate(c resource.DiskConfig) error {
+	if c.Throughput < 0 {
+		return fmt.Errorf("throughput must be positive: %d", c.Throughput)
+	}
+	if c.ReadPct < 0 || c.ReadPct > 100 {
+		return fmt.Errorf("read percentage must be between 0-100, got: %d", c.ReadPct)
+	}
+	if c.IOPS < 0 {
+		return fmt.Errorf("IOPS must be positive: %d", c.IOPS)
+	}
+	if c.BlockSize < 512 || c.BlockSize > 64*megaBytes || c.BlockSize%512 != 0 {
+		return fmt.Errorf("block size must be a multiple of 512 between 512-%d bytes, got: %d", 64*megaBytes, c.BlockSize)
+	}
+	if c.FileSize*megaBytes < c.BlockSize {
+		return fmt.Errorf("file size must not be smaller than the block size, got: %d MB", c.FileSize)
+	}
+	if c.Direct {
+		if _, err := directFlag(); err != nil {
+			return err
+		}
+		if c.BlockSize%alignment != 0 {
+			return fmt.Errorf("block size must be a multiple of %d bytes for direct I/O, got: %d", alignment, c.BlockSize)
+		}
+	}
+	fi, err := os.Stat(c.Dir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("not a directory: %s", c.Dir)
+	}
+	return nil
+}
+
+// Load represents a disk I/O load. It reads and writes blocks of a test file at a paced rate.
+type Load struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	change chan resource.DiskConfig
+
+	cfg    resource.DiskConfig
+	status resource.DiskLoadStatus
+	mtx    sync.RWMutex
+}
+
+// NewLoad returns a configured, idle disk load.
+func NewLoad(l *log.Logger) *Load {
+	return &Load{
+		change: make(chan resource.DiskConfig, 1),
+		cfg:    DefaultConfig(),
+		l:      l,
+	}
+}
+
+// Start starts up the load goroutine.
+func (l *Load) Start() {
+	l.cancel = make(chan struct{})
+
+	l.wg.Add(1)
+	go l.load()
+}
+
+// Stop signals the load goroutine to stop and waits for it to return.
+func (l *Load) Stop() {
+	close(l.cancel)
+	l.wg.Wait()
+}
+
+// Update updates the throughput in MB/s, keeping the rest of the configuration.
+func (l *Load) Update(mbps int64) {
+	l.mtx.Lock()
+	l.cfg.Throughput = mbps
+	cfg := l.cfg
+	l.mtx.Unlock()
+
+	l.l.Printf("updating disk load throughput to %d MB/s\n", mbps)
+	l.send(cfg)
+}
+
+// Configure validates and applies a new configuration.
+func (l *Load) Configure(cfg resource.DiskConfig) error {
+	if err := Validate(cfg); err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	l.cfg = cfg
+	l.mtx.Unlock()
+
+	l.l.Printf("updating disk load config: %+v\n", cfg)
+	l.send(cfg)
+	return nil
+}
+
+// Config returns the current configuration.
+func (l *Load) Config() resource.DiskConfig {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	return l.cfg
+}
+
+// Status returns the configuration and the achieved throughput of the load.
+func (l *Load) Status() resource.DiskLoadStatus {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	s := l.status
+	s.Config = l.cfg
+	return s
+}
+
+// send hands a configuration to the load goroutine, replacing a pending one.
+func (l *Load) send(cfg resource.DiskConfig) {
+	select {
+	case <-l.change:
+	default:
+	}
+	l.change <- cfg
+}
+
+// window accumulates the operations done within a report window.
+type window struct {
+	start   time.Time
+	read    int64
+	written int64
+	ops     int64
+	latency time.Duration
+}
+
+func (l *Load) load() {
+	defer l.wg.Done()
+
+	var (
+		cfg = l.Config()
+		t   *testFile
+		// ops is the number of operations owed, including fractions carried over.
+		ops float64
+		// mix decides between reads and writes, so ReadPct of the operations are reads.
+		mix int64
+		w   = window{start: time.Now()}
+	)
+	defer func() {
+		if t != nil {
+			t.close()
+		}
+		l.saveStatus(window{}, 0)
+	}()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.cancel:
+			return
+		case cfg = <-l.change:
+			ops = 0
+			// An idle load keeps no test file, so stopping it releases the disk.
+			if t != nil && (cfg.Throughput == 0 || !t.matches(cfg)) {
+				t.close()
+				t = nil
+			}
+		case <-ticker.C:
+			if cfg.Throughput == 0 {
+				// The load may have stopped on an I/O error.
+				if t != nil {
+					t.close()
+					t = nil
+				}
+				if time.Since(w.start) >= time.Second {
+					l.saveStatus(window{}, 0)
+					w = window{start: time.Now()}
+				}
+				continue
+			}
+
+			if t == nil {
+				var err error
+				if t, err = openTestFile(cfg); err != nil {
+					l.fail(&cfg, err)
+					continue
+				}
+			}
+			if cfg.ReadPct > 0 && !t.filled {
+				if t.fillOff == 0 {
+					l.l.Printf("preparing %d MB disk load test file: %s\n", cfg.FileSize, t.path)
+				}
+				// Filled a tick at a time, so a large file does not hold up configuration
+				// changes and stopping the load.
+				filled, err := t.fill(time.Now().Add(tick))
+				if err != nil {
+					l.fail(&cfg, err)
+					continue
+				}
+				if !filled {
+					// Nothing is read or written for the load meanwhile.
+					if time.Since(w.start) >= time.Second {
+						l.saveStatus(window{}, 0)
+						w = window{start: time.Now()}
+					}
+					continue
+				}
+			}
+
+			// Owe the operations of this tick, but never more than two ticks' worth, so a
+			// stall does not turn into a burst.
+			perTick := float64(cfg.Throughput*megaBytes) * tick.Seconds() / float64(cfg.BlockSize)
+			if cfg.IOPS > 0 {
+				perTick = math.Min(perTick, float64(cfg.IOPS)*tick.Seconds())
+			}
+			ops = math.Min(ops+perTick, 2*perTick)
+
+			deadline := time.Now().Add(tick)
+			for ; ops >= 1 && time.Now().Before(deadline); ops-- {
+				mix += cfg.ReadPct
+				read := mix >= 100
+				if read {
+					mix -= 100
+				}
+
+				start := time.Now()
+				var err error
+				if read {
+					err = t.read(cfg.Random)
+					w.read += cfg.BlockSize
+				} else {
+					err = t.write(cfg.Random, cfg.Fsync)
+					w.written += cfg.BlockSize
+				}
+				if err != nil {
+					l.fail(&cfg, err)
+					break
+				}
+				w.latency += time.Since(start)
+				w.ops++
+			}
+
+			if elapsed := time.Since(w.start); elapsed >= time.Second {
+				l.saveStatus(w, elapsed)
+				w = window{start: time.Now()}
+			}
+		}
+	}
+}
+
+// fail logs an I/O error and stops the load by setting its throughput to zero.
+func (l *Load) fail(cfg *resource.DiskConfig, err error) {
+	l.l.Printf("disk load stopped: %s\n", err)
+	cfg.Throughput = 0
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.cfg.Throughput = 0
+}
+
+// saveStatus stores the throughput achieved within a report window.
+func (l *Load) saveStatus(w window, elapsed time.Duration) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.status = resource.DiskLoadStatus{}
+	if elapsed <= 0 {
+		return
+	}
+	secs := elapsed.Seconds()
+	l.status.ReadMBps = round(float64(w.read) / megaBytes / secs)
+	l.status.WriteMBps = round(float64(w.written) / megaBytes / secs)
+	l.status.IOPS = round(float64(w.ops) / secs)
+	if w.ops > 0 {
+		l.status.LatencyMs = round(float64(w.latency) / float64(w.ops) / float64(time.Millisecond))
+	}
+}
+
+// testFile is the file the disk load operates on.
+type testFile struct {
+	f         *os.File
+	path      string
+	direct    bool
+	size      int64
+	blockSize int64
+	buf       []byte
+	filled    bool
+	fillOff   int64
+	readOff   int64
+	writeOff  int64
+}
+
+// openTestFile creates the test file of the load in the configured directory.
+func openTestFile(cfg resource.DiskConfig) (*testFile, error) {
+	flags := os.O_RDWR | os.O_CREATE | os.O_TRUNC
+	if cfg.Direct {
+		direct, err := directFlag()
+		if err != nil {
+			return nil, err
+		}
+		flags |= direct
+	}
+
+	path := filepath.Join(cfg.Dir, fmt.Sprintf("schwer-%d.dat", os.Getpid()))
+	f, err := os.OpenFile(path, flags, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &testFile{
+		f:         f,
+		path:      path,
+		direct:    cfg.Direct,
+		size:      cfg.FileSize * megaBytes / cfg.BlockSize * cfg.BlockSize,
+		blockSize: cfg.BlockSize,
+		buf:       alignedBuffer(int(cfg.BlockSize)),
+	}
+	// Random data, so the storage cannot compress or deduplicate the blocks.
+	rand.Read(t.buf)
+	return t, nil
+}
+
+// matches tells whether the file can be reused with the given configuration.
+func (t *testFile) matches(cfg resource.DiskConfig) bool {
+	return t.path == filepath.Join(cfg.Dir, filepath.Base(t.path)) &&
+		t.direct == cfg.Direct &&
+		t.blockSize == cfg.BlockSize &&
+		t.size == cfg.FileSize*megaBytes/cfg.BlockSize*cfg.BlockSize
+}
+
+// fill writes the whole file once, so there is data to read back. It writes until the
+// deadline and carries on from there when called again, and tells whether the file is full.
+func (t *testFile) fill(deadline time.Time) (bool, error) {
+	for ; t.fillOff < t.size; t.fillOff += t.blockSize {
+		if !time.Now().Before(deadline) {
+			return false, nil
+		}
+		if _, err := t.f.WriteAt(t.buf, t.fillOff); err != nil {
+			return false, err
+		}
+	}
+	t.filled = true
+	return true, t.f.Sync()
+}
+
+func (t *testFile) read(random bool) error {
+	_, err := t.f.ReadAt(t.buf, t.offset(&t.readOff, random))
+	return err
+}
+
+func (t *testFile) write(random, fsync bool) error {
+	if _, err := t.f.WriteAt(t.buf, t.offset(&t.writeOff, random)); err != nil {
+		return err
+	}
+	if fsync {
+		return t.f.Sync()
+	}
+	return nil
+}
+
+// offset returns the offset of the next block, either advancing off sequentially or
+// picking a random block.
+func (t *testFile) offset(off *int64, random bool) int64 {
+	if random {
+		return rand.Int63n(t.size/t.blockSize) * t.blockSize
+	}
+	o := *off
+	*off = (o + t.blockSize) % t.size
+	return o
+}
+
+func (t *testFile) close() {
+	t.f.Close()
+	os.Remove(t.path)
+}
+
+// alignedBuffer returns a buffer whose address is aligned as required by O_DIRECT.
+func alignedBuffer(size int) []byte {
+	b := make([]byte, size+alignment)
+	off := int(uintptr(unsafe.Pointer(&b[0])) & (alignment - 1))
+	if off != 0 {
+		off = alignment - off
+	}
+	return b[off : off+size]
+}
+
+// round rounds v to two decimal places.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,58 @@
+package disk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLoadStopsWhileFilling stops a load while it still prepares a test file too large to
+// be filled in the meantime.
+func TestLoadStopsWhileFilling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schwer-disk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, fmt.Sprintf("schwer-%d.dat", os.Getpid()))
+
+	l := NewLoad(log.New(ioutil.Discard, "", 0))
+	l.Start()
+	defer l.Stop()
+
+	cfg := DefaultConfig()
+	cfg.Dir = dir
+	cfg.Throughput = 1
+	cfg.ReadPct = 100
+	cfg.FileSize = 4 << 10
+	if err := l.Configure(cfg); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the test file", func() bool {
+		_, err := os.Stat(path)
+		return err == nil
+	})
+
+	l.Update(0)
+	waitFor(t, "the test file to be removed", func() bool {
+		_, err := os.Stat(path)
+		return os.IsNotExist(err)
+	})
+}
+
+// waitFor fails the test if cond does not hold within a second.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
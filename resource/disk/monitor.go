@@ -0,0 +1,152 @@
+package disk
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/event"
+)
+
+// Monitor represents a disk I/O monitor.
+type Monitor struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	b        backend.Backend
+	hub      *event.Hub
+	interval *resource.Interval
+	prev     map[string]backend.DiskCounters
+	at       time.Time
+
+	usage resource.DiskStats
+	mtx   sync.RWMutex
+}
+
+// NewMonitor returns a configured disk I/O monitor reading device counters from b. Every
+// new sample is published to hub.
+func NewMonitor(b backend.Backend, hub *event.Hub, l *log.Logger) *Monitor {
+	return &Monitor{
+		l:        l,
+		b:        b,
+		hub:      hub,
+		interval: resource.NewInterval(),
+	}
+}
+
+// Start starts up the monitoring goroutine.
+func (m *Monitor) Start() {
+	m.cancel = make(chan struct{})
+
+	m.wg.Add(1)
+	go m.monitor()
+}
+
+// Stop signals the monitoring goroutine to stop and waits for it to return.
+func (m *Monitor) Stop() {
+	close(m.cancel)
+	m.wg.Wait()
+}
+
+// Interval returns the sampling interval of the monitor.
+func (m *Monitor) Interval() time.Duration {
+	return m.interval.Get()
+}
+
+// SetInterval changes the sampling interval of the monitor. It takes effect immediately,
+// even while the monitor is running.
+func (m *Monitor) SetInterval(d time.Duration) error {
+	return m.interval.Set(d)
+}
+
+// Usage returns the latest throughput and latency of every block device.
+func (m *Monitor) Usage() resource.DiskStats {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	// Return a deep-copy of values so we're not racy.
+	u := resource.DiskStats{Devices: make([]resource.DiskDevice, len(m.usage.Devices))}
+	copy(u.Devices, m.usage.Devices)
+	return u
+}
+
+// monitor is the disk I/O monitoring goroutine.
+func (m *Monitor) monitor() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cancel:
+			return
+		case <-m.interval.Changed():
+		case <-time.After(m.interval.Get()):
+			sample, err := m.b.Disk()
+			if err == backend.ErrNotSupported {
+				m.l.Printf("disk I/O counters are not supported by the %s backend\n", m.b.Name())
+				return
+			}
+			if err != nil {
+				m.l.Printf("error in getting disk I/O counters: %s\n", err)
+				continue
+			}
+			m.saveUsage(sample)
+			m.hub.Publish(event.TypeDisk, m.Usage())
+		}
+	}
+}
+
+// saveUsage computes the throughput and latency of every device since the previous
+// reading. Loop and RAM devices are skipped.
+func (m *Monitor) saveUsage(sample backend.DiskSample) {
+	now := sample.Time
+	prev, at := m.prev, m.at
+	m.prev = make(map[string]backend.DiskCounters, len(sample.Devices))
+	for _, c := range sample.Devices {
+		m.prev[c.Name] = c
+	}
+	m.at = now
+
+	// Counters are cumulative, so the first reading only sets the baseline.
+	if at.IsZero() {
+		return
+	}
+	secs := now.Sub(at).Seconds()
+
+	devices := make([]resource.DiskDevice, 0, len(sample.Devices))
+	for _, c := range sample.Devices {
+		name := c.Name
+		p, ok := prev[name]
+		if !ok || strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+			continue
+		}
+
+		reads := float64(c.ReadCount - p.ReadCount)
+		writes := float64(c.WriteCount - p.WriteCount)
+		d := resource.DiskDevice{
+			Name:      name,
+			ReadMBps:  round(float64(c.ReadBytes-p.ReadBytes) / megaBytes / secs),
+			WriteMBps: round(float64(c.WriteBytes-p.WriteBytes) / megaBytes / secs),
+			ReadIOPS:  round(reads / secs),
+			WriteIOPS: round(writes / secs),
+		}
+		// Read and write times are reported in milliseconds.
+		if reads > 0 {
+			d.ReadLatencyMs = round(float64(c.ReadTimeMs-p.ReadTimeMs) / reads)
+		}
+		if writes > 0 {
+			d.WriteLatencyMs = round(float64(c.WriteTimeMs-p.WriteTimeMs) / writes)
+		}
+		devices = append(devices, d)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Name < devices[j].Name })
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.usage.Devices = devices
+}
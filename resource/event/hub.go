@@ -0,0 +1,81 @@
+package event
+
+import (
+	"sync"
+	"time"
+)
+
+// Event types.
+const (
+	TypeCPU   = "cpu"
+	TypeMem   = "mem"
+	TypeDisk  = "disk"
+	TypeNet   = "net"
+	TypeProc  = "proc"
+	TypeLoad  = "load"
+	TypeGuard = "guard"
+)
+
+// subscriberBuffer is how many events a subscriber may lag behind before events are
+// dropped for it.
+const subscriberBuffer = 64
+
+// Event is a monitor sample, a load change or a load backed off by a guardrail.
+type Event struct {
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// Hub fans published events out to all subscribers. A nil *Hub is valid and discards
+// everything published to it.
+type Hub struct {
+	subs map[chan Event]struct{}
+	mtx  sync.RWMutex
+}
+
+// NewHub returns a new Hub.
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[chan Event]struct{}),
+	}
+}
+
+// Subscribe returns a channel of events published from now on and a function to cancel
+// the subscription. Events are dropped for subscribers which do not keep up.
+func (h *Hub) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	h.mtx.Lock()
+	h.subs[ch] = struct{}{}
+	h.mtx.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mtx.Lock()
+			delete(h.subs, ch)
+			h.mtx.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish sends an event of the given type to all subscribers without blocking.
+func (h *Hub) Publish(typ string, data interface{}) {
+	if h == nil {
+		return
+	}
+
+	e := Event{Type: typ, Time: time.Now(), Data: data}
+
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	for ch := range h.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package expiry
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// Revert modes of expired loads.
+const (
+	RevertZero     = "zero"
+	RevertPrevious = "previous"
+)
+
+// TTL is the time to live of a load update. Once Duration runs out, the load reverts to
+// zero, or to its state before the update if Revert is RevertPrevious. A zero Duration
+// falls back to the maximum TTL.
+type TTL struct {
+	Duration time.Duration
+	Revert   string
+}
+
+// Expiry reverts loads once their TTL runs out. Loads are identified by name, and each of
+// them has at most one pending expiry.
+type Expiry struct {
+	l *log.Logger
+
+	max    time.Duration
+	timers map[string]*timer
+	mtx    sync.Mutex
+}
+
+// timer is the pending expiry of a load.
+type timer struct {
+	t        *time.Timer
+	deadline time.Time
+	revert   string
+}
+
+// New returns an Expiry which caps every TTL at max. Without a maximum (0), loads only
+// expire if their update has a TTL.
+func New(max time.Duration, l *log.Logger) *Expiry {
+	return &Expiry{
+		l:      l,
+		max:    max,
+		timers: make(map[string]*timer),
+	}
+}
+
+// Max returns the maximum TTL, 0 if there is none.
+func (e *Expiry) Max() time.Duration {
+	return e.max
+}
+
+// Validate returns an error if ttl is negative, exceeds the maximum TTL or has an unknown
+// revert mode.
+func (e *Expiry) Validate(ttl TTL) error {
+	if ttl.Duration < 0 {
+		return fmt.Errorf("ttl must not be negative, got: %s", ttl.Duration)
+	}
+	if e.max > 0 && ttl.Duration > e.max {
+		return fmt.Errorf("ttl must be at most %s, got: %s", e.max, ttl.Duration)
+	}
+	switch ttl.Revert {
+	case "", RevertZero, RevertPrevious:
+		return nil
+	}
+	return fmt.Errorf("revert must be %s or %s, got: %q", RevertZero, RevertPrevious, ttl.Revert)
+}
+
+// Set schedules fn to revert the named load once ttl runs out, replacing its pending
+// expiry. The pending expiry is cancelled if there is neither a TTL nor a maximum TTL.
+func (e *Expiry) Set(name string, ttl TTL, fn func()) {
+	d := ttl.Duration
+	if d == 0 {
+		d = e.max
+	}
+	revert := ttl.Revert
+	if revert == "" {
+		revert = RevertZero
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.clear(name)
+	if d <= 0 {
+		return
+	}
+	t := &timer{deadline: time.Now().Add(d), revert: revert}
+	t.t = time.AfterFunc(d, func() { e.expire(name, t, fn) })
+	e.timers[name] = t
+}
+
+// Clear cancels the pending expiry of the named load.
+func (e *Expiry) Clear(name string) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.clear(name)
+}
+
+// Status returns the pending expiry of the named load, nil if there is none.
+func (e *Expiry) Status(name string) *resource.TTLStatus {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	t, ok := e.timers[name]
+	if !ok {
+		return nil
+	}
+	remaining := time.Until(t.deadline)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &resource.TTLStatus{
+		Remaining: remaining.Seconds(),
+		Revert:    t.revert,
+	}
+}
+
+// Stop cancels every pending expiry.
+func (e *Expiry) Stop() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for name := range e.timers {
+		e.clear(name)
+	}
+}
+
+// clear cancels the pending expiry of the named load. The caller must hold the lock.
+func (e *Expiry) clear(name string) {
+	if t, ok := e.timers[name]; ok {
+		t.t.Stop()
+		delete(e.timers, name)
+	}
+}
+
+// expire runs fn unless t has been replaced or cancelled since it was scheduled. fn is
+// called without holding the lock, as reverting a load usually schedules a new expiry.
+func (e *Expiry) expire(name string, t *timer, fn func()) {
+	e.mtx.Lock()
+	if e.timers[name] != t {
+		e.mtx.Unlock()
+		return
+	}
+	delete(e.timers, name)
+	e.mtx.Unlock()
+
+	e.l.Printf("%s load expired, reverting to %s\n", name, t.revert)
+	fn()
+}
@@ -0,0 +1,312 @@
+package guard
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/event"
+)
+
+// guardInterval is how often the guardrails are checked against the latest samples of the
+// monitors.
+const guardInterval = time.Second
+
+// Error is returned for load updates which would break a guardrail.
+type Error struct {
+	msg string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// BackoffFunc reduces the load of res ("cpu" or "mem") by the given amount: percent of total
+// CPU capacity for the CPU load, MB for the memory load. It returns false if there was no
+// load to back off.
+type BackoffFunc func(res string, by float64) bool
+
+// Guard keeps the loads within the guardrails. Updates which would break a guardrail are
+// rejected up front, and loads are backed off if the host gets close to a limit while they
+// are running, e.g. because another process started using memory.
+type Guard struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	cfg        resource.GuardConfig
+	cpuMonitor resource.CPUMonitor
+	memMonitor resource.MemMonitor
+	hub        *event.Hub
+	backoff    BackoffFunc
+
+	backoffs map[string]uint64
+	last     *resource.GuardBackoff
+	holdOff  map[string]time.Time
+	mtx      sync.Mutex
+}
+
+// New returns a Guard enforcing cfg on the samples of the given monitors.
+func New(cfg resource.GuardConfig, cpuMonitor resource.CPUMonitor, memMonitor resource.MemMonitor, hub *event.Hub, l *log.Logger) *Guard {
+	return &Guard{
+		l:          l,
+		cfg:        cfg,
+		cpuMonitor: cpuMonitor,
+		memMonitor: memMonitor,
+		hub:        hub,
+		backoffs:   make(map[string]uint64),
+		holdOff:    make(map[string]time.Time),
+	}
+}
+
+// Validate returns an error if cfg has a guardrail out of bounds.
+func Validate(cfg resource.GuardConfig) error {
+	switch {
+	case cfg.MemFloorMB < 0:
+		return fmt.Errorf("memory floor must not be negative, got: %d", cfg.MemFloorMB)
+	case cfg.MemFloorPct < 0 || cfg.MemFloorPct > 99:
+		return fmt.Errorf("memory floor must be between 0-99%%, got: %d%%", cfg.MemFloorPct)
+	case cfg.CgroupHeadroomMB < 0:
+		return fmt.Errorf("cgroup headroom must not be negative, got: %d", cfg.CgroupHeadroomMB)
+	case cfg.CPUCeiling < 0 || cfg.CPUCeiling > 100:
+		return fmt.Errorf("cpu ceiling must be between 0-100%%, got: %d%%", cfg.CPUCeiling)
+	}
+	return nil
+}
+
+// ParseMemFloor parses a memory floor given in MB (e.g. "512") or in percent of total
+// memory (e.g. "10%") into cfg.
+func ParseMemFloor(s string, cfg *resource.GuardConfig) error {
+	if s == "" {
+		return nil
+	}
+	v, err := strconv.ParseInt(strings.TrimSuffix(s, "%"), 10, 64)
+	if err != nil {
+		return errors.New("memory floor must be a number of MB or a percentage")
+	}
+	if strings.HasSuffix(s, "%") {
+		cfg.MemFloorPct = v
+	} else {
+		cfg.MemFloorMB = v
+	}
+	return nil
+}
+
+// SetBackoff sets the function which backs off the loads. It must be called before Start.
+func (g *Guard) SetBackoff(fn BackoffFunc) {
+	g.backoff = fn
+}
+
+// Start starts up the guard goroutine.
+func (g *Guard) Start() {
+	g.cancel = make(chan struct{})
+
+	g.wg.Add(1)
+	go g.guard()
+}
+
+// Stop signals the guard goroutine to stop and waits for it to return.
+func (g *Guard) Stop() {
+	close(g.cancel)
+	g.wg.Wait()
+}
+
+// Config returns the guardrails.
+func (g *Guard) Config() resource.GuardConfig {
+	return g.cfg
+}
+
+// Status returns the guardrails and the backoffs they caused.
+func (g *Guard) Status() resource.GuardStatus {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	s := resource.GuardStatus{
+		Config:   g.cfg,
+		Backoffs: make(map[string]uint64, len(g.backoffs)),
+	}
+	for res, n := range g.backoffs {
+		s.Backoffs[res] = n
+	}
+	if g.last != nil {
+		last := *g.last
+		s.Last = &last
+	}
+	return s
+}
+
+// CheckCPU returns an error if raising the CPU load from current to requested percent of
+// total CPU capacity would push the CPU utilisation of the host above the ceiling.
+// Lowering the load is always allowed.
+func (g *Guard) CheckCPU(current, requested float64) error {
+	if g.cfg.CPUCeiling == 0 || requested <= current {
+		return nil
+	}
+	// The utilisation not caused by the CPU load stays as it is.
+	projected := math.Max(0, g.cpuUtilisation()-current) + requested
+	if projected > float64(g.cfg.CPUCeiling) {
+		return &Error{fmt.Sprintf("cpu load of %.0f%% would raise cpu utilisation to %.0f%%, above the ceiling of %d%%", requested, projected, g.cfg.CPUCeiling)}
+	}
+	return nil
+}
+
+// CheckMem returns an error if growing the memory load from current to requested MB would
+// push the available memory of the host below the floor or the usage of the cgroup above
+// its limit minus the headroom. Shrinking the load is always allowed.
+func (g *Guard) CheckMem(current, requested int64) error {
+	delta := requested - current
+	if delta <= 0 {
+		return nil
+	}
+	s := g.memMonitor.Precise()
+	if s.Total == 0 {
+		// Not sampled yet.
+		return nil
+	}
+	if floor := g.memFloor(s.Total); floor > 0 && s.Available-float64(delta) < floor {
+		return &Error{fmt.Sprintf("memory load of %d MB would leave %.0f MB available, below the floor of %.0f MB", requested, math.Max(0, s.Available-float64(delta)), floor)}
+	}
+	if limit := g.cgroupLimit(s.Container); limit > 0 && int64(s.Container.Used)+delta > limit {
+		return &Error{fmt.Sprintf("memory load of %d MB would raise cgroup memory usage to %d MB, above the limit of %d MB minus %d MB headroom", requested, int64(s.Container.Used)+delta, s.Container.Limit, g.cfg.CgroupHeadroomMB)}
+	}
+	return nil
+}
+
+// guard is the guard goroutine.
+func (g *Guard) guard() {
+	defer g.wg.Done()
+
+	ticker := time.NewTicker(guardInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.cancel:
+			return
+		case <-ticker.C:
+			g.check()
+		}
+	}
+}
+
+// check backs off the loads which break a guardrail according to the latest samples.
+func (g *Guard) check() {
+	if by, reason := g.memExcess(); by > 0 {
+		g.trip("mem", by, reason, g.memMonitor.Interval())
+	}
+	if by, reason := g.cpuExcess(); by > 0 {
+		g.trip("cpu", by, reason, g.cpuMonitor.Interval())
+	}
+}
+
+// memExcess returns how many MB the memory load has to shrink by to satisfy the memory
+// guardrails, and why.
+func (g *Guard) memExcess() (float64, string) {
+	s := g.memMonitor.Precise()
+	if s.Total == 0 {
+		return 0, ""
+	}
+
+	var (
+		by     float64
+		reason string
+	)
+	if floor := g.memFloor(s.Total); floor > 0 && s.Available < floor {
+		by = math.Ceil(floor - s.Available)
+		reason = fmt.Sprintf("available memory of %.0f MB is below the floor of %.0f MB", s.Available, floor)
+	}
+	if limit := g.cgroupLimit(s.Container); limit > 0 && int64(s.Container.Used) > limit {
+		if over := float64(int64(s.Container.Used) - limit); over > by {
+			by = over
+			reason = fmt.Sprintf("cgroup memory usage of %d MB is above the limit of %d MB minus %d MB headroom", s.Container.Used, s.Container.Limit, g.cfg.CgroupHeadroomMB)
+		}
+	}
+	return by, reason
+}
+
+// cpuExcess returns by how many percent of total CPU capacity the CPU load has to back off
+// to satisfy the CPU ceiling, and why.
+func (g *Guard) cpuExcess() (float64, string) {
+	if g.cfg.CPUCeiling == 0 {
+		return 0, ""
+	}
+	u := g.cpuUtilisation()
+	if u <= float64(g.cfg.CPUCeiling) {
+		return 0, ""
+	}
+	return math.Ceil(u - float64(g.cfg.CPUCeiling)), fmt.Sprintf("cpu utilisation of %.0f%% is above the ceiling of %d%%", u, g.cfg.CPUCeiling)
+}
+
+// trip backs off the load of res, unless it has been backed off within the last two
+// sampling intervals of its monitor: the effect of the previous backoff may not have been
+// sampled yet.
+func (g *Guard) trip(res string, by float64, reason string, interval time.Duration) {
+	g.mtx.Lock()
+	if time.Now().Before(g.holdOff[res]) {
+		g.mtx.Unlock()
+		return
+	}
+	g.mtx.Unlock()
+
+	if g.backoff == nil || !g.backoff(res, by) {
+		return
+	}
+
+	b := resource.GuardBackoff{
+		Resource: res,
+		By:       by,
+		Reason:   reason,
+		Time:     time.Now(),
+	}
+	g.mtx.Lock()
+	g.backoffs[res]++
+	g.last = &b
+	g.holdOff[res] = b.Time.Add(2*interval + guardInterval)
+	g.mtx.Unlock()
+
+	g.l.Printf("backing off %s load by %s: %s\n", res, unit(res, by), reason)
+	g.hub.Publish(event.TypeGuard, b)
+}
+
+// memFloor returns the memory floor in MB, the higher one if it is given both in MB and in
+// percent.
+func (g *Guard) memFloor(total float64) float64 {
+	return math.Max(float64(g.cfg.MemFloorMB), total*float64(g.cfg.MemFloorPct)/100)
+}
+
+// cgroupLimit returns the memory usage of the cgroup in MB which the memory load must not
+// exceed, 0 if there is none.
+func (g *Guard) cgroupLimit(c *resource.ContainerMem) int64 {
+	if g.cfg.CgroupHeadroomMB == 0 || c == nil || c.Limit == 0 {
+		return 0
+	}
+	return int64(c.Limit) - g.cfg.CgroupHeadroomMB
+}
+
+// cpuUtilisation returns the mean utilisation of all CPU cores.
+func (g *Guard) cpuUtilisation() float64 {
+	levels := g.cpuMonitor.Levels()
+	if len(levels) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range levels {
+		sum += v
+	}
+	return sum / float64(len(levels))
+}
+
+// unit formats a backoff amount of the load of res.
+func unit(res string, by float64) string {
+	if res == "cpu" {
+		return fmt.Sprintf("%.0f%%", by)
+	}
+	return fmt.Sprintf("%.0f MB", by)
+}
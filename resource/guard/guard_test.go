@@ -0,0 +1,135 @@
+package guard
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+func TestParseMemFloor(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want resource.GuardConfig
+		ok   bool
+	}{
+		{"", resource.GuardConfig{}, true},
+		{"512", resource.GuardConfig{MemFloorMB: 512}, true},
+		{"10%", resource.GuardConfig{MemFloorPct: 10}, true},
+		{"0", resource.GuardConfig{}, true},
+		// Bounds are checked by Validate.
+		{"-1", resource.GuardConfig{MemFloorMB: -1}, true},
+		{"512MB", resource.GuardConfig{}, false},
+		{"%", resource.GuardConfig{}, false},
+		{"1.5%", resource.GuardConfig{}, false},
+	} {
+		var cfg resource.GuardConfig
+		err := ParseMemFloor(tc.s, &cfg)
+		if (err == nil) != tc.ok || cfg != tc.want {
+			t.Errorf("%q: got %+v, %v, want %+v, ok %t", tc.s, cfg, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		cfg resource.GuardConfig
+		ok  bool
+	}{
+		{resource.GuardConfig{}, true},
+		{resource.GuardConfig{MemFloorMB: 512, MemFloorPct: 10, CgroupHeadroomMB: 64, CPUCeiling: 90}, true},
+		{resource.GuardConfig{MemFloorPct: 99, CPUCeiling: 100}, true},
+		{resource.GuardConfig{MemFloorMB: -1}, false},
+		{resource.GuardConfig{MemFloorPct: -1}, false},
+		{resource.GuardConfig{MemFloorPct: 100}, false},
+		{resource.GuardConfig{CgroupHeadroomMB: -1}, false},
+		{resource.GuardConfig{CPUCeiling: -1}, false},
+		{resource.GuardConfig{CPUCeiling: 101}, false},
+	} {
+		if err := Validate(tc.cfg); (err == nil) != tc.ok {
+			t.Errorf("%+v: got %v, want ok %t", tc.cfg, err, tc.ok)
+		}
+	}
+}
+
+// fakeCPUMonitor serves fixed CPU utilisation levels.
+type fakeCPUMonitor struct {
+	resource.CPUMonitor
+	levels []float64
+}
+
+func (m fakeCPUMonitor) Levels() []float64 { return m.levels }
+
+// fakeMemMonitor serves fixed memory stats.
+type fakeMemMonitor struct {
+	resource.MemMonitor
+	stats resource.PreciseMemStats
+}
+
+func (m fakeMemMonitor) Precise() resource.PreciseMemStats { return m.stats }
+
+func TestCheckCPU(t *testing.T) {
+	// 50% busy on average, 20% of it caused by the CPU load.
+	cpu := fakeCPUMonitor{levels: []float64{40, 60}}
+	g := New(resource.GuardConfig{CPUCeiling: 70}, cpu, fakeMemMonitor{}, nil, log.New(ioutil.Discard, "", 0))
+
+	for _, tc := range []struct {
+		current, requested float64
+		ok                 bool
+	}{
+		{20, 30, true},
+		{20, 40, true},
+		{20, 41, false},
+		// Lowering the load is always allowed.
+		{60, 55, true},
+		// The load cannot have caused more than what is measured.
+		{80, 90, false},
+	} {
+		if err := g.CheckCPU(tc.current, tc.requested); (err == nil) != tc.ok {
+			t.Errorf("%g%% -> %g%%: got %v, want ok %t", tc.current, tc.requested, err, tc.ok)
+		} else if err != nil {
+			if _, isGuard := err.(*Error); !isGuard {
+				t.Errorf("%g%% -> %g%%: got %T, want *Error", tc.current, tc.requested, err)
+			}
+		}
+	}
+
+	if err := New(resource.GuardConfig{}, cpu, fakeMemMonitor{}, nil, nil).CheckCPU(0, 100); err != nil {
+		t.Errorf("without a ceiling: got %v", err)
+	}
+}
+
+func TestCheckMem(t *testing.T) {
+	stats := resource.PreciseMemStats{Total: 10000, Available: 4000}
+	stats.Container = &resource.ContainerMem{Used: 1500, Limit: 2000}
+	mem := fakeMemMonitor{stats: stats}
+
+	for _, tc := range []struct {
+		name               string
+		cfg                resource.GuardConfig
+		current, requested int64
+		ok                 bool
+	}{
+		{"no guardrail", resource.GuardConfig{}, 0, 100000, true},
+		{"above the floor", resource.GuardConfig{MemFloorMB: 1000}, 0, 3000, true},
+		{"below the floor", resource.GuardConfig{MemFloorMB: 1000}, 0, 3001, false},
+		{"growing by the difference", resource.GuardConfig{MemFloorMB: 1000}, 1000, 4000, true},
+		{"shrinking", resource.GuardConfig{MemFloorMB: 5000}, 1000, 500, true},
+		{"floor in percent", resource.GuardConfig{MemFloorPct: 35}, 0, 501, false},
+		{"higher of both floors", resource.GuardConfig{MemFloorMB: 1000, MemFloorPct: 35}, 0, 500, true},
+		{"below the cgroup headroom", resource.GuardConfig{CgroupHeadroomMB: 100}, 0, 400, true},
+		{"above the cgroup headroom", resource.GuardConfig{CgroupHeadroomMB: 100}, 0, 401, false},
+	} {
+		g := New(tc.cfg, fakeCPUMonitor{}, mem, nil, nil)
+		if err := g.CheckMem(tc.current, tc.requested); (err == nil) != tc.ok {
+			t.Errorf("%s: got %v, want ok %t", tc.name, err, tc.ok)
+		}
+	}
+
+	// Nothing is checked before the first sample.
+	g := New(resource.GuardConfig{MemFloorMB: 1000}, fakeCPUMonitor{}, fakeMemMonitor{}, nil, nil)
+	if err := g.CheckMem(0, 100000); err != nil {
+		t.Errorf("not sampled: got %v", err)
+	}
+}
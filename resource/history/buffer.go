@@ -0,0 +1,150 @@
+package history
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// sample is a set of named values taken at the same time.
+type sample struct {
+	t      time.Time
+	values map[string]float64
+}
+
+// Point is the aggregate of the samples of a series within a step.
+type Point struct {
+	Time time.Time `json:"time"`
+	Min  float64   `json:"min"`
+	Avg  float64   `json:"avg"`
+	Max  float64   `json:"max"`
+}
+
+// Series is a named, downsampled series of points.
+type Series struct {
+	Name   string  `json:"name"`
+	Points []Point `json:"points"`
+}
+
+// minCapacity is the number of samples a buffer makes room for at first.
+const minCapacity = 64
+
+// buffer holds the samples of a resource taken within a time window. It is a ring, which
+// grows as needed: the oldest sample is at head, followed by the rest in order.
+type buffer struct {
+	window  time.Duration
+	samples []sample
+	head    int
+	n       int
+	mtx     sync.RWMutex
+}
+
+func newBuffer(window time.Duration) *buffer {
+	return &buffer{
+		window: window,
+	}
+}
+
+// at returns the i-th oldest sample.
+func (b *buffer) at(i int) *sample {
+	return &b.samples[(b.head+i)%len(b.samples)]
+}
+
+// add appends a sample and evicts the samples which fell out of the window.
+func (b *buffer) add(t time.Time, values map[string]float64) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	cutoff := t.Add(-b.window)
+	for b.n > 0 && !b.at(0).t.After(cutoff) {
+		// Drop the values, so they can be garbage collected.
+		*b.at(0) = sample{}
+		b.head = (b.head + 1) % len(b.samples)
+		b.n--
+	}
+
+	if b.n == len(b.samples) {
+		b.grow()
+	}
+	*b.at(b.n) = sample{t: t, values: values}
+	b.n++
+}
+
+// grow doubles the capacity of the ring, moving the oldest sample to the front.
+func (b *buffer) grow() {
+	capacity := 2 * len(b.samples)
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+	samples := make([]sample, capacity)
+	for i := 0; i < b.n; i++ {
+		samples[i] = *b.at(i)
+	}
+	b.samples = samples
+	b.head = 0
+}
+
+// query downsamples the samples taken since the given time into steps, aggregating every
+// value into min/avg/max rounded to two decimals. Steps are aligned to multiples of step.
+func (b *buffer) query(since time.Time, step time.Duration) []Series {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	type agg struct {
+		min, max, sum float64
+		n             int
+	}
+	// Aggregates per series name per step start (in Unix nanoseconds).
+	buckets := make(map[string]map[int64]*agg)
+
+	start := sort.Search(b.n, func(i int) bool { return !b.at(i).t.Before(since) })
+	for i := start; i < b.n; i++ {
+		s := b.at(i)
+		idx := s.t.Truncate(step).UnixNano()
+		for name, v := range s.values {
+			steps, ok := buckets[name]
+			if !ok {
+				steps = make(map[int64]*agg)
+				buckets[name] = steps
+			}
+			a, ok := steps[idx]
+			if !ok {
+				a = &agg{min: math.Inf(1), max: math.Inf(-1)}
+				steps[idx] = a
+			}
+			a.min = math.Min(a.min, v)
+			a.max = math.Max(a.max, v)
+			a.sum += v
+			a.n++
+		}
+	}
+
+	series := make([]Series, 0, len(buckets))
+	for name, steps := range buckets {
+		idxs := make([]int64, 0, len(steps))
+		for idx := range steps {
+			idxs = append(idxs, idx)
+		}
+		sort.Slice(idxs, func(i, j int) bool { return idxs[i] < idxs[j] })
+
+		s := Series{Name: name, Points: make([]Point, len(idxs))}
+		for i, idx := range idxs {
+			a := steps[idx]
+			s.Points[i] = Point{
+				Time: time.Unix(0, idx).UTC(),
+				Min:  round(a.min),
+				Avg:  round(a.sum / float64(a.n)),
+				Max:  round(a.max),
+			}
+		}
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Name < series[j].Name })
+	return series
+}
+
+// round rounds v to two decimal places.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,132 @@
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBufferEvictsOutOfWindow(t *testing.T) {
+	b := newBuffer(10 * time.Second)
+	t0 := time.Unix(1000, 0)
+	// Enough samples to wrap around the ring several times.
+	for i := 0; i < 5*minCapacity; i++ {
+		b.add(t0.Add(time.Duration(i)*time.Second), map[string]float64{"v": float64(i)})
+	}
+
+	if b.n != 10 {
+		t.Fatalf("held samples: got %d, want 10", b.n)
+	}
+	if len(b.samples) != minCapacity {
+		t.Errorf("capacity: got %d, want %d", len(b.samples), minCapacity)
+	}
+	last := 5*minCapacity - 1
+	for i := 0; i < b.n; i++ {
+		if got, want := b.at(i).values["v"], float64(last-9+i); got != want {
+			t.Errorf("sample %d: got %g, want %g", i, got, want)
+		}
+	}
+}
+
+func TestBufferGrows(t *testing.T) {
+	b := newBuffer(time.Hour)
+	t0 := time.Unix(1000, 0)
+	n := 3*minCapacity + 1
+	for i := 0; i < n; i++ {
+		b.add(t0.Add(time.Duration(i)*time.Second), map[string]float64{"v": float64(i)})
+	}
+
+	if b.n != n {
+		t.Fatalf("held samples: got %d, want %d", b.n, n)
+	}
+	for i := 0; i < b.n; i++ {
+		if got := b.at(i).values["v"]; got != float64(i) {
+			t.Errorf("sample %d: got %g, want %d", i, got, i)
+		}
+	}
+}
+
+func TestBufferQuery(t *testing.T) {
+	// Aligned to a minute.
+	t0 := time.Unix(1200, 0)
+	b := newBuffer(time.Hour)
+	for i, v := range []float64{10.004, 20, 30, 40.126, 50, 60} {
+		b.add(t0.Add(time.Duration(i)*5*time.Second), map[string]float64{"a": v, "b": 100 - v})
+	}
+
+	for _, tc := range []struct {
+		name   string
+		since  time.Time
+		step   time.Duration
+		series int
+		// want holds the expected points of some of the series by name.
+		want map[string][]Point
+	}{
+		{
+			name:   "every sample",
+			step:   5 * time.Second,
+			series: 2,
+			want: map[string][]Point{
+				"a": {
+					{t0, 10, 10, 10},
+					{t0.Add(5 * time.Second), 20, 20, 20},
+					{t0.Add(10 * time.Second), 30, 30, 30},
+					{t0.Add(15 * time.Second), 40.13, 40.13, 40.13},
+					{t0.Add(20 * time.Second), 50, 50, 50},
+					{t0.Add(25 * time.Second), 60, 60, 60},
+				},
+			},
+		},
+		{
+			name:   "downsampled",
+			step:   10 * time.Second,
+			series: 2,
+			want: map[string][]Point{
+				"a": {
+					{t0, 10, 15, 20},
+					{t0.Add(10 * time.Second), 30, 35.06, 40.13},
+					{t0.Add(20 * time.Second), 50, 55, 60},
+				},
+				"b": {
+					{t0, 80, 85, 90},
+					{t0.Add(10 * time.Second), 59.87, 64.94, 70},
+					{t0.Add(20 * time.Second), 40, 45, 50},
+				},
+			},
+		},
+		{
+			name:   "since",
+			since:  t0.Add(12 * time.Second),
+			step:   time.Minute,
+			series: 2,
+			want: map[string][]Point{
+				"a": {{t0, 40.13, 50.04, 60}},
+			},
+		},
+		{
+			name:  "since the last sample",
+			since: t0.Add(time.Minute),
+			step:  time.Minute,
+		},
+	} {
+		series := b.query(tc.since, tc.step)
+		if len(series) != tc.series {
+			t.Errorf("%s: got %d series, want %d", tc.name, len(series), tc.series)
+			continue
+		}
+		for _, s := range series {
+			want, ok := tc.want[s.Name]
+			if !ok {
+				continue
+			}
+			if len(s.Points) != len(want) {
+				t.Errorf("%s: series %s: got %d points, want %d", tc.name, s.Name, len(s.Points), len(want))
+				continue
+			}
+			for i, p := range s.Points {
+				if !p.Time.Equal(want[i].Time) || p.Min != want[i].Min || p.Avg != want[i].Avg || p.Max != want[i].Max {
+					t.Errorf("%s: series %s: point %d: got %+v, want %+v", tc.name, s.Name, i, p, want[i])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package history
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/event"
+)
+
+// Resources which have their history recorded.
+const (
+	ResourceCPU = "cpu"
+	ResourceMem = "mem"
+)
+
+// Recorder records the samples published by monitors for a limited time window.
+type Recorder struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	hub        *event.Hub
+	cpuMonitor resource.CPUMonitor
+	memMonitor resource.MemMonitor
+	buffers    map[string]*buffer
+}
+
+// NewRecorder returns a configured Recorder retaining samples for window. The events of the
+// monitors tell when a sample is taken, and the unrounded figures of the sample are read
+// from cpuMonitor and memMonitor.
+func NewRecorder(hub *event.Hub, cpuMonitor resource.CPUMonitor, memMonitor resource.MemMonitor, window time.Duration, l *log.Logger) *Recorder {
+	return &Recorder{
+		hub:        hub,
+		cpuMonitor: cpuMonitor,
+		memMonitor: memMonitor,
+		buffers: map[string]*buffer{
+			ResourceCPU: newBuffer(window),
+			ResourceMem: newBuffer(window),
+		},
+		l: l,
+	}
+}
+
+// Start starts up the recording goroutine.
+func (r *Recorder) Start() {
+	r.cancel = make(chan struct{})
+
+	events, unsubscribe := r.hub.Subscribe()
+	r.wg.Add(1)
+	go r.record(events, unsubscribe)
+}
+
+// Stop signals the recording goroutine to stop and waits for it to return.
+func (r *Recorder) Stop() {
+	close(r.cancel)
+	r.wg.Wait()
+}
+
+// Query returns the history of a resource since the given time, downsampled into steps.
+func (r *Recorder) Query(res string, since time.Time, step time.Duration) ([]Series, error) {
+	b, ok := r.buffers[res]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource: %q", res)
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be positive, got: %s", step)
+	}
+	return b.query(since, step), nil
+}
+
+// record is the recording goroutine.
+func (r *Recorder) record(events <-chan event.Event, unsubscribe func()) {
+	defer r.wg.Done()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-r.cancel:
+			return
+		case e := <-events:
+			switch e.Type {
+			case event.TypeCPU:
+				r.buffers[ResourceCPU].add(e.Time, cpuValues(r.cpuMonitor.Levels()))
+			case event.TypeMem:
+				r.buffers[ResourceMem].add(e.Time, memValues(r.memMonitor.Precise()))
+			}
+		}
+	}
+}
+
+// cpuValues flattens CPU utilisation levels into per-core series and their average.
+func cpuValues(levels []float64) map[string]float64 {
+	values := make(map[string]float64, len(levels)+1)
+	var sum float64
+	for i, v := range levels {
+		values["core"+strconv.Itoa(i)] = v
+		sum += v
+	}
+	if len(levels) > 0 {
+		values["avg"] = sum / float64(len(levels))
+	}
+	return values
+}
+
+// memValues flattens memory stats into series.
+func memValues(stats resource.PreciseMemStats) map[string]float64 {
+	values := map[string]float64{
+		"total":     stats.Total,
+		"available": stats.Available,
+		"used":      stats.Used,
+		"usedpct":   stats.UsedPct,
+		"swap_used": stats.SwapUsed,
+		"held":      float64(stats.Held) / (1 << 20),
+	}
+	if p := stats.Process; p != nil {
+		values["process_rss"] = float64(p.RSS)
+	}
+	if c := stats.Container; c != nil {
+		values["container_used"] = float64(c.Used)
+	}
+	return values
+}
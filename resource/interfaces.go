@@ -1,5 +1,9 @@
 package resource
 
+import (
+	"time"
+)
+
 // StartStopper is implemented by types which can be started and stopped.
 type StartStopper interface {
 	Start()
@@ -12,8 +16,98 @@ type Load interface {
 	Update(int64)
 }
 
-// Monitor is implemented by resource consumption monitors.
+// CoreLoad is implemented by CPU load controllers which can load each CPU core separately.
+type CoreLoad interface {
+	Load
+	UpdateCore(core int, pct int64) error
+	UpdateCores(pcts []int64) error
+	// ValidateCores returns an error if the load of core, or n loads of every core if core
+	// is nil, cannot be set.
+	ValidateCores(core *int, n int) error
+	SetPeriod(time.Duration) error
+	Period() time.Duration
+	Workers() []WorkerStatus
+}
+
+// MemLoad is implemented by memory load controllers. Update sets the allocation size in MB
+// and ends leak mode.
+type MemLoad interface {
+	Load
+	// Leak starts growing the allocation in leak mode, or stops it if the rate is 0.
+	Leak(LeakConfig) error
+	Configure(MemConfig) error
+	Config() MemConfig
+	// SetBandwidth starts streaming over the allocation, or stops it if there are no workers.
+	SetBandwidth(BandwidthConfig) error
+	Status() MemLoadStatus
+}
+
+// DiskLoad is implemented by disk load controllers. Update sets the throughput in MB/s.
+type DiskLoad interface {
+	Load
+	Configure(DiskConfig) error
+	Config() DiskConfig
+	Status() DiskLoadStatus
+}
+
+// NetLoad is implemented by network load controllers. Update sets the throughput in Mbit/s.
+type NetLoad interface {
+	Load
+	Configure(NetConfig) error
+	Config() NetConfig
+	Status() NetLoadStatus
+}
+
+// CountLoad is implemented by loads which hold a number of items, e.g. open file
+// descriptors. Update sets the number of items.
+type CountLoad interface {
+	Load
+	// Max returns the maximum number of items, 0 if there is none.
+	Max() int64
+	Status() CountLoadStatus
+}
+
+// Monitor is implemented by resource consumption monitors. The latest sample is returned
+// by the Usage method of the monitor of each resource, typed by resource.
 type Monitor interface {
 	StartStopper
-	Usage() interface{}
+	Interval() time.Duration
+	SetInterval(time.Duration) error
+}
+
+// CPUMonitor is implemented by CPU consumption monitors which also report the usage of the
+// container they run in and a detailed breakdown of CPU utilisation.
+type CPUMonitor interface {
+	Monitor
+	Usage() CPULevels
+	Container() *ContainerCPU
+	Details() CPUDetails
+	// Levels returns the utilisation of each core with two decimals.
+	Levels() []float64
+}
+
+// MemMonitor is implemented by memory consumption monitors which also report unrounded
+// figures.
+type MemMonitor interface {
+	Monitor
+	Usage() MemStats
+	Precise() PreciseMemStats
+}
+
+// DiskMonitor is implemented by disk I/O monitors.
+type DiskMonitor interface {
+	Monitor
+	Usage() DiskStats
+}
+
+// NetMonitor is implemented by network interface monitors.
+type NetMonitor interface {
+	Monitor
+	Usage() NetStats
+}
+
+// ProcMonitor is implemented by monitors of the resources held by the process.
+type ProcMonitor interface {
+	Monitor
+	Usage() ProcStats
 }
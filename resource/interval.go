@@ -0,0 +1,65 @@
+package resource
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Bounds and default of the sampling interval of monitors.
+const (
+	MinInterval     = 100 * time.Millisecond
+	MaxInterval     = 60 * time.Second
+	DefaultInterval = time.Second
+)
+
+// ValidateInterval returns an error if d is not a valid sampling interval.
+func ValidateInterval(d time.Duration) error {
+	if d < MinInterval || d > MaxInterval {
+		return fmt.Errorf("interval must be between %s-%s, got: %s", MinInterval, MaxInterval, d)
+	}
+	return nil
+}
+
+// Interval is the sampling interval of a monitor. It is safe for concurrent use, so it can
+// be changed while the monitor is running.
+type Interval struct {
+	// Keep 64-bit value first to ensure 64-bit alignment for atomic operations.
+	d int64
+
+	changed chan struct{}
+}
+
+// NewInterval returns an Interval set to DefaultInterval.
+func NewInterval() *Interval {
+	return &Interval{
+		d:       int64(DefaultInterval),
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Get returns the current interval.
+func (i *Interval) Get() time.Duration {
+	return time.Duration(atomic.LoadInt64(&i.d))
+}
+
+// Set changes the interval and wakes up the monitor waiting on Changed().
+func (i *Interval) Set(d time.Duration) error {
+	if err := ValidateInterval(d); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&i.d, int64(d))
+
+	// A pending notification already wakes the monitor up.
+	select {
+	case i.changed <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Changed is signalled when the interval changes, so a monitor does not have to sit out a
+// long interval before picking up a shorter one.
+func (i *Interval) Changed() <-chan struct{} {
+	return i.changed
+}
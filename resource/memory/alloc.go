@@ -0,0 +1,26 @@
+package memory
+
+// allocator allocates and frees the chunks of the memory load.
+type allocator interface {
+	alloc(size int) ([]byte, error)
+	free(b []byte)
+	// faultIn tells whether new chunks are to be written page by page, so they are backed
+	// by physical memory right away.
+	faultIn() bool
+}
+
+// heapAllocator allocates chunks on the Go heap.
+type heapAllocator struct{}
+
+func (heapAllocator) alloc(size int) ([]byte, error) {
+	return make([]byte, size), nil
+}
+
+// free does nothing, the garbage collector reclaims the chunk once it is dropped.
+func (heapAllocator) free(b []byte) {}
+
+// faultIn is true, since fresh heap memory is only backed by physical memory once it is
+// written to.
+func (heapAllocator) faultIn() bool {
+	return true
+}
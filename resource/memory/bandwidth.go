@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// gigaBytes is the unit of memory bandwidth.
+const gigaBytes = 1000 * 1000 * 1000
+
+// MaxBandwidthWorkers is the maximum number of memory bandwidth workers.
+const MaxBandwidthWorkers = 256
+
+// countSep is the byte the read workers count, to force a full scan of the chunk.
+var countSep = []byte{0xff}
+
+// ValidateBandwidth checks that the memory bandwidth mode can run with the given
+// configuration.
+func ValidateBandwidth(c resource.BandwidthConfig) error {
+	if c.Workers < 0 || c.Workers > MaxBandwidthWorkers {
+		return fmt.Errorf("workers must be between 0-%d, got: %d", MaxBandwidthWorkers, c.Workers)
+	}
+	if c.Target < 0 || math.IsInf(c.Target, 0) || math.IsNaN(c.Target) {
+		return fmt.Errorf("target bandwidth must be positive: %g", c.Target)
+	}
+	if c.Workers > 0 && c.Op != "read" && c.Op != "write" && c.Op != "copy" {
+		return errors.New("op must be read, write or copy")
+	}
+	return nil
+}
+
+// bandwidth streams reads and writes over the chunks of a memory load.
+type bandwidth struct {
+	// bytes counts the bytes moved within the current report window, achieved holds the
+	// bits of the float64 bandwidth of the last window. Kept first for 64-bit alignment
+	// of atomic operations.
+	bytes    uint64
+	achieved uint64
+
+	cancel chan struct{}
+	wg     sync.WaitGroup
+
+	load *Load
+	cfg  resource.BandwidthConfig
+}
+
+// startBandwidth starts the workers and the reporter of the bandwidth mode.
+func startBandwidth(load *Load, cfg resource.BandwidthConfig) *bandwidth {
+	b := &bandwidth{
+		cancel: make(chan struct{}),
+		load:   load,
+		cfg:    cfg,
+	}
+	for i := 0; i < int(cfg.Workers); i++ {
+		b.wg.Add(1)
+		go b.work(i)
+	}
+	b.wg.Add(1)
+	go b.report()
+	return b
+}
+
+// stop signals the workers and the reporter to stop and waits for them to return.
+func (b *bandwidth) stop() {
+	close(b.cancel)
+	b.wg.Wait()
+}
+
+// status returns the configuration and the bandwidth achieved over the last second.
+func (b *bandwidth) status() *resource.BandwidthStatus {
+	return &resource.BandwidthStatus{
+		Config:   b.cfg,
+		Achieved: math.Float64frombits(atomic.LoadUint64(&b.achieved)),
+	}
+}
+
+// work streams over its own share of the chunks one at a time, so no two workers write the
+// same chunk, and paces itself to its share of the target bandwidth.
+func (b *bandwidth) work(id int) {
+	defer b.wg.Done()
+
+	var (
+		rate    = b.cfg.Target * gigaBytes / float64(b.cfg.Workers)
+		workers = int(b.cfg.Workers)
+		start   = time.Now()
+		moved   float64
+		next    int
+	)
+	for {
+		select {
+		case <-b.cancel:
+			return
+		default:
+		}
+
+		b.load.chunksMtx.RLock()
+		n := len(b.load.chunks)
+		first, last := id*n/workers, (id+1)*n/workers
+		if first == last {
+			b.load.chunksMtx.RUnlock()
+			// No share of the allocation to stream over, wait for it to grow.
+			select {
+			case <-b.cancel:
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			start, moved = time.Now(), 0
+			continue
+		}
+		// The share moves as the allocation is resized.
+		if next < first || next >= last {
+			next = first
+		}
+		chunk := b.load.chunks[next]
+		switch b.cfg.Op {
+		case "read":
+			// Counting a byte is vectorised and scans the whole chunk.
+			bytes.Count(chunk, countSep)
+		case "write":
+			// Compiled to a memory clear, which runs at full bandwidth.
+			for i := range chunk {
+				chunk[i] = 0
+			}
+		case "copy":
+			half := len(chunk) / 2
+			copy(chunk[half:], chunk[:half])
+		}
+		b.load.chunksMtx.RUnlock()
+		next++
+
+		atomic.AddUint64(&b.bytes, megaBytes)
+		if rate == 0 {
+			continue
+		}
+		moved += megaBytes
+		if ahead := time.Duration(moved/rate*float64(time.Second)) - time.Since(start); ahead > time.Millisecond {
+			select {
+			case <-b.cancel:
+				return
+			case <-time.After(ahead):
+			}
+		}
+	}
+}
+
+// report computes the achieved bandwidth every second.
+func (b *bandwidth) report() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-b.cancel:
+			return
+		case now := <-ticker.C:
+			moved := atomic.SwapUint64(&b.bytes, 0)
+			gbps := float64(moved) / gigaBytes / now.Sub(last).Seconds()
+			atomic.StoreUint64(&b.achieved, math.Float64bits(math.Round(gbps*100)/100))
+			last = now
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+func TestValidateBandwidth(t *testing.T) {
+	for _, tc := range []struct {
+		cfg resource.BandwidthConfig
+		ok  bool
+	}{
+		{resource.BandwidthConfig{Workers: 1, Op: "copy"}, true},
+		{resource.BandwidthConfig{Workers: 4, Op: "read", Target: 1.5}, true},
+		{resource.BandwidthConfig{Workers: 0}, true},
+		{resource.BandwidthConfig{Workers: -1, Op: "copy"}, false},
+		{resource.BandwidthConfig{Workers: MaxBandwidthWorkers + 1, Op: "copy"}, false},
+		{resource.BandwidthConfig{Workers: 1, Op: "copy", Target: -1}, false},
+		{resource.BandwidthConfig{Workers: 1, Op: "fill"}, false},
+	} {
+		if err := ValidateBandwidth(tc.cfg); (err == nil) != tc.ok {
+			t.Errorf("%+v: got %v, want ok %t", tc.cfg, err, tc.ok)
+		}
+	}
+}
+
+// TestBandwidthStreams streams over an allocation while it is resized and touched, which
+// the race detector checks.
+func TestBandwidthStreams(t *testing.T) {
+	l := NewLoad(log.New(ioutil.Discard, "", 0))
+	l.Start()
+	defer l.Stop()
+
+	cfg := DefaultConfig()
+	cfg.Touch = "seq"
+	if err := l.Configure(cfg); err != nil {
+		t.Fatal(err)
+	}
+	l.Update(8)
+	for _, op := range []string{"read", "write", "copy"} {
+		if err := l.SetBandwidth(resource.BandwidthConfig{Workers: 3, Op: op}); err != nil {
+			t.Fatal(err)
+		}
+		l.Update(5)
+		time.Sleep(600 * time.Millisecond)
+		l.Update(8)
+		time.Sleep(600 * time.Millisecond)
+	}
+	if err := l.SetBandwidth(resource.BandwidthConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if s := l.Status(); s.Bandwidth != nil || s.Allocated != 8*megaBytes {
+		t.Errorf("unexpected status after streaming: %+v", s)
+	}
+}
@@ -1,5 +1,13 @@
 package memory
 
+import (
+	"time"
+)
+
 const (
 	megaBytes = 1 << 20
+
+	// MinLeakInterval and MaxLeakInterval are the bounds of the leak mode interval.
+	MinLeakInterval = 10 * time.Millisecond
+	MaxLeakInterval = time.Hour
 )
@@ -1,31 +1,98 @@
 package memory
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"math"
 	"math/rand"
 	"os"
 	"runtime"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/milonoir/schwer/resource"
 )
 
+// DefaultConfig returns the configuration the memory load starts with: Go heap allocation
+// with the whole allocation touched at random every second.
+func DefaultConfig() resource.MemConfig {
+	return resource.MemConfig{
+		Allocator: "heap",
+		Touch:     "random",
+		Hot:       100,
+	}
+}
+
+// Validate checks that the memory load can run with the given configuration.
+func Validate(c resource.MemConfig) error {
+	switch c.Allocator {
+	case "heap":
+		if c.Populate || c.Lock || c.HugePages {
+			return errors.New("populate, lock and hugepages require the mmap allocator")
+		}
+	case "mmap":
+		if !mmapSupported {
+			return errors.New("mmap allocator is not supported on this platform")
+		}
+	default:
+		return fmt.Errorf("allocator must be heap or mmap, got: %q", c.Allocator)
+	}
+	if c.Touch != "seq" && c.Touch != "random" && c.Touch != "none" {
+		return fmt.Errorf("touch pattern must be seq, random or none, got: %q", c.Touch)
+	}
+	if c.Hot < 0 || c.Hot > 100 {
+		return fmt.Errorf("hot set must be between 0-100%%, got: %d", c.Hot)
+	}
+	return nil
+}
+
 // Load represents a memory load.
 type Load struct {
+	// requested is the requested allocation size in MB, allocated is the size actually
+	// held in bytes. growth and progress hold the bits of the float64 growth rate in MB/s
+	// and the progress of the latest resize in percent. Kept first for 64-bit alignment of
+	// atomic operations.
+	requested int64
+	allocated int64
+	growth    uint64
+	progress  uint64
+
 	cancel chan struct{}
 	wg     sync.WaitGroup
 	l      *log.Logger
 
-	alloc    [][]byte
-	change   chan int
-	pageSize int
+	// chunks are the megabyte-sized pieces of the allocation, allocated by allocator. The
+	// load goroutine locks chunksMtx to change or touch chunks, bandwidth workers read-lock it
+	// to stream over their own share of them.
+	chunks    [][]byte
+	chunksMtx sync.RWMutex
+	allocator allocator
+	change    chan int
+	leak      chan resource.LeakConfig
+	config    chan resource.MemConfig
+	pageSize  int
+
+	cfg       resource.MemConfig
+	leakCfg   *resource.LeakConfig
+	bandwidth *bandwidth
+	lastError string
+	mtx       sync.RWMutex
 }
 
 // NewLoad returns a configured memory load.
 func NewLoad(l *log.Logger) *Load {
 	return &Load{
-		change:   make(chan int, 1),
-		pageSize: os.Getpagesize(),
-		l:        l,
+		allocator: heapAllocator{},
+		change:    make(chan int, 1),
+		leak:      make(chan resource.LeakConfig, 1),
+		config:    make(chan resource.MemConfig, 1),
+		pageSize:  os.Getpagesize(),
+		progress:  math.Float64bits(100),
+		cfg:       DefaultConfig(),
+		l:         l,
 	}
 }
 
@@ -37,24 +104,197 @@ func (l *Load) Start() {
 	go l.load()
 }
 
-// Stop signals the load goroutine to stop and waits for it to return.
+// Stop stops the bandwidth mode, signals the load goroutine to stop and waits for it to
+// return.
 func (l *Load) Stop() {
+	l.mtx.Lock()
+	if l.bandwidth != nil {
+		l.bandwidth.stop()
+		l.bandwidth = nil
+	}
+	l.mtx.Unlock()
+
 	close(l.cancel)
 	l.wg.Wait()
 }
 
-// Update updates the allocated memory size.
+// Update updates the allocated memory size and ends leak mode. Only the difference to the
+// current size is allocated or released. A resize still underway is abandoned, and the new
+// one starts from the size reached so far.
 func (l *Load) Update(size int64) {
 	l.l.Printf("updating mem load to %d MB\n", size)
+	atomic.StoreInt64(&l.requested, size)
+	l.setLeak(nil)
+
+	// Replace a pending update, the latest one wins, and drop a pending leak update, so it
+	// does not restart leaking after this update.
+	select {
+	case <-l.change:
+	default:
+	}
+	select {
+	case <-l.leak:
+	default:
+	}
 	l.change <- int(size)
 }
 
+// ValidateLeak checks that the memory load can grow in leak mode with the given
+// configuration.
+func ValidateLeak(cfg resource.LeakConfig) error {
+	if cfg.Rate < 0 {
+		return fmt.Errorf("leak rate must be positive: %d", cfg.Rate)
+	}
+	if cfg.Ceiling < 0 {
+		return fmt.Errorf("leak ceiling must be positive: %d", cfg.Ceiling)
+	}
+	interval := time.Duration(cfg.IntervalMs) * time.Millisecond
+	if interval < MinLeakInterval || interval > MaxLeakInterval {
+		return fmt.Errorf("leak interval must be between %s-%s, got: %s", MinLeakInterval, MaxLeakInterval, interval)
+	}
+	if cfg.Sawtooth && cfg.Ceiling == 0 {
+		return errors.New("sawtooth leak requires a ceiling")
+	}
+	return nil
+}
+
+// Leak starts growing the allocation by cfg.Rate MB every cfg.IntervalMs milliseconds,
+// starting from the current size. A rate of 0 stops growing, keeping what is held.
+func (l *Load) Leak(cfg resource.LeakConfig) error {
+	if err := ValidateLeak(cfg); err != nil {
+		return err
+	}
+
+	if cfg.Rate == 0 {
+		l.l.Printf("stopping mem leak\n")
+		l.setLeak(nil)
+	} else {
+		l.l.Printf("starting mem leak: %+v\n", cfg)
+		l.setLeak(&cfg)
+	}
+
+	// Replace a pending leak update, the latest one wins.
+	select {
+	case <-l.leak:
+	default:
+	}
+	l.leak <- cfg
+	return nil
+}
+
+// Configure validates and applies a new configuration. If the allocator settings change,
+// the allocation is released and allocated again with the new settings.
+func (l *Load) Configure(cfg resource.MemConfig) error {
+	if err := Validate(cfg); err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	l.cfg = cfg
+	l.mtx.Unlock()
+
+	l.l.Printf("updating mem load config: %+v\n", cfg)
+
+	// Replace a pending config update, the latest one wins.
+	select {
+	case <-l.config:
+	default:
+	}
+	l.config <- cfg
+	return nil
+}
+
+// SetBandwidth validates the configuration and restarts streaming over the allocation
+// with it, or stops streaming if there are no workers.
+func (l *Load) SetBandwidth(cfg resource.BandwidthConfig) error {
+	if err := ValidateBandwidth(cfg); err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.bandwidth != nil {
+		l.bandwidth.stop()
+		l.bandwidth = nil
+	}
+	if cfg.Workers == 0 {
+		l.l.Printf("stopping mem bandwidth\n")
+		return nil
+	}
+	l.l.Printf("starting mem bandwidth: %+v\n", cfg)
+	l.bandwidth = startBandwidth(l, cfg)
+	return nil
+}
+
+// Config returns the current configuration.
+func (l *Load) Config() resource.MemConfig {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	return l.cfg
+}
+
+// Status returns the requested and actually allocated size, the leak mode state and the
+// configuration.
+func (l *Load) Status() resource.MemLoadStatus {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	s := resource.MemLoadStatus{
+		Requested: atomic.LoadInt64(&l.requested),
+		Allocated: atomic.LoadInt64(&l.allocated),
+		Growth:    math.Float64frombits(atomic.LoadUint64(&l.growth)),
+		Progress:  math.Float64frombits(atomic.LoadUint64(&l.progress)),
+		Config:    l.cfg,
+		LastError: l.lastError,
+	}
+	if l.leakCfg != nil {
+		cfg := *l.leakCfg
+		s.Leak = &cfg
+	}
+	if l.bandwidth != nil {
+		s.Bandwidth = l.bandwidth.status()
+	}
+	return s
+}
+
+func (l *Load) setLeak(cfg *resource.LeakConfig) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.leakCfg = cfg
+}
+
+func (l *Load) setError(s string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.lastError = s
+}
+
 func (l *Load) load() {
 	defer l.wg.Done()
-	defer func() {
-		l.alloc = nil
-		runtime.GC()
-	}()
+	defer l.release()
+
+	// Keep writing to the hot set, so it won't get swapped.
+	touch := time.NewTicker(time.Second)
+	defer touch.Stop()
+
+	var (
+		cfg    = l.Config()
+		leak   resource.LeakConfig
+		ticker *time.Ticker
+		tick   <-chan time.Time
+	)
+	stopLeak := func() {
+		if ticker != nil {
+			ticker.Stop()
+			ticker, tick = nil, nil
+		}
+		l.setGrowth(0)
+	}
+	defer stopLeak()
 
 	for {
 		// Do not use default branch in select as we don't want a busy loop.
@@ -62,21 +302,175 @@ func (l *Load) load() {
 		case <-l.cancel:
 			return
 		case size := <-l.change:
-			l.alloc = nil
-			runtime.GC()
-			for page := 0; page < size*megaBytes/l.pageSize; page++ {
-				// Allocate memory in page-sized chunks.
-				chunk := make([]byte, l.pageSize)
-				l.alloc = append(l.alloc, chunk)
+			stopLeak()
+			l.resize(size)
+		case leak = <-l.leak:
+			stopLeak()
+			if leak.Rate > 0 {
+				ticker = time.NewTicker(time.Duration(leak.IntervalMs) * time.Millisecond)
+				tick = ticker.C
 			}
-			l.l.Printf("mem alloc - page size: %d bytes, pages: %d, size: %d MB\n", l.pageSize, len(l.alloc), len(l.alloc)*l.pageSize/megaBytes)
-		case <-time.After(time.Second):
-			// Make sure we use the allocated memory, so it won't get swapped.
-			if l.alloc != nil {
-				for page := 0; page < len(l.alloc); page++ {
-					l.alloc[page][rand.Intn(l.pageSize)]++
+		case next := <-l.config:
+			if next.Allocator != cfg.Allocator || next.Populate != cfg.Populate || next.Lock != cfg.Lock || next.HugePages != cfg.HugePages {
+				size := len(l.chunks)
+				l.release()
+				if next.Allocator == "mmap" {
+					l.allocator = mmapAllocator{populate: next.Populate, lock: next.Lock, hugePages: next.HugePages}
+				} else {
+					l.allocator = heapAllocator{}
+				}
+				if size > 0 {
+					l.resize(size)
 				}
 			}
+			cfg = next
+		case <-tick:
+			l.grow(leak)
+		case <-touch.C:
+			l.touch(cfg)
 		}
 	}
 }
+
+// resize grows or trims the allocation to the given number of megabytes, touching only
+// the difference. Growing is abandoned if the load is stopped, another update arrives or
+// the allocator fails.
+func (l *Load) resize(size int) {
+	l.setError("")
+	from := len(l.chunks)
+	switch {
+	case size < from:
+		l.chunksMtx.Lock()
+		for i := size; i < from; i++ {
+			l.allocator.free(l.chunks[i])
+			l.chunks[i] = nil
+		}
+		l.chunks = l.chunks[:size]
+		l.chunksMtx.Unlock()
+		atomic.StoreInt64(&l.allocated, int64(size*megaBytes))
+		// Return the released heap memory to the OS right away.
+		debug.FreeOSMemory()
+	case size > from:
+		for len(l.chunks) < size {
+			l.setProgress(float64(len(l.chunks)-from) / float64(size-from) * 100)
+			if l.interrupted() {
+				l.l.Printf("mem alloc interrupted at %d MB\n", len(l.chunks))
+				return
+			}
+			if err := l.add(); err != nil {
+				l.l.Printf("mem alloc stopped at %d MB: %s\n", len(l.chunks), err)
+				l.setError(err.Error())
+				return
+			}
+		}
+	}
+	l.setProgress(100)
+	l.l.Printf("mem alloc - page size: %d bytes, size: %d MB\n", l.pageSize, len(l.chunks))
+}
+
+// add allocates a chunk, writing every page of it if the allocator requires so.
+func (l *Load) add() error {
+	chunk, err := l.allocator.alloc(megaBytes)
+	if err != nil {
+		return err
+	}
+	if l.allocator.faultIn() {
+		for i := 0; i < len(chunk); i += l.pageSize {
+			chunk[i] = 1
+		}
+	}
+	l.chunksMtx.Lock()
+	l.chunks = append(l.chunks, chunk)
+	l.chunksMtx.Unlock()
+	atomic.AddInt64(&l.allocated, megaBytes)
+	return nil
+}
+
+// interrupted tells whether the load is stopped or another update is pending.
+func (l *Load) interrupted() bool {
+	select {
+	case <-l.cancel:
+		return true
+	default:
+	}
+	return len(l.change) > 0 || len(l.leak) > 0 || len(l.config) > 0
+}
+
+// touch writes a byte of every page of the hot set in order ("seq"), or as many bytes at
+// random pages of the hot set ("random").
+func (l *Load) touch(cfg resource.MemConfig) {
+	perChunk := megaBytes / l.pageSize
+	hot := len(l.chunks) * perChunk * int(cfg.Hot) / 100
+	if hot == 0 {
+		return
+	}
+
+	// The bandwidth workers write the chunks as well.
+	l.chunksMtx.Lock()
+	defer l.chunksMtx.Unlock()
+
+	switch cfg.Touch {
+	case "seq":
+		for page := 0; page < hot; page++ {
+			l.chunks[page/perChunk][page%perChunk*l.pageSize]++
+		}
+	case "random":
+		for i := 0; i < hot; i++ {
+			page := rand.Intn(hot)
+			l.chunks[page/perChunk][page%perChunk*l.pageSize+rand.Intn(l.pageSize)]++
+		}
+	}
+}
+
+// grow allocates one interval's worth of leak. Once the ceiling is reached, growth stops,
+// or everything is released with a sawtooth leak.
+func (l *Load) grow(cfg resource.LeakConfig) {
+	limit := len(l.chunks) + int(cfg.Rate)
+	if cfg.Ceiling > 0 {
+		if len(l.chunks) >= int(cfg.Ceiling) {
+			l.setGrowth(0)
+			if cfg.Sawtooth {
+				l.l.Printf("mem leak reached %d MB, releasing\n", cfg.Ceiling)
+				l.release()
+				atomic.StoreInt64(&l.requested, 0)
+			}
+			return
+		}
+		if limit > int(cfg.Ceiling) {
+			limit = int(cfg.Ceiling)
+		}
+	}
+
+	from := len(l.chunks)
+	for len(l.chunks) < limit {
+		if err := l.add(); err != nil {
+			l.l.Printf("mem leak stopped at %d MB: %s\n", len(l.chunks), err)
+			l.setError(err.Error())
+			break
+		}
+	}
+	atomic.StoreInt64(&l.requested, int64(len(l.chunks)))
+
+	secs := (time.Duration(cfg.IntervalMs) * time.Millisecond).Seconds()
+	l.setGrowth(float64(len(l.chunks)-from) / secs)
+}
+
+// release frees the whole allocation.
+func (l *Load) release() {
+	l.chunksMtx.Lock()
+	for _, chunk := range l.chunks {
+		l.allocator.free(chunk)
+	}
+	l.chunks = nil
+	l.chunksMtx.Unlock()
+	atomic.StoreInt64(&l.allocated, 0)
+	runtime.GC()
+}
+
+func (l *Load) setProgress(pct float64) {
+	atomic.StoreUint64(&l.progress, math.Float64bits(math.Round(pct*100)/100))
+}
+
+func (l *Load) setGrowth(mbps float64) {
+	atomic.StoreUint64(&l.growth, math.Float64bits(math.Round(mbps*100)/100))
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// mmapSupported tells whether the mmap allocator is available.
+const mmapSupported = true
+
+// mmapAllocator allocates chunks as anonymous private mappings outside of the Go heap.
+type mmapAllocator struct {
+	populate  bool
+	lock      bool
+	hugePages bool
+}
+
+func (a mmapAllocator) alloc(size int) ([]byte, error) {
+	flags := unix.MAP_ANON | unix.MAP_PRIVATE
+	if a.populate {
+		flags |= unix.MAP_POPULATE
+	}
+	b, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.hugePages {
+		if err := unix.Madvise(b, unix.MADV_HUGEPAGE); err != nil {
+			unix.Munmap(b)
+			return nil, err
+		}
+	}
+	if a.lock {
+		if err := unix.Mlock(b); err != nil {
+			unix.Munmap(b)
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func (a mmapAllocator) free(b []byte) {
+	// Unmapping also unlocks the pages.
+	unix.Munmap(b)
+}
+
+// faultIn is false. Mappings are prefaulted by the kernel with populate or lock, and are
+// otherwise only backed by physical memory where they are touched.
+func (a mmapAllocator) faultIn() bool {
+	return false
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+package memory
+
+import (
+	"errors"
+)
+
+// mmapSupported tells whether the mmap allocator is available.
+const mmapSupported = false
+
+// mmapAllocator is not available on this platform.
+type mmapAllocator struct {
+	populate  bool
+	lock      bool
+	hugePages bool
+}
+
+func (a mmapAllocator) alloc(size int) ([]byte, error) {
+	return nil, errors.New("mmap allocator is not supported on this platform")
+}
+
+func (a mmapAllocator) free(b []byte) {}
+
+func (a mmapAllocator) faultIn() bool {
+	return false
+}
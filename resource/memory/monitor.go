@@ -3,11 +3,16 @@ package memory
 import (
 	"log"
 	"math"
+	"os"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/milonoir/schwer/resource"
-	"github.com/shirou/gopsutil/mem"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/cgroup"
+	"github.com/milonoir/schwer/resource/event"
+	"github.com/shirou/gopsutil/process"
 )
 
 // Monitor represents a memory load monitor.
@@ -16,15 +21,37 @@ type Monitor struct {
 	wg     sync.WaitGroup
 	l      *log.Logger
 
-	usage resource.MemStats
-	mtx   sync.RWMutex
+	b        backend.Backend
+	cg       *cgroup.Cgroup
+	load     resource.MemLoad
+	hub      *event.Hub
+	interval *resource.Interval
+	proc     *process.Process
+
+	usage   resource.MemStats
+	precise resource.PreciseMemStats
+	mtx     sync.RWMutex
 }
 
-// NewMonitor returns a configured memory load monitor.
-func NewMonitor(l *log.Logger) *Monitor {
-	return &Monitor{
-		l: l,
+// NewMonitor returns a configured memory load monitor reading memory usage from b. If cg
+// is not nil, the usage of the cgroup is monitored as well. The memory held by load is
+// reported along with the usage of the process, which is always read locally. Every new
+// sample is published to hub.
+func NewMonitor(b backend.Backend, cg *cgroup.Cgroup, load resource.MemLoad, hub *event.Hub, l *log.Logger) *Monitor {
+	m := &Monitor{
+		l:        l,
+		b:        b,
+		cg:       cg,
+		load:     load,
+		hub:      hub,
+		interval: resource.NewInterval(),
+	}
+	if p, err := process.NewProcess(int32(os.Getpid())); err != nil {
+		l.Printf("error in getting process: %s\n", err)
+	} else {
+		m.proc = p
 	}
+	return m
 }
 
 // Start starts up the monitoring goroutine.
@@ -41,30 +68,60 @@ func (m *Monitor) Stop() {
 	m.wg.Wait()
 }
 
+// Interval returns the sampling interval of the monitor.
+func (m *Monitor) Interval() time.Duration {
+	return m.interval.Get()
+}
+
+// SetInterval changes the sampling interval of the monitor. It takes effect immediately,
+// even while the monitor is running.
+func (m *Monitor) SetInterval(d time.Duration) error {
+	return m.interval.Set(d)
+}
+
 // Usage returns the latest set of memory stats.
-func (m *Monitor) Usage() interface{} {
+func (m *Monitor) Usage() resource.MemStats {
 	m.mtx.RLock()
 	defer m.mtx.RUnlock()
 
 	return m.usage
 }
 
+// Precise returns the latest set of memory stats, with the host figures in MB with two
+// decimals.
+func (m *Monitor) Precise() resource.PreciseMemStats {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	p := m.precise
+	p.MemStats = m.usage
+	return p
+}
+
 // monitor is the memory load monitoring goroutine.
 func (m *Monitor) monitor() {
 	defer m.wg.Done()
 
+	// The first sample is taken right away.
+	var wait time.Duration
 	for {
 		select {
 		case <-m.cancel:
 			return
-		default:
-			usage, err := mem.VirtualMemory()
+		case <-m.interval.Changed():
+		case <-time.After(wait):
+			wait = m.interval.Get()
+			usage, err := m.b.Memory()
 			if err != nil {
 				m.l.Printf("error in getting virtual memory stats: %s\n", err)
 				continue
 			}
-			m.saveUsage(usage.Total, usage.Available, usage.Used, usage.UsedPercent)
-			time.Sleep(time.Second)
+			m.saveUsage(usage.Total, usage.Available, usage.Used, usage.UsedPct())
+			m.saveDetails(usage)
+			if m.cg != nil {
+				m.saveContainer()
+			}
+			m.hub.Publish(event.TypeMem, m.Usage())
 		}
 	}
 }
@@ -78,4 +135,91 @@ func (m *Monitor) saveUsage(total, avail, used uint64, usedPct float64) {
 	m.usage.Available = int(avail / megaBytes)
 	m.usage.Used = int(used / megaBytes)
 	m.usage.UsedPct = int(math.Round(usedPct))
+
+	m.precise.Total = preciseMB(total)
+	m.precise.Available = preciseMB(avail)
+	m.precise.Used = preciseMB(used)
+	m.precise.UsedPct = math.Round(usedPct*100) / 100
+}
+
+// saveDetails stores the page cache, swap, process and Go runtime memory usage, and the
+// memory held by the load. Usage which cannot be read is left at zero.
+func (m *Monitor) saveDetails(usage backend.MemSample) {
+	var proc *resource.ProcessMem
+	if m.proc != nil {
+		if info, err := m.proc.MemoryInfo(); err != nil {
+			m.l.Printf("error in getting process memory stats: %s\n", err)
+		} else {
+			proc = &resource.ProcessMem{
+				RSS:  int(info.RSS / megaBytes),
+				VMS:  int(info.VMS / megaBytes),
+				Swap: int(info.Swap / megaBytes),
+			}
+		}
+	}
+
+	var rt runtime.MemStats
+	runtime.ReadMemStats(&rt)
+	held := m.load.Status().Allocated
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.usage.Buffers = int(usage.Buffers / megaBytes)
+	m.usage.Cached = int(usage.Cached / megaBytes)
+	m.usage.SwapTotal = int(usage.SwapTotal / megaBytes)
+	m.usage.SwapUsed = int(usage.SwapUsed / megaBytes)
+	m.precise.Buffers = preciseMB(usage.Buffers)
+	m.precise.Cached = preciseMB(usage.Cached)
+	m.precise.SwapTotal = preciseMB(usage.SwapTotal)
+	m.precise.SwapUsed = preciseMB(usage.SwapUsed)
+	m.usage.Held = held
+	// A new value is stored each time, so copies handed out by Usage() are never mutated.
+	m.usage.Process = proc
+	m.usage.Runtime = resource.RuntimeMem{
+		HeapInUse:    int(rt.HeapInuse / megaBytes),
+		HeapAlloc:    int(rt.HeapAlloc / megaBytes),
+		HeapReleased: int(rt.HeapReleased / megaBytes),
+		Sys:          int(rt.Sys / megaBytes),
+		NumGC:        rt.NumGC,
+		PauseTotalMs: float64(rt.PauseTotalNs) / float64(time.Millisecond),
+		LastPauseMs:  float64(rt.PauseNs[(rt.NumGC+255)%256]) / float64(time.Millisecond),
+	}
+}
+
+// preciseMB converts bytes to MB with two decimals.
+func preciseMB(v uint64) float64 {
+	return math.Round(float64(v)/megaBytes*100) / 100
+}
+
+// saveContainer reads the cgroup's memory accounting and stores it in MB.
+func (m *Monitor) saveContainer() {
+	stats, err := m.cg.Memory()
+	if err != nil {
+		m.l.Printf("error in getting cgroup memory stats: %s\n", err)
+		return
+	}
+
+	c := &resource.ContainerMem{
+		Cgroup: m.cg.Version(),
+		Used:   int(stats.Usage / megaBytes),
+		Limit:  int(stats.Limit / megaBytes),
+	}
+	if stats.Limit > 0 {
+		c.UsedPct = int(math.Round(100 * float64(stats.Usage) / float64(stats.Limit)))
+	}
+	if p := stats.Pressure; p != nil {
+		c.Pressure = &resource.MemoryPressure{
+			SomeAvg10: p.SomeAvg10,
+			SomeAvg60: p.SomeAvg60,
+			FullAvg10: p.FullAvg10,
+			FullAvg60: p.FullAvg60,
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// A new value is stored each time, so copies handed out by Usage() are never mutated.
+	m.usage.Container = c
 }
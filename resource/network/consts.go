@@ -0,0 +1,19 @@
+package network
+
+import (
+	"time"
+)
+
+const (
+	// bytesPerMbit converts Mbit/s to bytes per second.
+	bytesPerMbit = 1000 * 1000 / 8
+
+	// maxPacketSize is the largest UDP payload over IPv4.
+	maxPacketSize = 65507
+
+	// tick is how often the network load sends a batch of packets.
+	tick = 10 * time.Millisecond
+
+	dialTimeout   = 3 * time.Second
+	retryInterval = time.Second
+)
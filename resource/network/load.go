@@ -0,0 +1,313 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// DefaultConfig returns the configuration of an idle network load.
+func DefaultConfig() resource.NetConfig {
+	return resource.NetConfig{
+		Protocol:   "tcp",
+		PacketSize: 1400,
+	}
+}
+
+// Validate checks that the network load can run with the given configuration.
+func Validate(c resource.NetConfig) error {
+	if c.Throughput < 0 {
+		return fmt.Errorf("throughput must be positive: %d", c.Throughput)
+	}
+	if c.PPS < 0 {
+		return fmt.Errorf("packets per second must be positive: %d", c.PPS)
+	}
+	if c.PacketSize < 1 || c.PacketSize > maxPacketSize {
+		return fmt.Errorf("packet size must be between 1-%d bytes, got: %d", maxPacketSize, c.PacketSize)
+	}
+	if c.Protocol != "tcp" && c.Protocol != "udp" {
+		return fmt.Errorf("protocol must be tcp or udp, got: %q", c.Protocol)
+	}
+	if c.Throughput > 0 && c.Target == "" {
+		return errors.New("target address is required")
+	}
+	if c.Target != "" {
+		if _, _, err := net.SplitHostPort(c.Target); err != nil {
+			return fmt.Errorf("invalid target address: %s", err)
+		}
+	}
+	if c.Sink != "" {
+		if _, _, err := net.SplitHostPort(c.Sink); err != nil {
+			return fmt.Errorf("invalid sink address: %s", err)
+		}
+	}
+	return nil
+}
+
+// Load represents a network load. It sends traffic to a target at a paced rate and, if
+// configured, runs a sink discarding traffic sent to it.
+type Load struct {
+	// Byte and error counters. Kept first for 64-bit alignment of atomic operations.
+	rx     uint64
+	errors uint64
+
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	change chan resource.NetConfig
+
+	cfg       resource.NetConfig
+	sink      *sink
+	status    resource.NetLoadStatus
+	lastError string
+	mtx       sync.RWMutex
+}
+
+// NewLoad returns a configured, idle network load.
+func NewLoad(l *log.Logger) *Load {
+	return &Load{
+		change: make(chan resource.NetConfig, 1),
+		cfg:    DefaultConfig(),
+		l:      l,
+	}
+}
+
+// Start starts up the load goroutine.
+func (l *Load) Start() {
+	l.cancel = make(chan struct{})
+
+	l.wg.Add(1)
+	go l.load()
+}
+
+// Stop signals the load goroutine to stop, waits for it to return and closes the sink.
+func (l *Load) Stop() {
+	close(l.cancel)
+	l.wg.Wait()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.sink != nil {
+		l.sink.close()
+		l.sink = nil
+	}
+}
+
+// Update updates the throughput in Mbit/s, keeping the rest of the configuration. Updates
+// which would leave the load without a target are ignored.
+func (l *Load) Update(mbps int64) {
+	l.mtx.Lock()
+	cfg := l.cfg
+	cfg.Throughput = mbps
+	if err := Validate(cfg); err != nil {
+		l.mtx.Unlock()
+		l.l.Printf("ignoring network load update: %s\n", err)
+		return
+	}
+	l.cfg = cfg
+	l.mtx.Unlock()
+
+	l.l.Printf("updating network load throughput to %d Mbit/s\n", mbps)
+	l.send(cfg)
+}
+
+// Configure validates and applies a new configuration. The sink is (re)started
+// synchronously, so failing to listen is reported as an error.
+func (l *Load) Configure(cfg resource.NetConfig) error {
+	if err := Validate(cfg); err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	if cfg.Sink != l.cfg.Sink {
+		if l.sink != nil {
+			l.sink.close()
+			l.sink = nil
+			l.l.Printf("network sink on %s closed\n", l.cfg.Sink)
+		}
+		if cfg.Sink != "" {
+			s, err := listen(cfg.Sink, &l.rx)
+			if err != nil {
+				l.cfg.Sink = ""
+				l.mtx.Unlock()
+				return err
+			}
+			l.sink = s
+			l.l.Printf("network sink listening on %s\n", s.tcp.Addr())
+		}
+	}
+	l.cfg = cfg
+	l.mtx.Unlock()
+
+	l.l.Printf("updating network load config: %+v\n", cfg)
+	l.send(cfg)
+	return nil
+}
+
+// Config returns the current configuration.
+func (l *Load) Config() resource.NetConfig {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	return l.cfg
+}
+
+// Status returns the configuration of the load and the traffic it sent and received.
+func (l *Load) Status() resource.NetLoadStatus {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	s := l.status
+	s.Config = l.cfg
+	s.Errors = atomic.LoadUint64(&l.errors)
+	s.LastError = l.lastError
+	return s
+}
+
+// send hands a configuration to the load goroutine, replacing a pending one.
+func (l *Load) send(cfg resource.NetConfig) {
+	select {
+	case <-l.change:
+	default:
+	}
+	l.change <- cfg
+}
+
+// window accumulates the traffic within a report window.
+type window struct {
+	start   time.Time
+	tx      int64
+	packets int64
+	rx      uint64
+}
+
+func (l *Load) load() {
+	defer l.wg.Done()
+
+	var (
+		cfg     = l.Config()
+		conn    net.Conn
+		retryAt time.Time
+		buf     = make([]byte, cfg.PacketSize)
+		// owed is the number of packets owed, including fractions carried over.
+		owed float64
+		w    = window{start: time.Now(), rx: atomic.LoadUint64(&l.rx)}
+	)
+	closeConn := func() {
+		if conn != nil {
+			conn.Close()
+			conn = nil
+		}
+	}
+	defer closeConn()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.cancel:
+			return
+		case next := <-l.change:
+			if next.Target != cfg.Target || next.Protocol != cfg.Protocol || next.Throughput == 0 {
+				closeConn()
+				retryAt = time.Time{}
+			}
+			if next.PacketSize != cfg.PacketSize {
+				buf = make([]byte, next.PacketSize)
+			}
+			cfg = next
+			owed = 0
+		case now := <-ticker.C:
+			if elapsed := now.Sub(w.start); elapsed >= time.Second {
+				rx := atomic.LoadUint64(&l.rx)
+				w.rx = rx - w.rx
+				l.saveStatus(w, elapsed, conn != nil)
+				w = window{start: now, rx: rx}
+			}
+
+			if cfg.Throughput == 0 {
+				continue
+			}
+
+			if conn == nil {
+				if now.Before(retryAt) {
+					continue
+				}
+				var err error
+				if conn, err = net.DialTimeout(cfg.Protocol, cfg.Target, dialTimeout); err != nil {
+					l.fail(err)
+					retryAt = now.Add(retryInterval)
+					continue
+				}
+			}
+
+			// Owe the packets of this tick, but never more than two ticks' worth, so a
+			// stall does not turn into a burst.
+			perTick := float64(cfg.Throughput*bytesPerMbit) * tick.Seconds() / float64(cfg.PacketSize)
+			if cfg.PPS > 0 {
+				perTick = math.Min(perTick, float64(cfg.PPS)*tick.Seconds())
+			}
+			owed = math.Min(owed+perTick, 2*perTick)
+
+			conn.SetWriteDeadline(now.Add(tick))
+			for ; owed >= 1; owed-- {
+				n, err := conn.Write(buf)
+				w.tx += int64(n)
+				if err != nil {
+					if ne, ok := err.(net.Error); ok && ne.Timeout() {
+						break
+					}
+					l.fail(err)
+					// UDP errors (e.g. ICMP port unreachable) do not break the socket.
+					if cfg.Protocol == "tcp" {
+						closeConn()
+						retryAt = now.Add(retryInterval)
+					}
+					break
+				}
+				w.packets++
+			}
+		}
+	}
+}
+
+// fail records a network error. Only the first error is logged, the rest are counted.
+func (l *Load) fail(err error) {
+	if atomic.AddUint64(&l.errors, 1) == 1 {
+		l.l.Printf("network load error: %s\n", err)
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.lastError = err.Error()
+}
+
+// saveStatus stores the traffic of a report window.
+func (l *Load) saveStatus(w window, elapsed time.Duration, connected bool) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	secs := elapsed.Seconds()
+	l.status = resource.NetLoadStatus{
+		Connected: connected,
+		TxMbps:    round(float64(w.tx) / bytesPerMbit / secs),
+		TxPPS:     round(float64(w.packets) / secs),
+		RxMbps:    round(float64(w.rx) / bytesPerMbit / secs),
+	}
+}
+
+// round rounds v to two decimal places.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,143 @@
+package network
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/backend"
+	"github.com/milonoir/schwer/resource/event"
+)
+
+// Monitor represents a network interface monitor.
+type Monitor struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	b        backend.Backend
+	hub      *event.Hub
+	interval *resource.Interval
+	prev     map[string]backend.NetCounters
+	at       time.Time
+
+	usage resource.NetStats
+	mtx   sync.RWMutex
+}
+
+// NewMonitor returns a configured network monitor reading interface counters from b. Every
+// new sample is published to hub.
+func NewMonitor(b backend.Backend, hub *event.Hub, l *log.Logger) *Monitor {
+	return &Monitor{
+		l:        l,
+		b:        b,
+		hub:      hub,
+		interval: resource.NewInterval(),
+	}
+}
+
+// Start starts up the monitoring goroutine.
+func (m *Monitor) Start() {
+	m.cancel = make(chan struct{})
+
+	m.wg.Add(1)
+	go m.monitor()
+}
+
+// Stop signals the monitoring goroutine to stop and waits for it to return.
+func (m *Monitor) Stop() {
+	close(m.cancel)
+	m.wg.Wait()
+}
+
+// Interval returns the sampling interval of the monitor.
+func (m *Monitor) Interval() time.Duration {
+	return m.interval.Get()
+}
+
+// SetInterval changes the sampling interval of the monitor. It takes effect immediately,
+// even while the monitor is running.
+func (m *Monitor) SetInterval(d time.Duration) error {
+	return m.interval.Set(d)
+}
+
+// Usage returns the latest traffic and error counters of every network interface.
+func (m *Monitor) Usage() resource.NetStats {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	// Return a deep-copy of values so we're not racy.
+	u := resource.NetStats{Interfaces: make([]resource.NetInterface, len(m.usage.Interfaces))}
+	copy(u.Interfaces, m.usage.Interfaces)
+	return u
+}
+
+// monitor is the network monitoring goroutine.
+func (m *Monitor) monitor() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cancel:
+			return
+		case <-m.interval.Changed():
+		case <-time.After(m.interval.Get()):
+			sample, err := m.b.Net()
+			if err == backend.ErrNotSupported {
+				m.l.Printf("network I/O counters are not supported by the %s backend\n", m.b.Name())
+				return
+			}
+			if err != nil {
+				m.l.Printf("error in getting network I/O counters: %s\n", err)
+				continue
+			}
+			m.saveUsage(sample)
+			m.hub.Publish(event.TypeNet, m.Usage())
+		}
+	}
+}
+
+// saveUsage computes the traffic of every interface since the previous reading.
+func (m *Monitor) saveUsage(sample backend.NetSample) {
+	now := sample.Time
+	prev, at := m.prev, m.at
+	m.prev = make(map[string]backend.NetCounters, len(sample.Interfaces))
+	for _, c := range sample.Interfaces {
+		m.prev[c.Name] = c
+	}
+	m.at = now
+
+	// Counters are cumulative, so the first reading only sets the baseline.
+	if at.IsZero() {
+		return
+	}
+	secs := now.Sub(at).Seconds()
+
+	interfaces := make([]resource.NetInterface, 0, len(sample.Interfaces))
+	for _, c := range sample.Interfaces {
+		p, ok := prev[c.Name]
+		if !ok {
+			continue
+		}
+
+		interfaces = append(interfaces, resource.NetInterface{
+			Name:      c.Name,
+			RxMbps:    round(float64(c.BytesRecv-p.BytesRecv) / bytesPerMbit / secs),
+			TxMbps:    round(float64(c.BytesSent-p.BytesSent) / bytesPerMbit / secs),
+			RxPPS:     round(float64(c.PktsRecv-p.PktsRecv) / secs),
+			TxPPS:     round(float64(c.PktsSent-p.PktsSent) / secs),
+			RxErrors:  c.ErrIn,
+			TxErrors:  c.ErrOut,
+			RxDropped: c.DropIn,
+			TxDropped: c.DropOut,
+		})
+	}
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.usage.Interfaces = interfaces
+}
@@ -0,0 +1,119 @@
+package network
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// sink accepts TCP connections and UDP datagrams on the same address and discards
+// everything it receives.
+type sink struct {
+	tcp net.Listener
+	udp net.PacketConn
+	wg  sync.WaitGroup
+
+	// rx counts the bytes received.
+	rx *uint64
+
+	conns  map[net.Conn]struct{}
+	closed bool
+	mtx    sync.Mutex
+}
+
+// listen starts a sink on addr.
+func listen(addr string, rx *uint64) (*sink, error) {
+	tcp, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Bind UDP to the port TCP actually got, in case addr asked for any free port.
+	udp, err := net.ListenPacket("udp", tcp.Addr().String())
+	if err != nil {
+		tcp.Close()
+		return nil, err
+	}
+
+	s := &sink{
+		tcp:   tcp,
+		udp:   udp,
+		rx:    rx,
+		conns: make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(2)
+	go s.accept()
+	go s.drainPackets()
+	return s, nil
+}
+
+// close stops the sink and waits for all of its goroutines to return.
+func (s *sink) close() {
+	s.tcp.Close()
+	s.udp.Close()
+
+	s.mtx.Lock()
+	s.closed = true
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mtx.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *sink) accept() {
+	defer s.wg.Done()
+
+	for {
+		c, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+
+		// Registered under the lock, so a connection accepted while the sink is closing is
+		// either closed by close() or not drained at all.
+		s.mtx.Lock()
+		if s.closed {
+			s.mtx.Unlock()
+			c.Close()
+			return
+		}
+		s.conns[c] = struct{}{}
+		s.wg.Add(1)
+		s.mtx.Unlock()
+
+		go s.drainConn(c)
+	}
+}
+
+func (s *sink) drainConn(c net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, c)
+		s.mtx.Unlock()
+		c.Close()
+	}()
+
+	buf := make([]byte, 64<<10)
+	for {
+		n, err := c.Read(buf)
+		atomic.AddUint64(s.rx, uint64(n))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *sink) drainPackets() {
+	defer s.wg.Done()
+
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, _, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		atomic.AddUint64(s.rx, uint64(n))
+	}
+}
@@ -0,0 +1,14 @@
+package process
+
+const (
+	// MaxThreads is the maximum number of threads the thread load holds. It is kept well
+	// below the default limit of the Go runtime (10000), beyond which the process aborts.
+	MaxThreads = 9000
+
+	// fdReserve is how many file descriptors below the soft limit the file descriptor load
+	// stops at, so the process can still serve requests and release the load.
+	fdReserve = 64
+
+	// MaxGoroutines is the maximum number of goroutines the goroutine load holds.
+	MaxGoroutines = 1000000
+)
@@ -0,0 +1,36 @@
+package process
+
+import (
+	"golang.org/x/sys/unix"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// limits returns the file descriptor and process limits of the process.
+func limits() (*resource.ProcLimits, error) {
+	var fds, procs unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_NOFILE, &fds); err != nil {
+		return nil, err
+	}
+	if err := unix.Getrlimit(unix.RLIMIT_NPROC, &procs); err != nil {
+		return nil, err
+	}
+	return &resource.ProcLimits{
+		FDs:   limit(fds),
+		Procs: limit(procs),
+	}, nil
+}
+
+func limit(r unix.Rlimit) resource.Limit {
+	return resource.Limit{
+		Soft: limitValue(r.Cur),
+		Hard: limitValue(r.Max),
+	}
+}
+
+func limitValue(v uint64) int64 {
+	if v == unix.RLIM_INFINITY {
+		return -1
+	}
+	return int64(v)
+}
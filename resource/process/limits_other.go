@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package process
+
+import (
+	"github.com/milonoir/schwer/resource"
+)
+
+// limits returns the file descriptor and process limits of the process. They are not
+// reported on this platform.
+func limits() (*resource.ProcLimits, error) {
+	return nil, nil
+}
@@ -0,0 +1,167 @@
+package process
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+// Load represents a load which holds a number of process resources: open file
+// descriptors, OS threads or goroutines.
+type Load struct {
+	// Item counters. Kept first for 64-bit alignment of atomic operations.
+	requested int64
+	held      int64
+
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	name   string
+	max    int64
+	pool   pool
+	change chan int64
+
+	lastError string
+	mtx       sync.RWMutex
+}
+
+// NewFDLoad returns a load which holds open file descriptors. It stops a little below the
+// soft limit of open file descriptors, if there is one.
+func NewFDLoad(l *log.Logger) *Load {
+	var max int64
+	if lim, err := limits(); err == nil && lim != nil && lim.FDs.Soft > fdReserve {
+		max = lim.FDs.Soft - fdReserve
+	}
+	return newLoad("fd", max, &fdPool{}, l)
+}
+
+// NewThreadLoad returns a load which holds OS threads.
+func NewThreadLoad(l *log.Logger) *Load {
+	return newLoad("thread", MaxThreads, &goroutinePool{lock: true}, l)
+}
+
+// NewGoroutineLoad returns a load which holds parked goroutines.
+func NewGoroutineLoad(l *log.Logger) *Load {
+	return newLoad("goroutine", MaxGoroutines, &goroutinePool{}, l)
+}
+
+func newLoad(name string, max int64, p pool, l *log.Logger) *Load {
+	return &Load{
+		name:   name,
+		max:    max,
+		pool:   p,
+		change: make(chan int64, 1),
+		l:      l,
+	}
+}
+
+// Start starts up the load goroutine.
+func (l *Load) Start() {
+	l.cancel = make(chan struct{})
+
+	l.wg.Add(1)
+	go l.load()
+}
+
+// Stop signals the load goroutine to stop and waits for it to release every item.
+func (l *Load) Stop() {
+	close(l.cancel)
+	l.wg.Wait()
+}
+
+// Update updates the number of items held. It is capped at Max().
+func (l *Load) Update(n int64) {
+	if l.max > 0 && n > l.max {
+		n = l.max
+	}
+	l.l.Printf("updating %s load to %d\n", l.name, n)
+	atomic.StoreInt64(&l.requested, n)
+
+	// Replace a pending update, the latest one wins.
+	select {
+	case <-l.change:
+	default:
+	}
+	l.change <- n
+}
+
+// Max returns the maximum number of items the load holds, 0 if there is none.
+func (l *Load) Max() int64 {
+	return l.max
+}
+
+// Status returns the requested and actually held number of items.
+func (l *Load) Status() resource.CountLoadStatus {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	return resource.CountLoadStatus{
+		Requested: atomic.LoadInt64(&l.requested),
+		Held:      atomic.LoadInt64(&l.held),
+		Max:       l.max,
+		LastError: l.lastError,
+	}
+}
+
+func (l *Load) load() {
+	defer l.wg.Done()
+	defer l.releaseAll()
+
+	for {
+		select {
+		case <-l.cancel:
+			return
+		case n := <-l.change:
+			l.setError("")
+			l.resize(n)
+		}
+	}
+}
+
+// resize acquires or releases items until n are held. It retargets if a new update
+// arrives meanwhile, gives up if acquiring fails, and returns early if the load is stopped.
+func (l *Load) resize(n int64) {
+	for {
+		held := atomic.LoadInt64(&l.held)
+		if held == n {
+			return
+		}
+
+		select {
+		case <-l.cancel:
+			return
+		case n = <-l.change:
+			continue
+		default:
+		}
+
+		if held > n {
+			l.pool.release()
+			atomic.AddInt64(&l.held, -1)
+			continue
+		}
+		if err := l.pool.acquire(); err != nil {
+			l.l.Printf("%s load stopped at %d: %s\n", l.name, held, err)
+			l.setError(err.Error())
+			return
+		}
+		atomic.AddInt64(&l.held, 1)
+	}
+}
+
+// releaseAll releases every item held.
+func (l *Load) releaseAll() {
+	for ; atomic.LoadInt64(&l.held) > 0; atomic.AddInt64(&l.held, -1) {
+		l.pool.release()
+	}
+}
+
+func (l *Load) setError(s string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.lastError = s
+}
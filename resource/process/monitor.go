@@ -0,0 +1,136 @@
+package process
+
+import (
+	"log"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/resource/event"
+	"github.com/shirou/gopsutil/process"
+)
+
+// Monitor represents a monitor of the resources held by the Schwer process itself.
+type Monitor struct {
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	hub      *event.Hub
+	interval *resource.Interval
+	// failed records the readings which failed, so each failure is logged only once.
+	failed map[string]bool
+
+	usage resource.ProcStats
+	mtx   sync.RWMutex
+}
+
+// NewMonitor returns a configured process monitor. Every new sample is published to hub.
+func NewMonitor(hub *event.Hub, l *log.Logger) *Monitor {
+	return &Monitor{
+		l:        l,
+		hub:      hub,
+		interval: resource.NewInterval(),
+		failed:   make(map[string]bool),
+	}
+}
+
+// Start starts up the monitoring goroutine.
+func (m *Monitor) Start() {
+	m.cancel = make(chan struct{})
+
+	m.wg.Add(1)
+	go m.monitor()
+}
+
+// Stop signals the monitoring goroutine to stop and waits for it to return.
+func (m *Monitor) Stop() {
+	close(m.cancel)
+	m.wg.Wait()
+}
+
+// Interval returns the sampling interval of the monitor.
+func (m *Monitor) Interval() time.Duration {
+	return m.interval.Get()
+}
+
+// SetInterval changes the sampling interval of the monitor. It takes effect immediately,
+// even while the monitor is running.
+func (m *Monitor) SetInterval(d time.Duration) error {
+	return m.interval.Set(d)
+}
+
+// Usage returns the latest number of file descriptors, threads and goroutines of the
+// process along with its limits.
+func (m *Monitor) Usage() resource.ProcStats {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	u := m.usage
+	if u.Limits != nil {
+		l := *u.Limits
+		u.Limits = &l
+	}
+	return u
+}
+
+// monitor is the process monitoring goroutine.
+func (m *Monitor) monitor() {
+	defer m.wg.Done()
+
+	p, err := process.NewProcess(int32(os.Getpid()))
+	if err != nil {
+		m.l.Printf("error in getting process: %s\n", err)
+		return
+	}
+
+	for {
+		select {
+		case <-m.cancel:
+			return
+		case <-m.interval.Changed():
+		case <-time.After(m.interval.Get()):
+			m.saveUsage(p)
+			m.hub.Publish(event.TypeProc, m.Usage())
+		}
+	}
+}
+
+// saveUsage reads the current resources and limits of the process. Values which cannot
+// be read are left at zero.
+func (m *Monitor) saveUsage(p *process.Process) {
+	u := resource.ProcStats{
+		Goroutines: int64(runtime.NumGoroutine()),
+	}
+
+	if fds, err := p.NumFDs(); err != nil {
+		m.fail("number of file descriptors", err)
+	} else {
+		u.FDs = int64(fds)
+	}
+	if threads, err := p.NumThreads(); err != nil {
+		m.fail("number of threads", err)
+	} else {
+		u.Threads = int64(threads)
+	}
+	lim, err := limits()
+	if err != nil {
+		m.fail("process limits", err)
+	}
+	u.Limits = lim
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.usage = u
+}
+
+// fail logs the first failure of reading what.
+func (m *Monitor) fail(what string, err error) {
+	if !m.failed[what] {
+		m.failed[what] = true
+		m.l.Printf("error in getting %s: %s\n", what, err)
+	}
+}
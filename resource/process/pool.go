@@ -0,0 +1,65 @@
+package process
+
+import (
+	"os"
+	"runtime"
+)
+
+// pool is a set of items held by a load.
+type pool interface {
+	// acquire adds an item to the pool.
+	acquire() error
+	// release removes the most recently acquired item from the pool.
+	release()
+}
+
+// fdPool holds open file descriptors of the null device.
+type fdPool struct {
+	files []*os.File
+}
+
+func (p *fdPool) acquire() error {
+	f, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
+	}
+	p.files = append(p.files, f)
+	return nil
+}
+
+func (p *fdPool) release() {
+	last := len(p.files) - 1
+	p.files[last].Close()
+	p.files = p.files[:last]
+}
+
+// goroutinePool holds parked goroutines. If lock is set, each goroutine is locked to its
+// own OS thread, so the pool holds OS threads.
+type goroutinePool struct {
+	lock  bool
+	stops []chan struct{}
+}
+
+func (p *goroutinePool) acquire() error {
+	stop := make(chan struct{})
+	ready := make(chan struct{})
+	go func() {
+		if p.lock {
+			// The goroutine never unlocks, so the thread is terminated when it returns.
+			runtime.LockOSThread()
+		}
+		close(ready)
+		<-stop
+	}()
+
+	// Wait for the thread to be taken, so the number of items held is accurate.
+	<-ready
+	p.stops = append(p.stops, stop)
+	return nil
+}
+
+func (p *goroutinePool) release() {
+	last := len(p.stops) - 1
+	close(p.stops[last])
+	p.stops = p.stops[:last]
+}
@@ -0,0 +1,148 @@
+package profile
+
+import (
+	"math"
+	"time"
+)
+
+// Shape names of the supported profiles.
+const (
+	ShapeRamp   = "ramp"
+	ShapeStep   = "step"
+	ShapeSine   = "sine"
+	ShapeSquare = "square"
+)
+
+// Profile is implemented by time-based load shapes.
+type Profile interface {
+	// Shape returns the name of the profile's shape.
+	Shape() string
+	// Duration returns how long the profile runs. Zero means it runs until cancelled.
+	Duration() time.Duration
+	// Level returns the load level at the given elapsed time.
+	Level(elapsed time.Duration) int64
+	// Max returns the highest level the profile reaches.
+	Max() int64
+}
+
+// Ramp is a linear transition from one level to another.
+type Ramp struct {
+	From   int64
+	To     int64
+	Length time.Duration
+}
+
+// Shape implements Profile.
+func (r Ramp) Shape() string { return ShapeRamp }
+
+// Duration implements Profile.
+func (r Ramp) Duration() time.Duration { return r.Length }
+
+// Level implements Profile.
+func (r Ramp) Level(elapsed time.Duration) int64 {
+	if elapsed >= r.Length {
+		return r.To
+	}
+	frac := float64(elapsed) / float64(r.Length)
+	return r.From + int64(math.Round(frac*float64(r.To-r.From)))
+}
+
+// Max implements Profile.
+func (r Ramp) Max() int64 {
+	if r.From > r.To {
+		return r.From
+	}
+	return r.To
+}
+
+// Step is a staircase of levels, each held for the same amount of time.
+type Step struct {
+	Levels []int64
+	Hold   time.Duration
+}
+
+// Shape implements Profile.
+func (s Step) Shape() string { return ShapeStep }
+
+// Duration implements Profile.
+func (s Step) Duration() time.Duration { return time.Duration(len(s.Levels)) * s.Hold }
+
+// Level implements Profile.
+func (s Step) Level(elapsed time.Duration) int64 {
+	i := int(elapsed / s.Hold)
+	if i >= len(s.Levels) {
+		i = len(s.Levels) - 1
+	}
+	return s.Levels[i]
+}
+
+// Max implements Profile.
+func (s Step) Max() int64 {
+	max := s.Levels[0]
+	for _, v := range s.Levels[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// Sine is a sine wave oscillating around a base level.
+type Sine struct {
+	Base      int64
+	Amplitude int64
+	Period    time.Duration
+	Length    time.Duration
+}
+
+// Shape implements Profile.
+func (s Sine) Shape() string { return ShapeSine }
+
+// Duration implements Profile.
+func (s Sine) Duration() time.Duration { return s.Length }
+
+// Level implements Profile.
+func (s Sine) Level(elapsed time.Duration) int64 {
+	phase := 2 * math.Pi * float64(elapsed%s.Period) / float64(s.Period)
+	return s.Base + int64(math.Round(float64(s.Amplitude)*math.Sin(phase)))
+}
+
+// Max implements Profile.
+func (s Sine) Max() int64 { return s.Base + s.Amplitude }
+
+// Square alternates between a low and a high level. Duty is the percentage of the
+// period spent at the high level.
+type Square struct {
+	Low    int64
+	High   int64
+	Duty   int64
+	Period time.Duration
+	Length time.Duration
+}
+
+// Shape implements Profile.
+func (s Square) Shape() string { return ShapeSquare }
+
+// Duration implements Profile.
+func (s Square) Duration() time.Duration { return s.Length }
+
+// Level implements Profile.
+func (s Square) Level(elapsed time.Duration) int64 {
+	if elapsed%s.Period < s.Period*time.Duration(s.Duty)/100 {
+		return s.High
+	}
+	return s.Low
+}
+
+// Max implements Profile.
+func (s Square) Max() int64 {
+	switch {
+	case s.Duty == 0:
+		return s.Low
+	case s.Duty == 100:
+		return s.High
+	case s.Low > s.High:
+		return s.Low
+	}
+	return s.High
+}
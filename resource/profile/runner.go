@@ -0,0 +1,154 @@
+package profile
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// tick is how often a running profile re-evaluates its level.
+const tick = time.Second
+
+// Status describes the currently running profile and its progress.
+type Status struct {
+	Running  bool    `json:"running"`
+	Shape    string  `json:"shape,omitempty"`
+	Elapsed  float64 `json:"elapsed"`
+	Duration float64 `json:"duration,omitempty"`
+	Progress int     `json:"progress"`
+	Level    int64   `json:"level"`
+}
+
+// Runner drives a load through a profile.
+type Runner struct {
+	// runMtx serializes Run and Cancel, so no profile is started between cancelling the
+	// running one and installing the next one.
+	runMtx sync.Mutex
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	current Profile
+	started time.Time
+	level   int64
+	mtx     sync.RWMutex
+}
+
+// NewRunner returns a configured profile runner.
+func NewRunner(l *log.Logger) *Runner {
+	return &Runner{
+		l: l,
+	}
+}
+
+// Run cancels the running profile (if any) and starts driving set through p.
+func (r *Runner) Run(p Profile, set func(int64)) {
+	r.runMtx.Lock()
+	defer r.runMtx.Unlock()
+
+	r.stop()
+
+	cancel := make(chan struct{})
+	start := time.Now()
+	level := p.Level(0)
+
+	r.mtx.Lock()
+	r.cancel = cancel
+	r.current = p
+	r.started = start
+	r.level = level
+	r.mtx.Unlock()
+
+	r.l.Printf("starting %s profile (duration: %s)\n", p.Shape(), p.Duration())
+	set(level)
+
+	r.wg.Add(1)
+	go r.run(p, set, start, cancel)
+}
+
+// Cancel stops the running profile and waits for it to return. The load is left at
+// its last level.
+func (r *Runner) Cancel() {
+	r.runMtx.Lock()
+	defer r.runMtx.Unlock()
+
+	r.stop()
+}
+
+// stop stops the running profile and waits for it to return. runMtx must be held.
+func (r *Runner) stop() {
+	r.mtx.Lock()
+	if r.cancel != nil {
+		close(r.cancel)
+		r.cancel = nil
+	}
+	r.mtx.Unlock()
+	r.wg.Wait()
+}
+
+// Status returns the state of the running profile.
+func (r *Runner) Status() Status {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if r.current == nil {
+		return Status{}
+	}
+
+	elapsed := time.Since(r.started)
+	s := Status{
+		Running: true,
+		Shape:   r.current.Shape(),
+		Elapsed: elapsed.Seconds(),
+		Level:   r.level,
+	}
+	if d := r.current.Duration(); d > 0 {
+		s.Duration = d.Seconds()
+		s.Progress = int(100 * elapsed / d)
+		if s.Progress > 100 {
+			s.Progress = 100
+		}
+	}
+	return s
+}
+
+// run is the profile goroutine.
+func (r *Runner) run(p Profile, set func(int64), start time.Time, cancel <-chan struct{}) {
+	defer r.wg.Done()
+	defer func() {
+		r.mtx.Lock()
+		r.current = nil
+		r.mtx.Unlock()
+	}()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	level := p.Level(0)
+	for {
+		select {
+		case <-cancel:
+			r.l.Printf("%s profile cancelled\n", p.Shape())
+			return
+		case <-ticker.C:
+			elapsed := time.Since(start)
+			done := p.Duration() > 0 && elapsed >= p.Duration()
+			if done {
+				elapsed = p.Duration()
+			}
+
+			if next := p.Level(elapsed); next != level {
+				level = next
+				r.mtx.Lock()
+				r.level = level
+				r.mtx.Unlock()
+				set(level)
+			}
+
+			if done {
+				r.l.Printf("%s profile finished\n", p.Shape())
+				return
+			}
+		}
+	}
+}
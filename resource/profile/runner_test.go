@@ -0,0 +1,37 @@
+package profile
+
+import (
+	"io/ioutil"
+	"log"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunnerConcurrentRuns(t *testing.T) {
+	r := NewRunner(log.New(ioutil.Discard, "", 0))
+
+	var sets int64
+	set := func(int64) { atomic.AddInt64(&sets, 1) }
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Every profile changes its level on each tick.
+			r.Run(Square{Low: 0, High: int64(i + 1), Duty: 50, Period: 2 * tick}, set)
+		}(i)
+	}
+	wg.Wait()
+	r.Cancel()
+
+	if r.Status().Running {
+		t.Fatal("profile running after cancel")
+	}
+	n := atomic.LoadInt64(&sets)
+	time.Sleep(tick + tick/2)
+	if got := atomic.LoadInt64(&sets); got != n {
+		t.Errorf("levels set after cancel: %d", got-n)
+	}
+}
@@ -0,0 +1,98 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Spec is a declarative description of a profile.
+type Spec struct {
+	Shape     string
+	From      int64
+	To        int64
+	Levels    []int64
+	Hold      time.Duration
+	Base      int64
+	Amplitude int64
+	Low       int64
+	High      int64
+	Duty      int64
+	Period    time.Duration
+	Duration  time.Duration
+}
+
+// Build validates the spec and returns the profile it describes. All levels the profile
+// can produce must fall between min and max.
+func (s Spec) Build(min, max int64) (Profile, error) {
+	inRange := func(name string, v int64) error {
+		if v < min || v > max {
+			return fmt.Errorf("%s must be between %d-%d, got: %d", name, min, max, v)
+		}
+		return nil
+	}
+	if s.Duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+
+	switch s.Shape {
+	case ShapeRamp:
+		if s.Duration <= 0 {
+			return nil, errors.New("ramp requires a positive duration")
+		}
+		if err := inRange("from", s.From); err != nil {
+			return nil, err
+		}
+		if err := inRange("to", s.To); err != nil {
+			return nil, err
+		}
+		return Ramp{From: s.From, To: s.To, Length: s.Duration}, nil
+
+	case ShapeStep:
+		if len(s.Levels) == 0 {
+			return nil, errors.New("step requires at least one level")
+		}
+		if s.Hold <= 0 {
+			return nil, errors.New("step requires a positive hold time")
+		}
+		for _, v := range s.Levels {
+			if err := inRange("level", v); err != nil {
+				return nil, err
+			}
+		}
+		return Step{Levels: s.Levels, Hold: s.Hold}, nil
+
+	case ShapeSine:
+		if s.Period <= 0 {
+			return nil, errors.New("sine requires a positive period")
+		}
+		if s.Amplitude < 0 {
+			return nil, errors.New("amplitude must not be negative")
+		}
+		if err := inRange("base - amplitude", s.Base-s.Amplitude); err != nil {
+			return nil, err
+		}
+		if err := inRange("base + amplitude", s.Base+s.Amplitude); err != nil {
+			return nil, err
+		}
+		return Sine{Base: s.Base, Amplitude: s.Amplitude, Period: s.Period, Length: s.Duration}, nil
+
+	case ShapeSquare:
+		if s.Period <= 0 {
+			return nil, errors.New("square requires a positive period")
+		}
+		if s.Duty < 0 || s.Duty > 100 {
+			return nil, fmt.Errorf("duty must be between 0-100, got: %d", s.Duty)
+		}
+		if err := inRange("low", s.Low); err != nil {
+			return nil, err
+		}
+		if err := inRange("high", s.High); err != nil {
+			return nil, err
+		}
+		return Square{Low: s.Low, High: s.High, Duty: s.Duty, Period: s.Period, Length: s.Duration}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown profile shape: %q", s.Shape)
+	}
+}
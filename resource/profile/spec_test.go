@@ -0,0 +1,116 @@
+package profile
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpecBuild(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec Spec
+		ok   bool
+	}{
+		{"ramp", Spec{Shape: ShapeRamp, From: 10, To: 90, Duration: time.Minute}, true},
+		{"ramp down", Spec{Shape: ShapeRamp, From: 90, To: 0, Duration: time.Minute}, true},
+		{"ramp without duration", Spec{Shape: ShapeRamp, From: 10, To: 90}, false},
+		{"ramp out of bounds", Spec{Shape: ShapeRamp, From: 10, To: 101, Duration: time.Minute}, false},
+		{"step", Spec{Shape: ShapeStep, Levels: []int64{0, 50, 100}, Hold: time.Second}, true},
+		{"step without levels", Spec{Shape: ShapeStep, Hold: time.Second}, false},
+		{"step without hold", Spec{Shape: ShapeStep, Levels: []int64{50}}, false},
+		{"step level out of bounds", Spec{Shape: ShapeStep, Levels: []int64{50, -1}, Hold: time.Second}, false},
+		{"sine", Spec{Shape: ShapeSine, Base: 50, Amplitude: 50, Period: time.Second}, true},
+		{"sine without period", Spec{Shape: ShapeSine, Base: 50, Amplitude: 10}, false},
+		{"sine with negative amplitude", Spec{Shape: ShapeSine, Base: 50, Amplitude: -10, Period: time.Second}, false},
+		{"sine below bounds", Spec{Shape: ShapeSine, Base: 10, Amplitude: 20, Period: time.Second}, false},
+		{"sine above bounds", Spec{Shape: ShapeSine, Base: 90, Amplitude: 20, Period: time.Second}, false},
+		{"square", Spec{Shape: ShapeSquare, Low: 10, High: 90, Duty: 25, Period: time.Second}, true},
+		{"square without period", Spec{Shape: ShapeSquare, Low: 10, High: 90, Duty: 25}, false},
+		{"square duty out of bounds", Spec{Shape: ShapeSquare, Low: 10, High: 90, Duty: 101, Period: time.Second}, false},
+		{"square high out of bounds", Spec{Shape: ShapeSquare, Low: 10, High: 200, Duty: 50, Period: time.Second}, false},
+		{"negative duration", Spec{Shape: ShapeSine, Base: 50, Amplitude: 10, Period: time.Second, Duration: -time.Second}, false},
+		{"unknown shape", Spec{Shape: "zigzag"}, false},
+	} {
+		if _, err := tc.spec.Build(0, 100); (err == nil) != tc.ok {
+			t.Errorf("%s: got %v, want ok %t", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestProfileLevels(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     Spec
+		elapsed  []time.Duration
+		levels   []int64
+		max      int64
+		duration time.Duration
+	}{
+		{
+			name:     "ramp",
+			spec:     Spec{Shape: ShapeRamp, From: 10, To: 90, Duration: 8 * time.Second},
+			elapsed:  []time.Duration{0, time.Second, 4 * time.Second, 8 * time.Second, time.Minute},
+			levels:   []int64{10, 20, 50, 90, 90},
+			max:      90,
+			duration: 8 * time.Second,
+		},
+		{
+			name:     "ramp down",
+			spec:     Spec{Shape: ShapeRamp, From: 80, To: 20, Duration: 6 * time.Second},
+			elapsed:  []time.Duration{0, 3 * time.Second, 6 * time.Second},
+			levels:   []int64{80, 50, 20},
+			max:      80,
+			duration: 6 * time.Second,
+		},
+		{
+			name:     "step",
+			spec:     Spec{Shape: ShapeStep, Levels: []int64{30, 70, 50}, Hold: 10 * time.Second},
+			elapsed:  []time.Duration{0, 9 * time.Second, 10 * time.Second, 25 * time.Second, time.Hour},
+			levels:   []int64{30, 30, 70, 50, 50},
+			max:      70,
+			duration: 30 * time.Second,
+		},
+		{
+			name:    "sine",
+			spec:    Spec{Shape: ShapeSine, Base: 50, Amplitude: 20, Period: 4 * time.Second},
+			elapsed: []time.Duration{0, time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second},
+			levels:  []int64{50, 70, 50, 30, 70},
+			max:     70,
+		},
+		{
+			name:     "square",
+			spec:     Spec{Shape: ShapeSquare, Low: 10, High: 90, Duty: 25, Period: 4 * time.Second, Duration: time.Minute},
+			elapsed:  []time.Duration{0, 999 * time.Millisecond, time.Second, 3 * time.Second, 4 * time.Second},
+			levels:   []int64{90, 90, 10, 10, 90},
+			max:      90,
+			duration: time.Minute,
+		},
+		{
+			name:    "square never high",
+			spec:    Spec{Shape: ShapeSquare, Low: 10, High: 90, Duty: 0, Period: time.Second},
+			elapsed: []time.Duration{0, 500 * time.Millisecond},
+			levels:  []int64{10, 10},
+			max:     10,
+		},
+	} {
+		p, err := tc.spec.Build(0, 100)
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+		for i, e := range tc.elapsed {
+			if got := p.Level(e); got != tc.levels[i] {
+				t.Errorf("%s: level after %s: got %d, want %d", tc.name, e, got, tc.levels[i])
+			}
+		}
+		if got := p.Max(); got != tc.max {
+			t.Errorf("%s: max: got %d, want %d", tc.name, got, tc.max)
+		}
+		if got := p.Duration(); got != tc.duration {
+			t.Errorf("%s: duration: got %s, want %s", tc.name, got, tc.duration)
+		}
+		if got := p.Shape(); got != tc.spec.Shape {
+			t.Errorf("%s: shape: got %s, want %s", tc.name, got, tc.spec.Shape)
+		}
+	}
+}
@@ -1,12 +1,403 @@
 package resource
 
+import (
+	"time"
+)
+
 // CPULevels is the type returned by the Usage() method of a CPU load monitor.
 type CPULevels []int
 
-// MemStats is the type returned by the Usage() method of a memory load monitor.
+// MemStats is the type returned by the Usage() method of a memory load monitor. Host
+// figures are in MB, Held is the memory actually held by the memory load in bytes.
 type MemStats struct {
-	Total     int `json:"total"`
-	Available int `json:"available"`
-	Used      int `json:"used"`
-	UsedPct   int `json:"usedpct"`
+	Total     int            `json:"total"`
+	Available int            `json:"available"`
+	Used      int            `json:"used"`
+	UsedPct   int            `json:"usedpct"`
+	Buffers   int            `json:"buffers"`
+	Cached    int            `json:"cached"`
+	SwapTotal int            `json:"swap_total"`
+	SwapUsed  int            `json:"swap_used"`
+	Held      int64          `json:"held"`
+	Process   *ProcessMem    `json:"process,omitempty"`
+	Runtime   RuntimeMem     `json:"runtime"`
+	Container *ContainerMem  `json:"container,omitempty"`
+	Load      *MemLoadStatus `json:"load,omitempty"`
+}
+
+// PreciseMemStats is version 2 of the memory stats. The host figures are in MB with two
+// decimals instead of being truncated to whole MB; everything else is the same as in MemStats.
+type PreciseMemStats struct {
+	MemStats
+	Total     float64 `json:"total"`
+	Available float64 `json:"available"`
+	Used      float64 `json:"used"`
+	UsedPct   float64 `json:"usedpct"`
+	Buffers   float64 `json:"buffers"`
+	Cached    float64 `json:"cached"`
+	SwapTotal float64 `json:"swap_total"`
+	SwapUsed  float64 `json:"swap_used"`
+}
+
+// ProcessMem is the memory usage of the Schwer process in MB: its resident set size (RSS),
+// virtual memory size (VMS) and how much of it is swapped out.
+type ProcessMem struct {
+	RSS  int `json:"rss"`
+	VMS  int `json:"vms"`
+	Swap int `json:"swap"`
+}
+
+// RuntimeMem is the memory usage of the Go runtime of the Schwer process. Sizes are in MB,
+// GC pauses in milliseconds.
+type RuntimeMem struct {
+	HeapInUse    int     `json:"heap_inuse"`
+	HeapAlloc    int     `json:"heap_alloc"`
+	HeapReleased int     `json:"heap_released"`
+	Sys          int     `json:"sys"`
+	NumGC        uint32  `json:"num_gc"`
+	PauseTotalMs float64 `json:"gc_pause_total_ms"`
+	LastPauseMs  float64 `json:"gc_last_pause_ms"`
+}
+
+// ContainerMem is the memory usage of the cgroup Schwer runs in, in MB. Limit and UsedPct
+// are omitted if the cgroup has no memory limit.
+type ContainerMem struct {
+	Cgroup   int             `json:"cgroup"`
+	Used     int             `json:"used"`
+	Limit    int             `json:"limit,omitempty"`
+	UsedPct  int             `json:"usedpct,omitempty"`
+	Pressure *MemoryPressure `json:"pressure,omitempty"`
+}
+
+// MemoryPressure holds the share of time (in %) some or all tasks of the cgroup were
+// stalled on memory, averaged over 10s and 60s.
+type MemoryPressure struct {
+	SomeAvg10 float64 `json:"some_avg10"`
+	SomeAvg60 float64 `json:"some_avg60"`
+	FullAvg10 float64 `json:"full_avg10"`
+	FullAvg60 float64 `json:"full_avg60"`
+}
+
+// ContainerCPU is the CPU usage of the cgroup Schwer runs in. UsagePct is relative to the
+// cgroup's CPU limit, or to all CPU cores if it has none. Throttling counters are cumulative.
+type ContainerCPU struct {
+	Cgroup        int     `json:"cgroup"`
+	UsageCores    float64 `json:"usage_cores"`
+	UsagePct      int     `json:"usagepct"`
+	LimitCores    float64 `json:"limit_cores,omitempty"`
+	NrPeriods     uint64  `json:"nr_periods"`
+	NrThrottled   uint64  `json:"nr_throttled"`
+	ThrottledUsec uint64  `json:"throttled_usec"`
+}
+
+// CPUStatus is the detailed view of CPU utilisation and load.
+type CPUStatus struct {
+	Levels    CPULevels      `json:"levels"`
+	Feedback  FeedbackStatus `json:"feedback"`
+	PeriodMs  float64        `json:"period_ms"`
+	Workers   []WorkerStatus `json:"workers"`
+	Container *ContainerCPU  `json:"container,omitempty"`
+	TTL       *TTLStatus     `json:"ttl,omitempty"`
+	CPUDetails
+}
+
+// PreciseCPUStatus is version 3 of the CPU status. Utilisation levels have two decimals
+// instead of being rounded to integers; everything else is the same as in CPUStatus.
+type PreciseCPUStatus struct {
+	CPUStatus
+	Levels []float64 `json:"levels"`
+}
+
+// MonitorStatus describes a resource monitor.
+type MonitorStatus struct {
+	IntervalMs float64 `json:"interval_ms"`
+	Backend    string  `json:"backend,omitempty"`
+}
+
+// CPUDetails is the breakdown of CPU utilisation by mode, per core and over all cores, along
+// with the load averages, the CPU usage of the Schwer process and the current frequency of
+// each core in MHz. Parts which cannot be read on the platform are omitted.
+type CPUDetails struct {
+	Modes     []CPUModes  `json:"modes"`
+	Total     CPUModes    `json:"total"`
+	LoadAvg   *LoadAvg    `json:"loadavg,omitempty"`
+	Process   *ProcessCPU `json:"process,omitempty"`
+	Frequency []float64   `json:"freq_mhz,omitempty"`
+}
+
+// CPUModes is the share of time (in %) spent in each mode since the previous sample.
+type CPUModes struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Iowait  float64 `json:"iowait"`
+	Irq     float64 `json:"irq"`
+	Softirq float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
+	Idle    float64 `json:"idle"`
+}
+
+// LoadAvg is the 1, 5 and 15 minute load average of the host.
+type LoadAvg struct {
+	Load1  float64 `json:"load1"`
+	Load5  float64 `json:"load5"`
+	Load15 float64 `json:"load15"`
+}
+
+// ProcessCPU is the CPU usage of the Schwer process. Percent is relative to a single core,
+// so it exceeds 100 when more than one core is busy.
+type ProcessCPU struct {
+	Percent float64 `json:"percent"`
+}
+
+// WorkerStatus describes a CPU load worker. Core is -1 if the worker is not pinned.
+type WorkerStatus struct {
+	Core     int     `json:"core"`
+	Target   int64   `json:"target"`
+	Achieved float64 `json:"achieved"`
+}
+
+// FeedbackStatus describes the state of the closed-loop CPU load controller. Percentages
+// are of total CPU capacity.
+type FeedbackStatus struct {
+	Enabled   bool    `json:"enabled"`
+	Target    int64   `json:"target"`
+	Measured  float64 `json:"measured,omitempty"`
+	Error     float64 `json:"error,omitempty"`
+	Output    float64 `json:"output,omitempty"`
+	Converged bool    `json:"converged,omitempty"`
+}
+
+// MemLoadStatus describes the memory load. Requested is in MB, Allocated is in bytes,
+// Progress is how far the latest resize got in percent, and Growth is the rate the
+// allocation grew at over the last leak interval, in MB/s.
+type MemLoadStatus struct {
+	Requested int64            `json:"requested"`
+	Allocated int64            `json:"allocated"`
+	Progress  float64          `json:"progress"`
+	Growth    float64          `json:"growth_mbps"`
+	Leak      *LeakConfig      `json:"leak,omitempty"`
+	Config    MemConfig        `json:"config"`
+	Bandwidth *BandwidthStatus `json:"bandwidth,omitempty"`
+	LastError string           `json:"last_error,omitempty"`
+	TTL       *TTLStatus       `json:"ttl,omitempty"`
+}
+
+// BandwidthConfig configures the memory bandwidth mode: Workers goroutines stream Op
+// ("read", "write" or "copy") over the allocation of the memory load at Target GB/s in
+// total (0 is flat out). No workers turn the mode off.
+type BandwidthConfig struct {
+	Workers int64   `json:"workers"`
+	Target  float64 `json:"target"`
+	Op      string  `json:"op"`
+}
+
+// BandwidthStatus describes the memory bandwidth mode and the bandwidth it achieved over
+// the last second in GB/s.
+type BandwidthStatus struct {
+	Config   BandwidthConfig `json:"config"`
+	Achieved float64         `json:"achieved"`
+	TTL      *TTLStatus      `json:"ttl,omitempty"`
+}
+
+// MemConfig configures how the memory load allocates and uses memory. Allocator is "heap"
+// (Go heap) or "mmap" (anonymous private mappings, optionally prefaulted with Populate,
+// locked into RAM with Lock and advised to use transparent huge pages with HugePages).
+// Touch is the pattern the hot set, the first Hot percent of the allocation, is written
+// with every second: "seq", "random" or "none".
+type MemConfig struct {
+	Allocator string `json:"allocator"`
+	Populate  bool   `json:"populate"`
+	Lock      bool   `json:"lock"`
+	HugePages bool   `json:"hugepages"`
+	Touch     string `json:"touch"`
+	Hot       int64  `json:"hot"`
+}
+
+// LeakConfig configures the leak mode of the memory load: Rate MB are allocated every
+// IntervalMs milliseconds until Ceiling MB are held (0 is unlimited). With Sawtooth,
+// everything is released once the ceiling is reached and growth starts over.
+type LeakConfig struct {
+	Rate       int64 `json:"rate"`
+	IntervalMs int64 `json:"interval_ms"`
+	Ceiling    int64 `json:"ceiling"`
+	Sawtooth   bool  `json:"sawtooth"`
+}
+
+// LoadChange describes an update applied to a load. Core is only set for single core
+// CPU updates and Values only for per-core CPU updates.
+type LoadChange struct {
+	Resource string  `json:"resource"`
+	Core     *int    `json:"core,omitempty"`
+	Value    int64   `json:"value"`
+	Values   []int64 `json:"values,omitempty"`
+}
+
+// DiskConfig configures the disk load. Throughput is in MB/s and is split between reads
+// and writes by ReadPct. IOPS caps the number of operations per second (0 is unlimited).
+// BlockSize is in bytes, FileSize is in MB.
+type DiskConfig struct {
+	Dir        string `json:"dir"`
+	Throughput int64  `json:"throughput"`
+	ReadPct    int64  `json:"readpct"`
+	IOPS       int64  `json:"iops"`
+	BlockSize  int64  `json:"block_size"`
+	FileSize   int64  `json:"file_size"`
+	Random     bool   `json:"random"`
+	Fsync      bool   `json:"fsync"`
+	Direct     bool   `json:"direct"`
+}
+
+// DiskLoadStatus describes the disk load and the throughput it achieved over the last second.
+type DiskLoadStatus struct {
+	Config    DiskConfig `json:"config"`
+	ReadMBps  float64    `json:"read_mbps"`
+	WriteMBps float64    `json:"write_mbps"`
+	IOPS      float64    `json:"iops"`
+	LatencyMs float64    `json:"latency_ms"`
+	TTL       *TTLStatus `json:"ttl,omitempty"`
+}
+
+// DiskStats is the type returned by the Usage() method of a disk monitor.
+type DiskStats struct {
+	Devices []DiskDevice    `json:"devices"`
+	Load    *DiskLoadStatus `json:"load,omitempty"`
+}
+
+// DiskDevice is the throughput and average latency of a block device over the last
+// sampling interval.
+type DiskDevice struct {
+	Name           string  `json:"name"`
+	ReadMBps       float64 `json:"read_mbps"`
+	WriteMBps      float64 `json:"write_mbps"`
+	ReadIOPS       float64 `json:"read_iops"`
+	WriteIOPS      float64 `json:"write_iops"`
+	ReadLatencyMs  float64 `json:"read_latency_ms"`
+	WriteLatencyMs float64 `json:"write_latency_ms"`
+}
+
+// NetConfig configures the network load. Traffic is sent to Target over Protocol ("tcp" or
+// "udp") at Throughput Mbit/s in PacketSize byte writes, capped at PPS packets per second
+// (0 is unlimited). If Sink is set, incoming traffic is accepted and discarded on that
+// address over both TCP and UDP.
+type NetConfig struct {
+	Target     string `json:"target"`
+	Protocol   string `json:"protocol"`
+	Throughput int64  `json:"throughput"`
+	PPS        int64  `json:"pps"`
+	PacketSize int64  `json:"packet_size"`
+	Sink       string `json:"sink"`
+}
+
+// NetLoadStatus describes the network load and the traffic it sent and its sink received
+// over the last second. Throughput is in Mbit/s.
+type NetLoadStatus struct {
+	Config    NetConfig  `json:"config"`
+	Connected bool       `json:"connected"`
+	TxMbps    float64    `json:"tx_mbps"`
+	TxPPS     float64    `json:"tx_pps"`
+	RxMbps    float64    `json:"rx_mbps"`
+	Errors    uint64     `json:"errors"`
+	LastError string     `json:"last_error,omitempty"`
+	TTL       *TTLStatus `json:"ttl,omitempty"`
+}
+
+// NetStats is the type returned by the Usage() method of a network monitor.
+type NetStats struct {
+	Interfaces []NetInterface `json:"interfaces"`
+	Load       *NetLoadStatus `json:"load,omitempty"`
+}
+
+// NetInterface is the traffic of a network interface over the last sampling interval.
+// Throughput is in Mbit/s, error and drop counters are cumulative.
+type NetInterface struct {
+	Name      string  `json:"name"`
+	RxMbps    float64 `json:"rx_mbps"`
+	TxMbps    float64 `json:"tx_mbps"`
+	RxPPS     float64 `json:"rx_pps"`
+	TxPPS     float64 `json:"tx_pps"`
+	RxErrors  uint64  `json:"rx_errors"`
+	TxErrors  uint64  `json:"tx_errors"`
+	RxDropped uint64  `json:"rx_dropped"`
+	TxDropped uint64  `json:"tx_dropped"`
+}
+
+// ProcStats is the type returned by the Usage() method of a process monitor. It describes
+// the resources held by the Schwer process itself.
+type ProcStats struct {
+	FDs        int64       `json:"fds"`
+	Threads    int64       `json:"threads"`
+	Goroutines int64       `json:"goroutines"`
+	Limits     *ProcLimits `json:"limits,omitempty"`
+	Loads      *ProcLoads  `json:"loads,omitempty"`
+}
+
+// ProcLimits are the resource limits (ulimits) of the process.
+type ProcLimits struct {
+	FDs   Limit `json:"fds"`
+	Procs Limit `json:"procs"`
+}
+
+// Limit is a soft and hard resource limit pair. -1 means unlimited.
+type Limit struct {
+	Soft int64 `json:"soft"`
+	Hard int64 `json:"hard"`
+}
+
+// ProcLoads is the state of the file descriptor, thread and goroutine loads.
+type ProcLoads struct {
+	FDs        CountLoadStatus `json:"fds"`
+	Threads    CountLoadStatus `json:"threads"`
+	Goroutines CountLoadStatus `json:"goroutines"`
+}
+
+// CountLoadStatus is the requested and actually held number of items of a load, e.g. open
+// file descriptors, along with the error which stopped the load from reaching the request.
+type CountLoadStatus struct {
+	Requested int64      `json:"requested"`
+	Held      int64      `json:"held"`
+	Max       int64      `json:"max,omitempty"`
+	LastError string     `json:"last_error,omitempty"`
+	TTL       *TTLStatus `json:"ttl,omitempty"`
+}
+
+// TTLStatus is the pending expiry of a load: the time (in seconds) left until it reverts,
+// and whether it reverts to zero ("zero") or to its state before the update ("previous").
+type TTLStatus struct {
+	Remaining float64 `json:"remaining"`
+	Revert    string  `json:"revert"`
+}
+
+// GuardConfig configures the guardrails of the loads. The memory load never pushes the
+// available memory of the host below MemFloorMB MB or MemFloorPct percent of total memory,
+// nor the usage of the cgroup above its limit minus CgroupHeadroomMB MB. The CPU load never
+// pushes the CPU utilisation of the host above CPUCeiling percent. Zero disables a guardrail.
+type GuardConfig struct {
+	MemFloorMB       int64 `json:"mem_floor_mb"`
+	MemFloorPct      int64 `json:"mem_floor_pct"`
+	CgroupHeadroomMB int64 `json:"cgroup_headroom_mb"`
+	CPUCeiling       int64 `json:"cpu_ceiling_pct"`
+}
+
+// GuardBackoff describes a load backed off by a guardrail: By is in MB for the memory load
+// ("mem") and in percent of total CPU capacity for the CPU load ("cpu").
+type GuardBackoff struct {
+	Resource string    `json:"resource"`
+	By       float64   `json:"by"`
+	Reason   string    `json:"reason"`
+	Time     time.Time `json:"time"`
+}
+
+// GuardStatus describes the guardrails, how many times they backed off each load and the
+// latest backoff.
+type GuardStatus struct {
+	Config   GuardConfig       `json:"config"`
+	Backoffs map[string]uint64 `json:"backoffs"`
+	Last     *GuardBackoff     `json:"last,omitempty"`
+}
+
+// PauseStatus tells whether load updates are rejected, and since when.
+type PauseStatus struct {
+	Paused bool       `json:"paused"`
+	Since  *time.Time `json:"since,omitempty"`
 }
@@ -0,0 +1,57 @@
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration is a time.Duration which can be decoded from either a Go duration string
+// (e.g. "90s") or a number of seconds. It is encoded as a Go duration string.
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	return d.set(v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return time.Duration(d).String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return d.set(v)
+}
+
+func (d *Duration) set(v interface{}) error {
+	switch t := v.(type) {
+	case string:
+		pd, err := time.ParseDuration(t)
+		if err != nil {
+			return err
+		}
+		*d = Duration(pd)
+	case float64:
+		*d = Duration(t * float64(time.Second))
+	case int:
+		*d = Duration(time.Duration(t) * time.Second)
+	default:
+		return fmt.Errorf("invalid duration: %v", v)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestDurationDecode(t *testing.T) {
+	for _, tc := range []struct {
+		doc  string
+		want time.Duration
+		ok   bool
+	}{
+		{`"90s"`, 90 * time.Second, true},
+		{`"1h30m"`, 90 * time.Minute, true},
+		{`"250ms"`, 250 * time.Millisecond, true},
+		{`90`, 90 * time.Second, true},
+		{`1.5`, 1500 * time.Millisecond, true},
+		{`0`, 0, true},
+		{`"90"`, 0, false},
+		{`"soon"`, 0, false},
+		{`true`, 0, false},
+		{`[1]`, 0, false},
+	} {
+		var j Duration
+		err := json.Unmarshal([]byte(tc.doc), &j)
+		if (err == nil) != tc.ok || (tc.ok && time.Duration(j) != tc.want) {
+			t.Errorf("JSON %s: got %s, %v, want %s, ok %t", tc.doc, time.Duration(j), err, tc.want, tc.ok)
+		}
+
+		var y Duration
+		err = yaml.Unmarshal([]byte(tc.doc), &y)
+		if (err == nil) != tc.ok || (tc.ok && time.Duration(y) != tc.want) {
+			t.Errorf("YAML %s: got %s, %v, want %s, ok %t", tc.doc, time.Duration(y), err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestDurationEncode(t *testing.T) {
+	d := Duration(90 * time.Second)
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"1m30s"` {
+		t.Errorf("JSON: got %s, want \"1m30s\"", b)
+	}
+
+	y, err := yaml.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(y) != "1m30s\n" {
+		t.Errorf("YAML: got %q, want \"1m30s\\n\"", y)
+	}
+}
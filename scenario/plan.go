@@ -0,0 +1,156 @@
+package scenario
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/milonoir/schwer/resource/profile"
+	"gopkg.in/yaml.v2"
+)
+
+// Plan is a declarative, multi-resource load plan. Its phases are executed in order.
+type Plan struct {
+	Name   string  `json:"name" yaml:"name"`
+	Phases []Phase `json:"phases" yaml:"phases"`
+}
+
+// Phase sets resource loads and holds them for its duration before the next phase starts.
+// Loads which are not set in a phase are left unchanged.
+type Phase struct {
+	Name       string       `json:"name" yaml:"name"`
+	Duration   Duration     `json:"duration" yaml:"duration"`
+	CPU        *int64       `json:"cpu" yaml:"cpu"`
+	CPUProfile *ProfileSpec `json:"cpu_profile" yaml:"cpu_profile"`
+	Mem        *int64       `json:"mem" yaml:"mem"`
+}
+
+// ProfileSpec is the file representation of a profile.Spec. A profile without a duration
+// lasts as long as its phase, and is cancelled once its phase ends in any case.
+type ProfileSpec struct {
+	Shape     string   `json:"shape" yaml:"shape"`
+	From      int64    `json:"from" yaml:"from"`
+	To        int64    `json:"to" yaml:"to"`
+	Levels    []int64  `json:"levels" yaml:"levels"`
+	Hold      Duration `json:"hold" yaml:"hold"`
+	Base      int64    `json:"base" yaml:"base"`
+	Amplitude int64    `json:"amplitude" yaml:"amplitude"`
+	Low       int64    `json:"low" yaml:"low"`
+	High      int64    `json:"high" yaml:"high"`
+	Duty      int64    `json:"duty" yaml:"duty"`
+	Period    Duration `json:"period" yaml:"period"`
+	Duration  Duration `json:"duration" yaml:"duration"`
+}
+
+// Load reads and validates a plan from a YAML or JSON file.
+func Load(path string) (*Plan, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse decodes and validates a plan. Documents starting with '{' are decoded as JSON,
+// everything else as YAML. Unknown fields are rejected.
+func Parse(b []byte) (*Plan, error) {
+	p := &Plan{}
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(p); err != nil {
+			return nil, fmt.Errorf("invalid JSON scenario: %s", err)
+		}
+	} else if err := yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, fmt.Errorf("invalid YAML scenario: %s", err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks that every phase of the plan can be executed.
+func (p *Plan) Validate() error {
+	if len(p.Phases) == 0 {
+		return errors.New("scenario has no phases")
+	}
+	for i := range p.Phases {
+		if err := p.Phases[i].validate(); err != nil {
+			return fmt.Errorf("%s: %s", p.Phases[i].label(i), err)
+		}
+	}
+	return nil
+}
+
+// Duration returns the total length of the plan.
+func (p *Plan) Duration() time.Duration {
+	var d time.Duration
+	for _, ph := range p.Phases {
+		d += time.Duration(ph.Duration)
+	}
+	return d
+}
+
+// label identifies the i-th phase in errors and logs.
+func (ph *Phase) label(i int) string {
+	if ph.Name == "" {
+		return fmt.Sprintf("phase %d", i+1)
+	}
+	return fmt.Sprintf("phase %d (%s)", i+1, ph.Name)
+}
+
+func (ph *Phase) validate() error {
+	if ph.Duration < 0 {
+		return errors.New("duration must not be negative")
+	}
+	if ph.CPU == nil && ph.CPUProfile == nil && ph.Mem == nil {
+		return errors.New("phase sets neither cpu, cpu_profile nor mem")
+	}
+	if ph.CPU != nil && ph.CPUProfile != nil {
+		return errors.New("cpu and cpu_profile are mutually exclusive")
+	}
+	if ph.CPU != nil && (*ph.CPU < 0 || *ph.CPU > 100) {
+		return fmt.Errorf("cpu must be between 0-100, got: %d", *ph.CPU)
+	}
+	if ph.CPUProfile != nil {
+		if _, err := ph.profile(); err != nil {
+			return fmt.Errorf("cpu_profile: %s", err)
+		}
+	}
+	if ph.Mem != nil && *ph.Mem < 0 {
+		return fmt.Errorf("mem must be positive: %d", *ph.Mem)
+	}
+	return nil
+}
+
+// profile builds the CPU load profile of the phase.
+func (ph *Phase) profile() (profile.Profile, error) {
+	spec := ph.CPUProfile.Spec()
+	if spec.Shape != profile.ShapeStep && spec.Duration == 0 {
+		spec.Duration = time.Duration(ph.Duration)
+	}
+	return spec.Build(0, 100)
+}
+
+// Spec returns the profile.Spec s represents.
+func (s *ProfileSpec) Spec() profile.Spec {
+	return profile.Spec{
+		Shape:     s.Shape,
+		From:      s.From,
+		To:        s.To,
+		Levels:    s.Levels,
+		Hold:      time.Duration(s.Hold),
+		Base:      s.Base,
+		Amplitude: s.Amplitude,
+		Low:       s.Low,
+		High:      s.High,
+		Duty:      s.Duty,
+		Period:    time.Duration(s.Period),
+		Duration:  time.Duration(s.Duration),
+	}
+}
@@ -0,0 +1,97 @@
+package scenario
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		doc  string
+		// err is a part of the expected error, empty if the plan is valid.
+		err string
+	}{
+		{
+			name: "yaml",
+			doc: `
+name: warm-up
+phases:
+  - name: ramp
+    duration: 30s
+    cpu: 20
+    mem: 512
+  - duration: 90
+    cpu_profile:
+      shape: sine
+      base: 40
+      amplitude: 20
+      period: 10s
+`,
+		},
+		{
+			name: "json",
+			doc:  `{"name": "spike", "phases": [{"duration": "1m", "cpu": 80}, {"duration": 1.5, "mem": 0}]}`,
+		},
+		{
+			name: "json with leading space",
+			doc:  "\n  {\"phases\": [{\"duration\": \"1s\", \"cpu\": 1}]}",
+		},
+		{"invalid json", `{"phases": [`, "invalid JSON scenario"},
+		{"invalid yaml", "phases: [", "invalid YAML scenario"},
+		{"unknown json field", `{"phases": [{"duration": "1s", "cpu": 1, "disk": 1}]}`, "invalid JSON scenario"},
+		{"unknown yaml field", "phases:\n  - duration: 1s\n    cpu: 1\n    disk: 1\n", "invalid YAML scenario"},
+		{"no phases", `{"name": "empty"}`, "scenario has no phases"},
+		{"empty phase", `{"phases": [{"duration": "1s"}]}`, "phase 1: phase sets neither cpu, cpu_profile nor mem"},
+		{"negative duration", `{"phases": [{"duration": "-1s", "cpu": 1}]}`, "duration must not be negative"},
+		{"invalid duration", `{"phases": [{"duration": "soon", "cpu": 1}]}`, "invalid JSON scenario"},
+		{"cpu out of bounds", `{"phases": [{"name": "hot", "duration": "1s", "cpu": 101}]}`, "phase 1 (hot): cpu must be between 0-100"},
+		{"cpu and cpu_profile", `{"phases": [{"duration": "1s", "cpu": 1, "cpu_profile": {"shape": "step", "levels": [1], "hold": "1s"}}]}`, "mutually exclusive"},
+		{"invalid profile", `{"phases": [{"duration": "1s", "cpu_profile": {"shape": "zigzag"}}]}`, "cpu_profile:"},
+		{"negative mem", `{"phases": [{"duration": "1s", "mem": -1}]}`, "mem must be positive"},
+		{"second phase", `{"phases": [{"duration": "1s", "cpu": 1}, {"duration": "1s"}]}`, "phase 2:"},
+	} {
+		_, err := Parse([]byte(tc.doc))
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: got %v, want no error", tc.name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: got %v, want an error containing %q", tc.name, err, tc.err)
+		}
+	}
+}
+
+func TestParseDecodesPlan(t *testing.T) {
+	p, err := Parse([]byte(`
+name: warm-up
+phases:
+  - duration: 30s
+    cpu: 20
+  - duration: 90
+    cpu_profile:
+      shape: ramp
+      from: 10
+      to: 50
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "warm-up" || len(p.Phases) != 2 {
+		t.Fatalf("got %+v", p)
+	}
+	if got := p.Duration(); got != 2*time.Minute {
+		t.Errorf("duration: got %s, want 2m", got)
+	}
+	if cpu := p.Phases[0].CPU; cpu == nil || *cpu != 20 {
+		t.Errorf("cpu: got %v, want 20", cpu)
+	}
+	// A profile without a duration lasts as long as its phase.
+	pr, err := p.Phases[1].profile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := pr.Duration(); got != 90*time.Second {
+		t.Errorf("profile duration: got %s, want 1m30s", got)
+	}
+}
@@ -0,0 +1,179 @@
+package scenario
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/milonoir/schwer/resource/profile"
+)
+
+// Target is implemented by types which can apply the loads of a scenario.
+type Target interface {
+	UpdateCPULoad(int64) error
+	UpdateMemLoad(int64) error
+	RunCPUProfile(profile.Profile) error
+	CancelCPUProfile()
+}
+
+// Status describes the currently running scenario and its current phase.
+type Status struct {
+	Running       bool    `json:"running"`
+	Name          string  `json:"name,omitempty"`
+	Phase         int     `json:"phase,omitempty"`
+	Phases        int     `json:"phases,omitempty"`
+	PhaseName     string  `json:"phase_name,omitempty"`
+	PhaseElapsed  float64 `json:"phase_elapsed,omitempty"`
+	PhaseDuration float64 `json:"phase_duration,omitempty"`
+	Elapsed       float64 `json:"elapsed,omitempty"`
+	Duration      float64 `json:"duration,omitempty"`
+}
+
+// Runner executes scenarios against a target.
+type Runner struct {
+	// runMtx serializes Run and Abort, so no scenario is started between aborting the
+	// running one and installing the next one.
+	runMtx sync.Mutex
+	cancel chan struct{}
+	wg     sync.WaitGroup
+	l      *log.Logger
+
+	plan         *Plan
+	phase        int
+	started      time.Time
+	phaseStarted time.Time
+	mtx          sync.RWMutex
+}
+
+// NewRunner returns a configured scenario runner.
+func NewRunner(l *log.Logger) *Runner {
+	return &Runner{
+		l: l,
+	}
+}
+
+// Run aborts the running scenario (if any) and starts executing p against t.
+func (r *Runner) Run(p *Plan, t Target) {
+	r.runMtx.Lock()
+	defer r.runMtx.Unlock()
+
+	r.stop()
+
+	cancel := make(chan struct{})
+	r.mtx.Lock()
+	r.cancel = cancel
+	r.plan = p
+	r.phase = 0
+	r.started = time.Now()
+	r.mtx.Unlock()
+
+	r.l.Printf("starting scenario %q (%d phases, duration: %s)\n", p.Name, len(p.Phases), p.Duration())
+
+	r.wg.Add(1)
+	go r.run(p, t, cancel)
+}
+
+// Abort stops the running scenario and waits for it to return. Loads are left at
+// their current levels.
+func (r *Runner) Abort() {
+	r.runMtx.Lock()
+	defer r.runMtx.Unlock()
+
+	r.stop()
+}
+
+// stop stops the running scenario and waits for it to return. runMtx must be held.
+func (r *Runner) stop() {
+	r.mtx.Lock()
+	if r.cancel != nil {
+		close(r.cancel)
+		r.cancel = nil
+	}
+	r.mtx.Unlock()
+	r.wg.Wait()
+}
+
+// Status returns the state of the running scenario.
+func (r *Runner) Status() Status {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if r.plan == nil {
+		return Status{}
+	}
+
+	ph := r.plan.Phases[r.phase]
+	return Status{
+		Running:       true,
+		Name:          r.plan.Name,
+		Phase:         r.phase + 1,
+		Phases:        len(r.plan.Phases),
+		PhaseName:     ph.Name,
+		PhaseElapsed:  time.Since(r.phaseStarted).Seconds(),
+		PhaseDuration: time.Duration(ph.Duration).Seconds(),
+		Elapsed:       time.Since(r.started).Seconds(),
+		Duration:      r.plan.Duration().Seconds(),
+	}
+}
+
+// run is the scenario goroutine.
+func (r *Runner) run(p *Plan, t Target, cancel <-chan struct{}) {
+	defer r.wg.Done()
+	defer func() {
+		r.mtx.Lock()
+		r.plan = nil
+		r.mtx.Unlock()
+	}()
+
+	for i, ph := range p.Phases {
+		r.mtx.Lock()
+		r.phase = i
+		r.phaseStarted = time.Now()
+		r.mtx.Unlock()
+
+		r.l.Printf("scenario %q: starting %s of %d\n", p.Name, ph.label(i), len(p.Phases))
+		if err := apply(ph, t); err != nil {
+			// Plans are validated up front, but loads may still be rejected by the
+			// guardrails of the target.
+			r.l.Printf("scenario %q: %s: %s\n", p.Name, ph.label(i), err)
+		}
+
+		select {
+		case <-cancel:
+			t.CancelCPUProfile()
+			r.l.Printf("scenario %q aborted\n", p.Name)
+			return
+		case <-time.After(time.Duration(ph.Duration)):
+		}
+		// A profile is bound to the phase which started it.
+		if ph.CPUProfile != nil {
+			t.CancelCPUProfile()
+		}
+	}
+	r.l.Printf("scenario %q finished\n", p.Name)
+}
+
+// apply sets the loads of a phase on t. A load which is rejected does not keep the others
+// from being set; the first error is returned.
+func apply(ph Phase, t Target) error {
+	var errs []error
+	if ph.CPU != nil {
+		errs = append(errs, t.UpdateCPULoad(*ph.CPU))
+	}
+	if ph.CPUProfile != nil {
+		p, err := ph.profile()
+		if err != nil {
+			return err
+		}
+		errs = append(errs, t.RunCPUProfile(p))
+	}
+	if ph.Mem != nil {
+		errs = append(errs, t.UpdateMemLoad(*ph.Mem))
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
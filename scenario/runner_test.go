@@ -0,0 +1,110 @@
+package scenario
+
+import (
+	"io/ioutil"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource/profile"
+)
+
+// fakeTarget records the loads a scenario sets.
+type fakeTarget struct {
+	mtx      sync.Mutex
+	cpu      []int64
+	profiles []profile.Profile
+	cancels  int
+}
+
+func (t *fakeTarget) UpdateCPULoad(pct int64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.cpu = append(t.cpu, pct)
+	return nil
+}
+
+func (t *fakeTarget) UpdateMemLoad(int64) error { return nil }
+
+func (t *fakeTarget) RunCPUProfile(p profile.Profile) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.profiles = append(t.profiles, p)
+	return nil
+}
+
+func (t *fakeTarget) CancelCPUProfile() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.cancels++
+}
+
+func TestRunnerBindsProfileToPhase(t *testing.T) {
+	p, err := Parse([]byte(`
+phases:
+  - duration: 50ms
+    cpu_profile: {shape: sine, base: 50, amplitude: 10, period: 1s}
+  - duration: 10ms
+    mem: 0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(log.New(ioutil.Discard, "", 0))
+	target := &fakeTarget{}
+	r.Run(p, target)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for r.Status().Running {
+		if time.Now().After(deadline) {
+			t.Fatal("scenario did not finish")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	target.mtx.Lock()
+	defer target.mtx.Unlock()
+	if len(target.profiles) != 1 {
+		t.Fatalf("profiles: got %d, want 1", len(target.profiles))
+	}
+	if d := target.profiles[0].Duration(); d != 50*time.Millisecond {
+		t.Errorf("profile duration: got %s, want the phase duration", d)
+	}
+	if target.cancels != 1 {
+		t.Errorf("profile cancels: got %d, want 1", target.cancels)
+	}
+}
+
+func TestRunnerConcurrentRuns(t *testing.T) {
+	p, err := Parse([]byte(`{"phases": [{"duration": "1h", "cpu": 10}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(log.New(ioutil.Discard, "", 0))
+	target := &fakeTarget{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.Run(p, target)
+		}()
+	}
+	wg.Wait()
+	r.Abort()
+
+	// Every scenario has been aborted, so each one cancelled the profile on its way out.
+	target.mtx.Lock()
+	defer target.mtx.Unlock()
+	if r.Status().Running {
+		t.Error("scenario running after abort")
+	}
+	if len(target.cpu) != 8 || target.cancels != 8 {
+		t.Errorf("got %d updates and %d cancels, want 8 of each", len(target.cpu), target.cancels)
+	}
+}
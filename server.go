@@ -2,12 +2,17 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/milonoir/schwer/resource"
+	"github.com/milonoir/schwer/scenario"
 	_ "github.com/milonoir/schwer/statik"
 	"github.com/rakyll/statik/fs"
 )
@@ -15,23 +20,58 @@ import (
 const (
 	tplServerError = "Server error: %s"
 	tplParseError  = "Unable to parse request: %s"
+
+	maxScenarioSize = 1 << 20
+
+	defaultHistoryStep = 10 * time.Second
+
+	writeTimeout    = 10 * time.Second
+	streamKeepAlive = 15 * time.Second
 )
 
 // newServer returns a new configured http.Server with all endpoints registered to it.
 func newServer(port uint64, c *Controller, l *log.Logger) *http.Server {
 	router := http.NewServeMux()
-	router.Handle("/", indexHandler())
-	router.Handle("/cpu", cpuHandler(c))
-	router.Handle("/mem", memHandler(c))
+	api := apiHandler(c)
+	router.Handle(apiPrefix+"/", api)
 
-	return &http.Server{
-		Addr:         ":" + strconv.FormatUint(port, 10),
-		Handler:      router,
-		ErrorLog:     l,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  15 * time.Second,
+	// The legacy endpoints take form values, but accept the JSON bodies of the versioned API
+	// as well.
+	router.Handle("/", indexHandler()) // also serves the OpenAPI document at /api/openapi.json
+	router.Handle("/cpu", jsonAlias(cpuHandler(c), api))
+	router.Handle("/cpu/profile", jsonAlias(cpuProfileHandler(c), api))
+	router.Handle("/mem", jsonAlias(memHandler(c), api))
+	router.Handle("/mem/bandwidth", jsonAlias(memBandwidthHandler(c), api))
+	router.Handle("/disk", jsonAlias(diskHandler(c), api))
+	router.Handle("/net", jsonAlias(netHandler(c), api))
+	router.Handle("/proc", jsonAlias(procHandler(c), api))
+	router.Handle("/monitor", jsonAlias(monitorHandler(c), api))
+	router.Handle("/sample", sampleHandler(c))
+	router.Handle("/guard", guardHandler(c))
+	router.Handle("/stop-all", stopAllHandler(c))
+	router.Handle("/pause", pauseHandler(c))
+	router.Handle("/scenario", scenarioHandler(c))
+	router.Handle("/metrics", metricsHandler(c))
+	router.Handle("/history", historyHandler(c))
+
+	// Streams are long-lived, so they bypass the write timeout applied to everything else
+	// and are closed explicitly on shutdown.
+	shutdown := make(chan struct{})
+	root := http.NewServeMux()
+	root.Handle("/", http.TimeoutHandler(router, writeTimeout, "Server timeout"))
+	root.Handle("/stream", streamHandler(c, shutdown))
+
+	server := &http.Server{
+		Addr:        ":" + strconv.FormatUint(port, 10),
+		Handler:     root,
+		ErrorLog:    l,
+		ReadTimeout: 5 * time.Second,
+		IdleTimeout: 15 * time.Second,
 	}
+	server.RegisterOnShutdown(func() {
+		close(shutdown)
+	})
+	return server
 }
 
 // indexHandler is the main web front-end handler.
@@ -44,70 +84,911 @@ func indexHandler() http.Handler {
 }
 
 // cpuHandler handles requests for:
-// - (GET)  getting current CPU utilisation levels;
-// - (POST) updating CPU load percentage.
+// - (GET)  getting current CPU utilisation levels, or a detailed status with v=2 (v=3 for unrounded levels);
+// - (POST) updating CPU load percentage of all cores, a single core or each core separately;
+// - (POST) updating the duty cycle period and turning feedback mode on or off.
 func cpuHandler(c *Controller) http.Handler {
-	return makeHandler(
-		c.CPUUtilisationLevels,
-		c.UpdateCPULoad,
-		"pct",
-		func(pct int64) error {
-			if pct < 0 || pct > 100 {
-				return fmt.Errorf("Percentage value must be between 0-100, got: %d", pct)
-			}
-			return nil
-		},
-		"CPU load percentage updated",
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var v interface{}
+			switch r.FormValue("v") {
+			case "2":
+				v = c.CPUStatus()
+			case "3":
+				v = c.PreciseCPUStatus()
+			default:
+				v = c.CPUUtilisationLevels()
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeCPUForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// validatePct checks that a CPU load percentage is within bounds.
+func validatePct(pct int64) error {
+	if pct < 0 || pct > 100 {
+		return fmt.Errorf("Percentage value must be between 0-100, got: %d", pct)
+	}
+	return nil
+}
+
+// decodeCPUForm decodes the form of a CPU load update into a cpuRequest.
+func (c *Controller) decodeCPUForm(r *http.Request) (applyFunc, error) {
+	var (
+		req cpuRequest
+		err error
 	)
+	if req.Pct, err = formInt(r, "pct"); err != nil {
+		return nil, err
+	}
+	if v := r.FormValue("core"); v != "" {
+		core, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, invalidValue("Invalid core value")
+		}
+		req.Core = &core
+	}
+	if v := r.FormValue("pcts"); v != "" {
+		if err := json.Unmarshal([]byte(v), &req.Pcts); err != nil {
+			return nil, invalidValue("Invalid pcts value")
+		}
+	}
+	if req.Period, err = formDuration(r, "period"); err != nil {
+		return nil, err
+	}
+	if req.Feedback, err = formBool(r, "feedback"); err != nil {
+		return nil, err
+	}
+	if req.loadTTL, err = parseLoadTTL(r); err != nil {
+		return nil, err
+	}
+	return c.validateCPURequest(req)
+}
+
+// postForm handles a POST request to a legacy endpoint. decode turns the form values into
+// the request of the versioned API, which is validated and applied the same way, so both
+// fail with the same status codes. Only the responses are plain text.
+func postForm(w http.ResponseWriter, r *http.Request, decode func(r *http.Request) (applyFunc, error)) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf(tplParseError, err), http.StatusBadRequest)
+		return
+	}
+	apply, err := decode(r)
+	applyForm(w, apply, err)
+}
+
+// applyForm applies a request decoded by a legacy endpoint, unless decoding failed with
+// err, and writes the outcome as plain text.
+func applyForm(w http.ResponseWriter, apply applyFunc, err error) {
+	var msg string
+	if err == nil {
+		msg, err = apply()
+	}
+	if err != nil {
+		writeFormError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+	w.Write([]byte(msg))
+}
+
+// writeFormError writes err as plain text with the status code of the versioned API.
+// Errors other than apiError are internal errors.
+func writeFormError(w http.ResponseWriter, err error) {
+	if e, ok := err.(*apiError); ok {
+		http.Error(w, e.Message, e.status)
+		return
+	}
+	http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+}
+
+// formInt parses the integer form value name, which is nil if it is not present.
+func formInt(r *http.Request, name string) (*int64, error) {
+	v := r.FormValue(name)
+	if v == "" {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, invalidValue("Invalid %s value", name)
+	}
+	return &i, nil
+}
+
+// formBool parses the boolean form value name, which is nil if it is not present.
+func formBool(r *http.Request, name string) (*bool, error) {
+	v := r.FormValue(name)
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, invalidValue("Invalid %s value", name)
+	}
+	return &b, nil
+}
+
+// formDuration parses the duration form value name, which is nil if it is not present.
+func formDuration(r *http.Request, name string) (*scenario.Duration, error) {
+	v := r.FormValue(name)
+	if v == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, invalidValue("Invalid %s value", name)
+	}
+	sd := scenario.Duration(d)
+	return &sd, nil
+}
+
+// parseLoadTTL reads the time to live ("ttl") and revert mode ("revert") of a load update
+// from the parsed form of r. They are validated along with the rest of the request.
+func parseLoadTTL(r *http.Request) (loadTTL, error) {
+	ttl, err := formDuration(r, "ttl")
+	return loadTTL{TTL: ttl, Revert: r.FormValue("revert")}, err
+}
+
+// cpuProfileHandler handles requests for:
+// - (GET)    getting the currently running CPU load profile and its progress;
+// - (POST)   starting a new CPU load profile;
+// - (DELETE) cancelling the running CPU load profile.
+func cpuProfileHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.CPUProfileStatus())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeProfileForm)
+		case http.MethodDelete:
+			c.CancelCPUProfile()
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte("CPU load profile cancelled"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// decodeProfileForm decodes the form of a CPU load profile into a profileRequest.
+func (c *Controller) decodeProfileForm(r *http.Request) (applyFunc, error) {
+	spec, err := parseProfileSpec(r)
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	ttl, err := parseLoadTTL(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.validateProfileRequest(profileRequest{spec, ttl})
+}
+
+// parseProfileSpec reads a profile spec from the parsed form of r. Only the values
+// present in the form are set.
+func parseProfileSpec(r *http.Request) (scenario.ProfileSpec, error) {
+	spec := scenario.ProfileSpec{Shape: r.FormValue("shape")}
+
+	ints := map[string]*int64{
+		"from":      &spec.From,
+		"to":        &spec.To,
+		"base":      &spec.Base,
+		"amplitude": &spec.Amplitude,
+		"low":       &spec.Low,
+		"high":      &spec.High,
+		"duty":      &spec.Duty,
+	}
+	for name, dst := range ints {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return spec, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = i
+	}
+
+	durations := map[string]*scenario.Duration{
+		"hold":     &spec.Hold,
+		"period":   &spec.Period,
+		"duration": &spec.Duration,
+	}
+	for name, dst := range durations {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return spec, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = scenario.Duration(d)
+	}
+
+	if v := r.FormValue("levels"); v != "" {
+		for _, f := range strings.Split(v, ",") {
+			i, err := strconv.ParseInt(strings.TrimSpace(f), 10, 64)
+			if err != nil {
+				return spec, errors.New("Invalid levels value")
+			}
+			spec.Levels = append(spec.Levels, i)
+		}
+	}
+
+	return spec, nil
 }
 
 // memHandler handles requests for:
-// - (GET)  getting current memory stats;
-// - (POST) updating the size of the allocation in memory load.
+// - (GET)  getting current memory stats and the state of the memory load, unrounded with v=3;
+// - (POST) updating the size of the allocation in memory load;
+// - (POST) starting or stopping the leak mode of the memory load;
+// - (POST) updating the allocator and touch pattern of the memory load.
 func memHandler(c *Controller) http.Handler {
-	return makeHandler(
-		c.MemStats,
-		c.UpdateMemLoad,
-		"size",
-		func(size int64) error {
-			if size < 0 {
-				return fmt.Errorf("Size value must be positive: %d", size)
-			}
-			return nil
-		},
-		"Memory allocation size updated",
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var v interface{}
+			// The stats are always detailed, so v=2 is the same as no version.
+			if r.FormValue("v") == "3" {
+				v = c.PreciseMemStats()
+			} else {
+				v = c.MemStats()
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeMemForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// memBandwidthHandler handles requests for:
+// - (GET)    getting the memory bandwidth mode and the achieved bandwidth;
+// - (POST)   starting streaming over the memory load allocation;
+// - (DELETE) stopping streaming.
+func memBandwidthHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.MemBandwidthStatus())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeBandwidthForm)
+		case http.MethodDelete:
+			c.SetMemBandwidth(resource.BandwidthConfig{})
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte("Memory bandwidth stopped"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// decodeMemForm decodes the form of a memory load update into a memRequest.
+func (c *Controller) decodeMemForm(r *http.Request) (applyFunc, error) {
+	var (
+		req memRequest
+		err error
+	)
+	if req.Size, err = formInt(r, "size"); err != nil {
+		return nil, err
+	}
+	if r.FormValue("leak") != "" {
+		leak, err := parseLeakConfig(r)
+		if err != nil {
+			return nil, asInvalid(err)
+		}
+		req.Leak = &leak
+	}
+	cfg, configured, err := parseMemConfig(r, c.MemLoadConfig())
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	if configured {
+		req.Config = &cfg
+	}
+	if req.loadTTL, err = parseLoadTTL(r); err != nil {
+		return nil, err
+	}
+	return c.validateMemRequest(req)
+}
+
+// decodeBandwidthForm decodes the form of the memory bandwidth mode into a
+// bandwidthRequest.
+func (c *Controller) decodeBandwidthForm(r *http.Request) (applyFunc, error) {
+	req := bandwidthRequest{BandwidthConfig: defaultBandwidth}
+	workers, err := formInt(r, "workers")
+	if err != nil {
+		return nil, err
+	}
+	if workers != nil {
+		req.Workers = *workers
+	}
+	if v := r.FormValue("gbps"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, invalidValue("Invalid gbps value")
+		}
+		req.Target = f
+	}
+	if v := r.FormValue("op"); v != "" {
+		req.Op = v
+	}
+	if req.loadTTL, err = parseLoadTTL(r); err != nil {
+		return nil, err
+	}
+	return c.validateBandwidthRequest(req)
+}
+
+// parseMemConfig applies the allocator and touch pattern values present in the parsed
+// form of r to cfg, and tells whether there were any.
+func parseMemConfig(r *http.Request, cfg resource.MemConfig) (resource.MemConfig, bool, error) {
+	configured := false
+
+	strs := map[string]*string{
+		"allocator": &cfg.Allocator,
+		"touch":     &cfg.Touch,
+	}
+	for name, dst := range strs {
+		if v := r.FormValue(name); v != "" {
+			*dst = v
+			configured = true
+		}
+	}
+
+	bools := map[string]*bool{
+		"populate":  &cfg.Populate,
+		"lock":      &cfg.Lock,
+		"hugepages": &cfg.HugePages,
+	}
+	for name, dst := range bools {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, false, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = b
+		configured = true
+	}
+
+	if v := r.FormValue("hot"); v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, false, errors.New("Invalid hot value")
+		}
+		cfg.Hot = i
+		configured = true
+	}
+	return cfg, configured, nil
+}
+
+// parseLeakConfig parses the leak mode parameters of the memory load from the parsed
+// form of r. The interval defaults to a second.
+func parseLeakConfig(r *http.Request) (resource.LeakConfig, error) {
+	cfg := resource.LeakConfig{IntervalMs: 1000}
+
+	ints := map[string]*int64{
+		"leak":    &cfg.Rate,
+		"ceiling": &cfg.Ceiling,
+	}
+	for name, dst := range ints {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = i
+	}
+
+	if v := r.FormValue("interval"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, errors.New("Invalid interval value")
+		}
+		cfg.IntervalMs = int64(d / time.Millisecond)
+	}
+
+	if v := r.FormValue("sawtooth"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, errors.New("Invalid sawtooth value")
+		}
+		cfg.Sawtooth = b
+	}
+	return cfg, nil
+}
+
+// historyHandler handles requests for:
+// - (GET) getting the recorded samples of a resource, downsampled into min/avg/max series.
+func historyHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		v, err := c.getHistory(r)
+		if err != nil {
+			writeFormError(w, err)
+			return
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.Write(b)
+	})
+}
+
+// parseHistoryQuery reads the resource, the start and the step of a history query from r.
+func parseHistoryQuery(r *http.Request) (string, time.Time, time.Duration, error) {
+	res := r.FormValue("resource")
+
+	// since is either a timestamp or a duration relative to now. Everything recorded is
+	// returned if it is omitted.
+	var since time.Time
+	if v := r.FormValue("since"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			since = time.Now().Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, v); err == nil {
+			since = t
+		} else {
+			return "", since, 0, errors.New("Invalid since value")
+		}
+	}
+
+	step := defaultHistoryStep
+	if v := r.FormValue("step"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return "", since, 0, errors.New("Invalid step value")
+		}
+		step = d
+	}
+	return res, since, step, nil
+}
+
+// streamHandler handles requests for:
+// - (GET) streaming monitor samples and load changes as Server-Sent Events.
+func streamHandler(c *Controller, shutdown <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		f, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, fmt.Sprintf(tplServerError, "streaming is not supported"), http.StatusInternalServerError)
+			return
+		}
+
+		events, cancel := c.Subscribe()
+		defer cancel()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		f.Flush()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-shutdown:
+				return
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+				f.Flush()
+			case e := <-events:
+				b, err := json.Marshal(e)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, b)
+				f.Flush()
+			}
+		}
+	})
+}
+
+// diskHandler handles requests for:
+// - (GET)  getting current disk I/O stats and the state of the disk load;
+// - (POST) updating the disk load configuration.
+func diskHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.DiskStats())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeDiskForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// decodeDiskForm decodes the form of a disk load update into a diskRequest.
+func (c *Controller) decodeDiskForm(r *http.Request) (applyFunc, error) {
+	cfg, err := parseDiskConfig(r, c.DiskLoadConfig())
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	ttl, err := parseLoadTTL(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.validateDiskRequest(diskRequest{cfg, ttl})
+}
+
+// parseDiskConfig applies the values present in the parsed form of r to cfg.
+func parseDiskConfig(r *http.Request, cfg resource.DiskConfig) (resource.DiskConfig, error) {
+	ints := map[string]*int64{
+		"mbps":     &cfg.Throughput,
+		"readpct":  &cfg.ReadPct,
+		"iops":     &cfg.IOPS,
+		"block":    &cfg.BlockSize,
+		"filesize": &cfg.FileSize,
+	}
+	for name, dst := range ints {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = i
+	}
+
+	bools := map[string]*bool{
+		"fsync":  &cfg.Fsync,
+		"direct": &cfg.Direct,
+	}
+	for name, dst := range bools {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = b
+	}
+
+	switch r.FormValue("pattern") {
+	case "":
+	case "seq":
+		cfg.Random = false
+	case "random":
+		cfg.Random = true
+	default:
+		return cfg, errors.New("Invalid pattern value")
+	}
+
+	if v := r.FormValue("dir"); v != "" {
+		cfg.Dir = v
+	}
+	return cfg, nil
+}
+
+// netHandler handles requests for:
+// - (GET)  getting current network interface stats and the state of the network load;
+// - (POST) updating the network load configuration.
+func netHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.NetStats())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeNetForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// decodeNetForm decodes the form of a network load update into a netRequest.
+func (c *Controller) decodeNetForm(r *http.Request) (applyFunc, error) {
+	cfg, err := parseNetConfig(r, c.NetLoadConfig())
+	if err != nil {
+		return nil, asInvalid(err)
+	}
+	ttl, err := parseLoadTTL(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.validateNetRequest(netRequest{cfg, ttl})
+}
+
+// parseNetConfig applies the values present in the parsed form of r to cfg. An empty
+// target or sink clears it.
+func parseNetConfig(r *http.Request, cfg resource.NetConfig) (resource.NetConfig, error) {
+	ints := map[string]*int64{
+		"mbit":   &cfg.Throughput,
+		"pps":    &cfg.PPS,
+		"packet": &cfg.PacketSize,
+	}
+	for name, dst := range ints {
+		v := r.FormValue(name)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("Invalid %s value", name)
+		}
+		*dst = i
+	}
+
+	switch v := r.FormValue("protocol"); v {
+	case "":
+	case "tcp", "udp":
+		cfg.Protocol = v
+	default:
+		return cfg, errors.New("Invalid protocol value")
+	}
+
+	if vs, ok := r.Form["target"]; ok {
+		cfg.Target = vs[0]
+	}
+	if vs, ok := r.Form["sink"]; ok {
+		cfg.Sink = vs[0]
+	}
+	return cfg, nil
+}
+
+// procHandler handles requests for:
+// - (GET)  getting the open file descriptors, threads, goroutines and limits of the process;
+// - (POST) updating the number of file descriptors, threads or goroutines held.
+func procHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.ProcStats())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeProcForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// decodeProcForm decodes the form of a process load update into a procRequest.
+func (c *Controller) decodeProcForm(r *http.Request) (applyFunc, error) {
+	var (
+		req procRequest
+		err error
 	)
+	for _, p := range []struct {
+		name  string
+		count **int64
+	}{
+		{"fds", &req.FDs},
+		{"threads", &req.Threads},
+		{"goroutines", &req.Goroutines},
+	} {
+		if *p.count, err = formInt(r, p.name); err != nil {
+			return nil, err
+		}
+	}
+	if req.loadTTL, err = parseLoadTTL(r); err != nil {
+		return nil, err
+	}
+	return c.validateProcRequest(req)
 }
 
-func makeHandler(getFunc func() interface{}, setFunc func(int64), formValue string, validator func(int64) error, successMsg string) http.Handler {
+// scenarioHandler handles requests for:
+// - (GET)    getting the currently running scenario and its current phase;
+// - (POST)   uploading and starting a YAML or JSON scenario;
+// - (DELETE) aborting the running scenario.
+func scenarioHandler(c *Controller) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			b, err := json.Marshal(getFunc())
+			b, err := json.Marshal(c.ScenarioStatus())
 			if err != nil {
 				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
 			}
+			w.Header().Set("Content-Type", contentTypeJSON)
 			w.Write(b)
 		case http.MethodPost:
-			if err := r.ParseForm(); err != nil {
+			b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxScenarioSize))
+			if err != nil {
 				http.Error(w, fmt.Sprintf(tplParseError, err), http.StatusBadRequest)
 				return
 			}
+			apply, err := c.decodeScenarioRequest(b)
+			applyForm(w, apply, err)
+		case http.MethodDelete:
+			c.AbortScenario()
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte("Scenario aborted"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
 
-			v := r.FormValue(formValue)
-			intValue, err := strconv.ParseInt(v, 10, 64)
+// monitorHandler handles requests for:
+// - (GET)  getting the sampling interval of every resource monitor;
+// - (POST) updating the sampling interval of one or more resource monitors.
+func monitorHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.MonitorStatus())
 			if err != nil {
-				http.Error(w, fmt.Sprintf("Invalid %s value", formValue), http.StatusBadRequest)
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
 				return
 			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			postForm(w, r, c.decodeMonitorForm)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
 
-			if err := validator(intValue); err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
+// decodeMonitorForm decodes the form of the sampling intervals of monitors.
+func (c *Controller) decodeMonitorForm(r *http.Request) (applyFunc, error) {
+	intervals := make(map[string]scenario.Duration)
+	for _, name := range []string{"cpu", "mem", "disk", "net", "proc"} {
+		d, err := formDuration(r, name)
+		if err != nil {
+			return nil, err
+		}
+		if d != nil {
+			intervals[name] = *d
+		}
+	}
+	return c.validateMonitorRequest(intervals)
+}
+
+// guardHandler handles requests for:
+// - (GET) getting the guardrails and the backoffs they caused.
+func guardHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.GuardStatus())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
 			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
 
-			setFunc(intValue)
+// stopAllHandler handles requests for:
+// - (POST) stopping every load and pausing load updates.
+func stopAllHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			apply, err := c.decodeStopAllRequest(nil)
+			applyForm(w, apply, err)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// pauseHandler handles requests for:
+// - (GET)    getting whether load updates are paused;
+// - (POST)   pausing load updates, leaving the loads at their current levels;
+// - (DELETE) resuming load updates.
+func pauseHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			b, err := json.Marshal(c.PauseStatus())
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
+		case http.MethodPost:
+			apply, err := c.decodePauseRequest(nil)
+			applyForm(w, apply, err)
+		case http.MethodDelete:
+			c.Resume()
 			w.WriteHeader(http.StatusAccepted)
-			w.Write([]byte(successMsg))
+			w.Write([]byte("Load updates resumed"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// sampleHandler handles requests for:
+// - (GET) getting a raw sample of a resource from the monitor backend.
+func sampleHandler(c *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			sample, err := c.getSample(r)
+			if err != nil {
+				writeFormError(w, err)
+				return
+			}
+			b, err := json.Marshal(sample)
+			if err != nil {
+				http.Error(w, fmt.Sprintf(tplServerError, err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", contentTypeJSON)
+			w.Write(b)
 		default:
 			w.WriteHeader(http.StatusMethodNotAllowed)
 		}
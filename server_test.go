@@ -0,0 +1,78 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/milonoir/schwer/resource"
+)
+
+func TestFormStatusCodes(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{CPUCeiling: 60}, time.Minute)
+	defer c.Stop()
+	srv := httptest.NewServer(newServer(0, c, nil).Handler)
+	defer srv.Close()
+
+	post := func(path string, form url.Values) int {
+		t.Helper()
+		resp, err := http.PostForm(srv.URL+path, form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// The legacy endpoints fail with the same status codes as the versioned API.
+	for _, tc := range []struct {
+		name   string
+		path   string
+		form   url.Values
+		status int
+	}{
+		{"cpu", "/cpu", url.Values{"pct": {"10"}}, http.StatusAccepted},
+		{"invalid pct", "/cpu", url.Values{"pct": {"ten"}}, http.StatusBadRequest},
+		{"pct out of bounds", "/cpu", url.Values{"pct": {"500"}}, http.StatusBadRequest},
+		{"pct and pcts", "/cpu", url.Values{"pct": {"10"}, "pcts": {"[10,10,10,10]"}}, http.StatusBadRequest},
+		{"ttl above the maximum", "/cpu", url.Values{"pct": {"10"}, "ttl": {"1h"}}, http.StatusBadRequest},
+		// The fake host is 50% busy, including the 10% load set above.
+		{"cpu guardrail", "/cpu", url.Values{"pct": {"30"}}, http.StatusConflict},
+		{"profile guardrail", "/cpu/profile", url.Values{"shape": {"step"}, "levels": {"5,30"}, "hold": {"1s"}}, http.StatusConflict},
+		{"size and leak", "/mem", url.Values{"size": {"1"}, "leak": {"1"}}, http.StatusBadRequest},
+		{"invalid bandwidth op", "/mem/bandwidth", url.Values{"op": {"move"}}, http.StatusBadRequest},
+		{"missing proc value", "/proc", url.Values{}, http.StatusBadRequest},
+		{"invalid monitor interval", "/monitor", url.Values{"cpu": {"soon"}}, http.StatusBadRequest},
+	} {
+		if got := post(tc.path, tc.form); got != tc.status {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.status)
+		}
+	}
+
+	if got := post("/pause", nil); got != http.StatusAccepted {
+		t.Fatalf("pause: got %d, want %d", got, http.StatusAccepted)
+	}
+	defer c.Resume()
+	if got := post("/cpu", url.Values{"pct": {"5"}}); got != http.StatusConflict {
+		t.Errorf("paused: got %d, want %d", got, http.StatusConflict)
+	}
+}
+
+func TestFormErrorsArePlainText(t *testing.T) {
+	c := newTestController(t, resource.GuardConfig{}, 0)
+	defer c.Stop()
+	srv := httptest.NewServer(newServer(0, c, nil).Handler)
+	defer srv.Close()
+
+	resp, err := http.PostForm(srv.URL+"/cpu", url.Values{"pct": {"ten"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("content type: got %q, want text/plain", ct)
+	}
+}
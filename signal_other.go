@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// stopAllSignals are the signals which stop every load.
+var stopAllSignals = []os.Signal{syscall.SIGUSR1}
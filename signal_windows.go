@@ -0,0 +1,8 @@
+package main
+
+import (
+	"os"
+)
+
+// stopAllSignals are the signals which stop every load. Windows has no user signals.
+var stopAllSignals []os.Signal
@@ -8,6 +8,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xeaR\xdbN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\x19\x99\x14]\xacTKo\xdb8\x10\xbe\xe7W\xcc\x12\xd8u\xf6`\xd1N\xb2@6%]\xb4i{\x0b\x12$6\x8a\x1eijl1\xe1\xab\xe2\xc8\x89\x8a\xfe\xf8\x82\x92j\x1b1\xfa\x08\xd0\x93\xc8\x99\xef\xa1\xe1'Q\xfc\xf5\xee\xfar\xfe\xe9\xe6=T\xe4\xec\xecH|\x7f\xa0*gG\x00\xc2!)\xd0\x95\xaa\x13\x92d\x8b\xf9\x87\xf19\x03\xde\xb5\xac\xf1\x0fP\xa3\x95,Qk1U\x88\xc4\x80\xda\x88\x92\x11>\x11\xd7)1\xa8j\\IF\x15:,raG\xed:\xa3\x8a(\xa6\x0b\xceW\xc1S*\xd6!\xac-\xaahR\xa1\x83\xcb\n\xafW\xca\x19\xdb\xca\xdb\xb0\x0c\x14F\x9d\xe1hg8\xea\xf4\x92\xaeM$H\xb5\x96\xec\xfes\x83u;>-\xce\x8ai\xe1\x8c/\xee\x13\x9b	\xdeC\x0e\xd0\xfd\xfa\x19F\xf0~~\xb1\x0ce\xdbQ\xf2\x1e\xeb\xbc\xcc\x9b\xe9\xec#Z\x1d\x1c\x02\x05\xb8\xd3\xd5#\xd6\x82W\xd3\x0e\xc9wPQ\x9a\x0d\x98R2\x1d<\xa1'6\xf0sY[\x95\x92d	5\x99\xe0\x87N\xd6>\xed\x19\xb1\x19\xbb\xe0\x0d\x85z\xdc\x0b\xb2\xd9\xe5\xcd\x02\x86\x9a\xe0\xd5\xe9\x96\xa2\x95\xdf\xa8\xf4\x9c\xc6\xe0\xd1\x94TIv>\x990\xa8\xd0\xac+\x92l\xfa\xdfd\xeb\x05 \xe2\x81\xd9JY\xbbT\xfa!\x1fG\xdcZ\xf0\xdec\xbb_\x85\xdam\xa9Q\x13\x03\x87T\x85R\xb2\x9b\xeb\xbb9\x03\xd5M%\x19\xd7\xb1\xd9\xf7\xb3j\x89\xb6\x1b\xc4\x06U\xc2\xf1\xdf\xff^\x08\xde\x17\x85\xf1\xb1!\xf0\xca\xa1d\x9dd\xff%\xf9\xc6-\xb1f\xe0\x8c\x97l\xc2\xc0\xa9'\xc9\xa6y&\xbe'\xdc\x93{Fj\x96\xce\x10\x83\x8d\xb2\x0dJ\xb6\x88\xa5\"\xdc\x83\x0b\x9e_\x7f\xc8\x82\x97f\xf3\xfb\xb18t\x07\xb1\\\xa1\x0bu\xfb\xabd\xf6\x98/Hf\xdf\xef\x85\xc9dj2_\xf0\x87\xd18t\x87\xd1\x0c\xc3(k\x83V\x19\x08Y\x03\x8e\xaf\xde\x1e$\xb5o2\xeeJlH\xaf\xb7\xfdi|'g\x7f8\xbf\xddb\x15\x02m\x7f\xd58\xfbG\x87\xd8\xbe\x82\x93\xc9\xf4\x7f\xb8j,\x99hU\x0b_\xe1\x0d,\xbc\xa1\x16\xe6\xa8+\x1flX\x1bLp\x19\\T\xbek[\x0b\xb79\x99\x04\xb7\x98\xb0\xde`Y\x0c\xe7\x9e\xfd{\x0b\xc1\xfb\x0bB\xf0\xfe\xda\xfc\x16\x00\x00\xff\xffPK\x07\x08I\x8a\x9a\xc6@\x02\x00\x00N\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00.C\xd9N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00jquery-3.4.1.min.jsUT\x05\x00\x01x\xda\x11]\xb4\xbdi\x97\xdb6\xd20\xfa\xfd\xfe\x8a\x16\xc7\x0f\x03X\x90Z\xb2\x93\xbco\xa8\x86u\x9c\xb6\x9d8\x93\xdd\xce$3\x14\x9d\xc3\x16\xa1\x16\xd3\x14\xa8\x90`/\x119\xbf\xfd\x1e\x14\x16\x82\x14\xd5\xc9\xcc}nr\xdc\"A\xec(\xd4\x86\xaa\xc2\xf9\xd3\xd1\xd9o?T\xacx8\xbb}>\xfdx:?\xab\xcf\xd0\x1a\x9f}\xf5\xee\xecM^\xf1$\x16i\xce\xcfb\x9e\x9c\xe5b\xcb\x8a\xb3u\xceE\x91^U\"/\xca\xb3\xfa\xec\xb7\xdfe\xd1i^\\\x9fg\xe9\x9a\xf1\x92\x9d==\xff\x7fF\x9b\x8a\xafeA\xc4\x88\xc0\x07\xaf*\xd9Y)\x8at-\xbc\x85\x97_\xfd\xc6\xd6\xc2\xa3T<\xecY\xbe9\xdb\xe5I\x951\xdf?\xf1a\xca\xee\xf7y!\xcae\xf7\x95\xb2i\x92\xaf\xab\x1d\xe3b)\x10#\xa3\x19\x0e\xdaV\xf1!\xdd\xa0Q\x9b\x05\x8bm\x91\xdf\x9dqvw\xf6\xba(\xf2\x02yz\xcc\x05\xfb\xbdJ\x0bV\x9e\xc5gw)O\xf2\xbb\xb3\xbbTl\xcf\xe23S\xd2\xc3\x8b\x82\x89\xaa\xe0g\x021\xdc\x04\xf0\x17y\x15O\xd8&\xe5,\xf1F\xa6\xbb\xaa\xfcR\xfd\x04b\x9b\x96\xc4v\xe8\x92\xb0\xde4\xdc\xc6\xc5\x99\xa0aD^\xd3K\xdbMR\xd0\xef`\x12\xa6\xd7L|_\xe4\"\x97u\x7f\xb7!%\x15\xd3RN0\xb9\xa6b\xba\xce\xf9:\x16\xa4\xa2b\xba\xaf\xca-I\xa9\x98\xa6<a\xf7\xdfm\x08\xa7\x87\x86\xe4\x94OE\xfeN\x14)\xbf&\xb7\x94O\xb7q\xf9\xdd\x1d\xff\xbe\xc8\xf7\xac\x10\x0f$\xa6\xb7\xed\xf7\x8c\xc6\xd3u\x9ceH5\x8d\xc9\x83\xacbG\xdd\xd9TS\xe0\x99\xa4v\x8d\xe4\xba\xf1jw\xc5\x8av\"\xd8\x94\xe7	{\xff\xb0g\x0d\xb9\x1f\xa8\xe6\x8cWY6\xa2\xcc\xf7\x19\xa5\x94M\xd5\x8c5dM\x0f\xb2\x86`4#e\xb1\x96?<\xe7k\xa6\x1e\xbe\x81\xd5\x0fF\xb3faj<\xbb\x92\xe0E8>\xc8\xc9,HJr\x8a8\xe5u\xfd\x1aO\xd7\x05\x8b\x05{\x9d19\xaf\xc8+\xd7E\xba\x97\x8b\x99nP>\x15\xec^P	\x9a\x9b\xbc@\xc5Y\xca\xcf\xd6\x18\xa5T\x84ET\xd70\xf9/\x85\x82r\xe6\xfb\xddwT`\xec\xfb\xf9\xb4\xec\xa4\x91\x14/\xf8t\xcb\xe2d\x1a\xef\xf7\x8c'\x97\xdb4KP\x8e\xa7\xfb\xb8`\\|\x9b'lZ\xb0]~\xcb\xcc\x97\xc6\x0e\xe3\xae75\x94\xb2%\x1b{^p\xb4#X]\x0f\xad\xc1\x92\x87\xb9ZB\x86\xa3\xba6\xc5\x02\xf3\xbd\x91\xf3\xb3\xa1\x1e\xeco\x8f\xdc\xd0\xee\xf64M\xb3\xbb\xb3\x9b\xe9\x86OS\x9e\n\xf8\xd2\x90==\xff\x10\xae\xcaU\xf5\xe6\xf5\x9b7\xab\xfb\x97\xb3h\\\xf7\xde\x9f\x9c_\xb7+\x92\xc8\xa1(\xd0\x1e\x8d$ld\x8c_\x8b\xad\x97r	)l\xaa^	\xa7r\xcczc\x8dv\x88a\xdf\x1f\xdd\xc3\x0f\xf2\xe2\xa2\x88\x1f<J\xe5B\xce(\xa5\xa2\xae\x0d\x88\xd9!\x0b\xdf\x9f]\x08\xdf\x17\x93\xb9\\=\x86\x1b\xd9sz3\xdd\x9bMC\x0f\n5\x05\x1b\xb2\xcey)\x8aj-\xf2\"\xb8!\xaa\x0b\xc1\x8c\x88\xfc\xa5l\xa9\xc5\x1av&J5\x99r\x0b\xe3\x86\\3\x11\x9c\x00b\xb9Rn\xe6\x80]\xcc\x96\xf2)dc\xf9\xa3\xc7\x1b\x05*-j\x88\xdc\xaf\xefD\xbc\xbe\xe9T\xa9f\xecf\xbac\xc55\x83\xaa\xa6N\xa7\x11&\xac\xc5B\xd3}\xc1n\xd5V\xa5\x80dDCX\xbc\xde\x0e\xf5\xf1f*\xbf@\x85\x84\xe1\x86\xec\xe2}\x9b\x8d\xdbl\xd0\xa0\xed\x19\xba\x99\xee\xe2=\xea\"\xb0\x0e\xa0hX#BV\x8aqC\x005\x0dLd\xaf\xe2Rn\x8e\xecAU\x1d\x17\xd7\x80\xf3JY\xc1&-Jq\xaa\x02\xf6;\x9a\xe1\x86d\xf1\xa3Y&s\xdc\x10\xf6\xfb\xc0\xbc:+A8\x1d\xb31\x82e\nf\xed\xa4v\xfb9\xbb\xa0\xdc\xf7\xf9\x85X\x86\xb0p<\x8a\x820\x92\xd5\xf3\xe4\xe4(\xed\xaa\xd4\xf5\xf1\x02\xaa\x85\x0f*R\xe6\x85\x08\xc4T\xfe\x90r\x0f\xd3&\xa6\xea\xa1!7Sv/\x18O(lC\xfd\xec\xb4'\x87\x03\x18\x8f\x00\xb2#1\xb5\x93\x18\xce\xa2\xba>4\xa4\xa4sR\xb5\xc9f\xd8\x19\x1d\xcd\x17\x12\xd9yWy\x9e\xb1\xd8\xc1\x1e\xb1\xef\xa3\x8c\xc6\x9d\xcaJ]\xd9x\x8c\xc9\x11\x12\x8a\xebz\x87b\\\xd7(\xa6\x87\x06\x93\x92RZ\xf9>\x8a\x158\x96\x93	^\x94\x17\xd5B\x96N7H!{\xc4:\xd5c\xc0\xbcB\xed\xdd\x82\xb2PD\xc4\xfb\xf5W\xd8\xbd\xbf\xfe\xea\x8d(\x15\xbe\x1f\x8f(-d\xef|_\xfe\xdcL\xd3\xf2\xfb,N\xb9\x9afT\xc8.\xa4\x146\xf14-\xe1Wbg\xbcD\x9c\xc6\xb2\xc6\x9c\xa6\xbe?\xeaf\xe0x\x19FAZ\xd7\xfd\xea8^\xf2\xe0\xd0\x90\x94\x8e\xe6D\x16\xa7f9PFrR`\x1c\xdc\xe6ir6\xd3\xbd\x82,\x05\xb60\x14\xb7\xeb\x87\x0e\xec~\x1f\xf3$\x0f4\x9b\xe1\x8d\xd1f\xfcM,\xb6\xd3B&\xef\x10\xc6\xd3\x82\xed\xb3x\xcd\xd0\xf9\xea\xd5\xf95\xf1<L\xd2\xf2G\x16'\x0f\x92\xde1\xc9\xa4t@\xb9\xcf\xc0\xc8\xed\xcc\xf3|\xef\xc2cC:C:\xde\n\x84\x1b\x9c\x8bF\x92\x96\x84jm\xcfT\xfeH\xce\xbb%$\x12\x17\x8f\x90\xa0\xb2)<Dw\x10\xa7\xb7\x1a\xf7\x11\xcf\x81v\x0fK\x9a\xe9$`\xdf\xd7\x1c\x06\xc7\x94\xd2\x0c\xcb~\xbe\xde\xed\xc5\xc3\xa9~.\\\xe8P\x1d\x9e\x9b\x9e\xcf\x1ar\x9d\xe5Wq\xf6\xfa6\xce\x82.\x82\x92\x0c\xc1A1\x0d\x928L\xe1\xb1\xc1G\x08R\xe6\x95\x0dqR\xd0\x99d	$\xdd\xc2\x07\xd9*\xa7\x86J-\x8a\x0b\xbe(\x14\x10\x8f\xe6\x92\x10\xe9\xa9	\x8b\x88\x14D\xfe`|U\xb0\xf8\xa6aY\xc9\xce,/\xc1\xfe\xbc\x84\x01\x1a\xd6\x10Q\xa4\xbb\xc7\xe8\x8b\xe7\x05Hr\x03-\xc4\xec%\xb4Hd~\xc3z\x14\xac\x1d\x98\xa4\x9aa\xb4\xe83\\(\xd1\\\x9e\x1c\xef\xd2\x10\x1cN\xbc\x12xA\x97\xa7\x08Y\x140\x1cTz\xe5\x08\xc3\x98\xf0\x86\xa4\xfc\xb8M\xc2{\xbd\x16\xcb\xc9<H\x0dp0\xc2eweS\xbd\xae\xca)S\xdd\x1d\x0b\x83\xaa\n:#\xe9\xf1\"\xb00\x1d\x8f#`\xd1\xec\xe4\xe9<4%\xac!\xd7\x05\xdb\x1f\xf5\xca4PHN;\xa53\x92\xdb\x9aILG|\x91^\xe4\x8bt<\xc6#\x81X\x98F$\xc5#Jc\xdf/\x80\x1c@\x9a\xdd\xe1E\x8f\x80\x1e1\x9f3\x12\xd30\xb2\xf0\x04\x00\xd1\x8e$\xbf(\x16\xf9x\x8c5BL\xa9l2\x8fHN8\x86-\x02-\xa6xa\xa1)W\xd0\xf4\xa7\x05t\xff\xae5y\x0d#\x12K\xbe\xa5J\x93`N\xcaj/\x05\xa6\xe0\xa1\xc1d\x80y|\xf7\xb0\xbb\xca3@\xaf\x1b\x1e\xaa\xb7i*X\x11\x8b\xbc\x90\xf3\xddO\xc2Ds\x15\xde\xe7\x8a\x94\x9c}\x0b\xcc\xd9\x99\x12'\xce\xde\x18^\x10\xe0\xe4\xecU,\xd8\xd9\x8f\xec\xfa\xf5\xfd^\xa3\x19\x85\xc0t\xc3\x1e\x10?\x81\xbc3\x0f\xf7\xb8\x0d\x1eZ\xfc\xe4\x8d\xc5\xd8\x8b\xbc\x88\x8a\xa9\xc8\xbf\xce\xefXq\x19\x97\x0c\xe1\x06\x83\x1c\xb5\xa5.S\xa3\xa8dB\xaeHNR\xb2%\x1brM\xeeHE2\xf2\x9e\\\x92\x98\xbc&\xb7\xa4$k\xf2@n\xa8W\xa6\x7f\xfc\x911o<\x7f*Q\xab\xec,\xd9Q\xde\nd\xef\xe8\x0c rO+\x860\xb9W?\xdf\xaa\x9f\x97\xea\xe7\xd50?-\x85\x1b\x01\xc4u4\xc3d\xd6\x90\xdf\xe8\xa1\xe9Kb \x02\xfe.\x05\xb9|O\xbe6\x02\xdd\x97\xe6\xe1;+\xf7}OOm\x1d\xd9A\x0bd\xfc\xa2Xp\x85\xb3X\xc8#\xd9\x05l\xb6\xa6\x86\x93\xc9\xbc!?Ro\xbde\xeb\x1b\x96\xd4%\xcb\xd8Z\xb0\xa4\x8e\xcb\x07\xbe\xae\xe3J\xe4\x9b|]\x95\xf0\xb4\xcf\xe2\x87\x1aD\xfe<+\xeb\x84mXQ'i\x19_e,\xa9\xb7i\x920^\xa7\xe5.\xde\xd7Y\x9e\xef\xeb]\x95\x89t\x9f\xb1:\xdf3^\x17,Nr\x9e=\xd4Z\xc8N\xear\x9d\xefY\xe2\x91o\xa8\x17\xaeV\xf7\xcff\xab\x95X\xad\x8a\xd5\x8a\xafV\x9b\xc8#o\xa9\x87\x96\xc1j\xb5ZM\xebp\xb5\xba\x9bDu\xf8a5\x9b\xacV\xf7\xf1,\xc2c\x8f\xfcL\xbd\xd5*\xf4\xc6\xdf\x8c\xbd\xa7\xc8\x1b\xbf\x1d{\x18-\x03\xfd\x1e>\xfd\xf0\xa4\x1e\xfd;ZR\xacS\x96\xc1G\xa8\xad\xf1\x83\xfc\xfd(\xc2O\xf1G\xf5\xca\xeb\x7fXy\xf2\xcb\xca\xabu\xbd\xb8\xd6\xb5\xacV\x91G\x9eP/h\x1b\\\xad\x10B\xffy\xd5\xb8\xee\x7fA8\\\xad\xa2\xa8\xf6\xc6?\x8f=\xfc\x14\xd7\xd3\xa7x\xb5\x92M\x937T\x82\xa4\xda:\xe8\x9b\xb17\xf6\x88w\xeda\xf2\xb9\x9b\xee}\x80>\x8e\xa1\xe2\x0f\xba\xd2\x08\x9bV\xf0S5\x86\xf1\x13]\xf8\xd7\x81\xc2O\x89\xfa\xf10\xf9c\xe83\n_\x8c\xff-\xbb\xf8\xcd\xd8\xc36\xebO\xbd\xee\xd5/<L~q\x13\x9f`\xf2\x8f~}o\xc7\xde\x13\x0f\x93/\xe8\xe1\xed\xab\xa0\xf3\xedozv=L.\xbf~\xf9\xee]\xf7\xebj5m\xbf\xbf\x7f\xf9E\xf7\xab\xfaT\x87O#\xf9\xf9\xe5\xfb\xf7?\x06\xbdv\x7f\xc6\xe4\xfbw\xaf\x7fz\xf5]\xff\xc3\x13L.\xbf|\xfbu\xaf3\x01\x02\xc0\x05\xb1\xa4\x96\x82G\xcd\xc5V\xfe\x9b\xc8\x17<Ak)\xc5\xd7\xf9f\"\xd1\xa8\x86\x08=[\xec\x96\xf1:O\x92\x1a\xa1p<\x89j\x8cV\xab\xe4)\xe6u\x0b\x94\xfa\x83~_\xad\x921\xae\xb1\x856X}/\xf50\x91\xcczo\xa4\x12\xd8\x7f\x1c{\xf8\x89\xce\xc2\x19K\xca\xcb\x9c\x0bv/\xfac\x93\xd5\xa9\xb5\x0b\xda^\xb1\xdf\xebkQgjD\xed\x00\xbbc@\xcb`\xb2Z%x	]w:\x86\x964\xfc0\x89\xea'\xba\x8b\x0d\xf9'=\xff\xf2\xfd7_?9O\xc9\x0f\xf4\\v0\xe5\xfbJh\xbcR\xcb~\xc5\x05\x8b\xeb\xabJ\x88\x9cc\x99\xef+z\xfea\xbbJ\xe4\xe3\xdf\xe9\xf9\x87\xf0\xc3!\x1a\xaf\x0e\xab\xf2\xe9*\xe4\xb1Ho\xd9\xd9\xea\xee\x9c\xfcK\xd5\xf67\x14JD0\xc65Z\xdd\x8dq\xbd\x9a\x9a\x04\xfc\xe4\x9c0F\xcf\xc3\xf1\xbf\xa3s\"X\x07\xd6`s\x85\xabU\x12O6\xd1aN>m`\x14\xcbZ\x0d\x11\xd7S\x18\xc15L!\x1d\xa4\xe9\xd4\x9b\xdd{c1\xf9\xf4\x93O\x9e\x7fj\x99\x80\x11-\xea\x9a/EP\\\xcc\x96\x8a\xe6M7E\xbe\xbb\xdc\xc6\xc5e\x9e0T\x8c\xa1\x00\x0e\x06?\xbex1\x9f\xd5\x9f|\xf2\xec\xb3O\xc9|\xf6\xec\xb9_\xd4\x9f|\xfa\xfc\x99\x94o\x0bF\xcfQ(\xb1\xdd\xfd|\xb3\xba\xff?\x9b\xa8\xfe0Y\xae\x12\\\x7f\x98<\xd1xP\x7f\x99\xac\xaa7o\xde\xbc\x91\xd3p~MR6L\x80\xc4\xd2[\xcd<\nL\xe4\xaa\xdal6\x89\x170EN\xd0\x8cL\xe6x\xec\xadV\xde\x98M\xd7\xbaw/\x052\x84d2\xc7VC\x88\xe6\x9f\xe2\xb1w\xe6\x05*{Cr\xe6J\xa5\xef\xa5x\x1b3z\xc5\xd01\x1b;\x9a\x81\x8a\xcf\xd0\x0c\xdf\xf76)\xcb\x92\x92	\xe8\x18h\n\xbf\x8dw\xacG\xd7\xc9!I\x8b\xc0k\x95g\x1e\xe1\x12\xc0\xbd\x8c]3\x9ex\x0d^\x88\xe2\xe1\xf0\xa5Q(\xd0\xef\x14\xb3\xb9\x9b\xc2\xc6\x94%JL\xbao\"t\xdf\x8dj\xa6\xd5U\xaec\xb1\xde\xca\x9e\x7fI\x0fPm`x\xd2ewz\xbf\xd6\xad2\xa2[\x15\x187\x83L8s\x98\xda\xc5\xdd6\xcd\x98\xa4\xca\x9a\x8f\x1d\x8f#\xbc\xb0<\xac$\xcbM\xab\x13,\x99b\x9cI\xa1\xea\x02q\x9f\x94\xc0\xc6\xac\xc9\x06\x94\xa7\xd3\xfc\x8e\xb3\xe2\x95aU\xf6\x94-[\xd5k\xf0\x99\xe4CA\x1b\x1aF\x96\xbf\xb7:ZQ\xd7#Q\xd7\xf3\x11\xa5{\xdf\xffL\xfd\xcc\xe1\xb5\xe5\x17\xa4\x18#E]$+\xee4V\xd7,\xd8IV\xf9\xd2\xf7\xdf#\x86	\xa3\xac\xae/\xc9k\x0c\x9a\xf7\xb9\xae\x17U\xf4_Sv\xcf\xd6r\x8a$O\x92\xd2*\x9cG\x90\xe73*\xdb\x02==\x8a)\x9b^3\xa1\xb5\xb5\x9f?\xbcMP\x8aq\xa7#\xf14M(\xa5\xa9MT\xdco,\x85\x12`\x98\xd3\x0d\xda\x80\x16b3P\x95\xef\xcb\xe5\x8a\x81k~\xbc\x1e\xd9\xa1*|\x16\x99\xef\x06\xc48q\xbbX~\xfe\xf0>\xbe\x96\x80+GF\xa0\x870\xb8\xe7\x11\xf6\xfd\xa4\x9b\xf32\x8b\xcbR\xe6\x95k6\xfc\xe5O[\xb39\xe5h\x08o\xa4\x8c1\xfd\xbd\x8c}\x7f\xf42\x14rwFR^\xbf\xad\xeb\xd1\xedT\xb0R\xc8~\xf9>\x82\x85hU\xc2\xa3\xd3{N\xad\xdc\x9a\n	]DJ\xaf{\xdf\xff\xc9\xd6u@\xa5Z\xa4V\xeb\xed\xa5\x89\x87\xf1\xb2\xa4\xa5\x15M\x0bFR\x86%\x96\xe9g$%\xbd\xc1$\xa7(\xa3[Y\x9faX\xd5\xae\xc8'\x13\x9c\x85yD\xbd\xbfy\xe3R\x0eg|\xcf\x90L\xc1\x8b5\xcd\xa6\xbf\xe5)G\x1e\x912\x02e\xcct\xca\xf7\x1f\x18b\x8e\x8a\x1d\xd75k$b8\x9a\xce\xcd\x14\xb4\xc1\xef\x80(\xe5\xc5\xcb,Ck\x98H\xbb\xeb_\"AF3\xdclR\x1eg\xd9\xc3\xa1\xa4\x94\xde\xc8\x15Sj\xfb\xde\xb0\x9b\xa61R\x17\x12v\xf8\x9f\x13\xef\xc9\xdc\xc3z\xe3\xb6\xbbY\x8a	\x07#\x87\x1aJb\xbf\xca\xad\xdeJ\xd0Z\xf2\x845\xc5/\xae\xa6\xebx\xbde_\xc3d\xf9~\xc22&\xd8\x19\x0b\x8bi\xb9M7\x02\xe1\x880\xbd\xfe\x94;\xf8Cb\x9aV\x12	o\":\x9a\x11\xd6~_\xb3V?z\xd9?,\xb1(ZaY\x8d\xc9G\x12\xd6\xdb\xf92z\x19;a\xc2Y\x08\xdf\x17\xa7N>\x04&\x82J\x91\xd6\xe9\xed\x86u\xb1\xa6\x96	k\x0f\x93\x82\xf2.\xa8\x14\x93	\xbe\x9a\xc6B\x14_\xc6<\xc9X\xc8\xc3\"\x8a\xa8hk\xdbwj\x13\xbe\xcfd-\xbe?\xb7\x14G\"H\xf5.\x9cw6-\xf3\xaaX\xb3\xb7<a\xf7\x13\xe1\xbe\xc9\xfd]\x98MZ\x00f\xc5\xaa;\x9c\xf2\xa9$M\xef\xd2\xab,\xe5\xd7\xa0\xf1t\xa4\xae\xc9\xdcj+\x96\xf3`2o{\x99\xc8\xe9<\xf4`\xc19k\x03.\xea1\");\x0c'\x1d\x94\ng.\xb7\xcc\xd1\xec\x1fi\xd6N\xd6\xa6\xbb\x89\xdavE]{\x8ai\x837\xb7=\x17\xd2\xae\xffd\x1c\x9b\xbc\xd8\xc1\xf9\xcf\x92u\x00d4\xefp\x06K/\x8b\xafX\xa6r:\xcfN\x99N\x05\xb6\xa0\xec[p\xf4\x9a\x96\xaf\x9c\x84\xbavSF\x94\x8e\x84\xef\xc7r\x0b\x0c\x95vZ\x97cv\xbf9\xe3\xbee(\xb6\xe3\xce\x1c\xde'\xb7\xa99\x1d\xe7\xc4\xfd\xd4Q<\xc6(\x8c\x88\xe5\x10rLRZtA=\x9dL0\x0b9-\xc24\x92\xe8\x1d$\xfa\x11\x12\xf2G>c\xdc\xc8\xffm\x97\x1e:\x9b\xde\xf7\x87N\xa9\x87\xa9\x98\xef\xb3f\x93\x17\x88\x9d\xa5\xfc,\xa1%\x9bj\xb5\x11\x05\x8dx)\xa7\xef\x97o\xbe\xa6\x83\xf0\x14\xefX\xb9\x8f\xd7\xec\xa7\x1f\xdf\x12N\xd11\xab\x80\xad>E7lt\xb9\xff\xd4\x98\xbc\xae\xb9\xefs\x0b\x99u\xedI\x91BJ\x17\xef\xa1/L\x98\xda\x8e{ Q-\x1dfP\x1c\x9e]2*\x92\xe3(\x9c\xfd^\xf4\xbb\x05\xec\x03\xba\xa4\xc5Q\x87\xc9k:J\xd1%&;U\x15\xe2\xf4r\x9a\xb0M\\e\xe2\x1f)\xbb\xc3\xb2\xfb\"\xdf\x8f\xa8D4\x88O\xe3$y}\xcb\xb8\xf8:-\x05\xe3\xacX\x1e'!\xaf\xe2Y\x1e'\x1e\xc9\x19\x19\xcdq\xc0%V\x8b\xd7[\xc8%+t^\x91\x97\xf36;\xc6$\x01\x14\x08\x14\xa9\xa4\xeb!\xd6\xfb\x8cM\xd7\x86k\xa0^\xea\x91Q\x9f~\xdb\xcf\x1end\x8dC\x90q\xb2n\xf7\x9c\xdbP\x90\xcb|\xa7(\x88\x87\xb1n\xee\x98a\xf2\x9ez\x86\xfa\x1f\xb7j\xf9\x1c\xfaw\x05\x1b\x97\xa78&UR2x'\xba\x18w\xba\xc8\xb0d\xfcn\xc8\xa8W\xa1\x82\xb7\xa1Tt\xd3\xef\xa6ll\x89\xae\xa6\x9b4\x13\xac\x98\xbe}5\xb8\x1f\x0c3 \x18\xe1\xed\xb9\xed\xe0\x1c\x1e\xb3S\n\xc9\x10\xd9\x06O\xba-H\xdc\x91n\x06MOD\x8f\xe5\xf5\xfd\xd7\x96\xfc\xf5\xb9\xe1\xb6K|\x19\xf2(\x08\xa3\xa6\xc1\xc1\xa3\xa3\xe2\x7fiTj\xfc'q\x8e\x1d\xa8B\xff\xc7ij\x02\xec\x91\xacd!n\xe3\xac\xd2\xf4\xe6\x7foJ\xd4\xe1\xe9\xe0\xc4\xa4\x1b\x89\xbd%/\xcfi~\xa2\x83\xb0\xd5M\xc7\x0c\xe3\x1e\xe6\xd1\"\xedTi\x80\x88aG\xf8\xcbi\xaa\xe4\xbe\xff\xae	\xcdr\xc2\x8a\x99\xf9x\xff\xf2\x0b:\xbcs{R\xab&\xc7\x7f2UN\xf1\xe1d\xc4p\x00\x92\xc7R\x1c\xb3\xd4\xcc\x9c\x91\x0e\x8a\xc3\xc49\x859Y\xb9\\\x03\xef)\xf0=\xf8`X\xac\x1c\x0e\x7f\xb0\xe4\x18x\x07\x81\x03\xab\xcc\x9d\x13\x1aCy-\xbc\x80.\xb1?C\x8eD\xf6\x1f\x03S\xb7\xf8k\xc3\x14\x9e\xca\x02\x07\xb5\xa5\x1c\xf8\xad\xfc\xa3\xa4\xb6\x16\xb9\xf5\xa7\x10\x84\xb6\x1e>;Fd\x9c\xb3BRH\xea]\xc4giB?\xf2\xc67c\xef\xa3\x17\x17\xe7\xf1\x8b\x0b\xa5xk\x93'\xabb\xb5\xfa\xe8lW\xc6Y\x96\xdf\xad\xe3\xbd\xa8\nF?\xfa\xe8\xc5E\xbe\xd7\xba\x06u\x02\x00i\xe7*\xf1\xc5\xc5\xb9J~\xe1\x11v\xbc\xd0^\xd8\xad\xee\x03\xfd\xe8\xa3\xc8\xa2u\xdf\xbfU+\xe3\x85O?<\x89h\xab\x8c\xff\xa8^y+\xd0\xe1\x0eVjz\xd2VU\xd7\xa6\xaaV\xed\xbf\x0c`o\xd4J\x11z\xaa\xae4\xf97U\xe3\x1f\xaa\xed\xdf\xf4D\xb9@\x9f\x8b\x0c\x94i?\x0d\x96\x8c\xff\x06\xcd\x8d\x9f\x0e\x14\x9d\xfem:\x0e\xc7\xff\x8e\x80\xd0\xf6\x96\x97\xf5\xd6s[\xb0\x0d\xfd\xe8\xa33\xcbw~d\x9e\xba\x0b<\xf8]\xad\xde\xb9\xb3|\x8b\x13B\x9e\xe2\xf3\xf1B\xf4du	\xf2\x1e\xf1\xd4\xe1\x0e\x0c\xd5\x85>\x81{\xd9%\xf7\xe7\x11\xef\xd5\xa9e\x90\xdfi2\x04\x1bPR\xe9\xa8\xed\xb9\x8d\x87\xc93\xd0Q\x0c,\x0c\xe30\xc8\x81\x9a\xec'\xe2\x05f.<L\x8e\xf6\x8d\x9d\xb1\xd1\xect3m\x05\x7f\xb5\x9d\xa1j\x9e\x92\xe0\xde\xc3\xc4\x94$\xd3\xa7\x81\\{,\x11\xc0N\x8a\xd0\xac4\xf9\x0d2X\xd3\xd8|\xaa\xebxz\xc7\xaenR\xf1M7\xaf\xfc\xb0\xcb\xff\x18H\xcd\x87r\x96\xbdD\x89]z\xd0\x97\xc8YY\xe7\x9c\xc3\xc6\x83\xfctm\x0c\xbc\xe0\xa0\xa7}\x0b\xcb\x91\xdc\xe70\xb2R\x8flD=\xf2DB\xf5-\xbd\xb5\x13\xe6(\xe2o\xb5\xee\xa6\x96,aI\xcb\xa1<\xa5\x9bG\x98\xf9\x88\xa7\xeb|'\x05>\xc3\xd3\x7f\x9f\x97\xa9\xec6&\x0fR\xa4s\xb2q\x11\xa7\xbc\xc4\xcb\x01\xa2C?\xebH\xfdK\xd6g\xed\x03F\n*\xba\n\x0b+\xb3K\x89\xa1\xaeGhT(]i+>\xc8Tn\x9b^\xb6\x8f\xa8\xc0\x01;\xd5u\xdf\x9f\x7f\xea\x9f\xfc\n\xd6K}\xd2\x99n\x90\xd0\xba\x06A\xddNJ&B8\x0c\xc2h\xb6\xb0:\x19\xf2\x8a\x8a\xe5Q=\xcc=\x0e\xce\xe4.\x98-\xd4$\x8dN\xf6i2\x12\xa7>Y>\xb2\xae\xd1\\\x8aDC\xa2\x1f\xa5\x14\x89~\xb2\xc0\xcb\xd3\x93 p0\xc7u=J\xc0J\xee\x15\x932\x10K\x94M\xd1p	h\x85/\xe5\xf0.\xa5\xa8\xdfi\x8dR\xba\xf3\xfd\x07\xb4#\x0c/'\xf3@\xa8\\\xe2T.\x81\x97\xf3\xa0Z~\x8f*\xc2\xf0D\xfe\x08\x1c\xcc\x82\x8f}.K\xcf\x87\xd6\xe7\xe4\xbcZc\x96v\xd5\x80\xf7q^c\x1a\xb2H2\x08\x02\x0cGFi]\x8fr\xec\xc0\xdf\xa5\xed\xf4r\x1e\xa4\xf2%\x1f\xea\xe0\x02\xb4\xeb\x94\xda\xb2Z\x0b\xb6\xe0\x94-ZU\x95\x03>\xf1\xb4\xe2J\x89\xc8e.1\x9c\xabts\xa9\x1cqXD\x94\xd22,\"\\\x8c\xc7\x96\xf9Z\xee\xd57\x02_\x02\x9dm'\xbb\\\x9a\xe7y0k0\xb9lH\xc9\x0c\xb2\x1b>\xbc*e\xf7y\x95e\xea\x8f\xc0n\x11\x8b:\x8f\x96b\x08\x04\x9dc\x8a#\xe4\xeb\xfb\xaf{*\xf4\xb2\xaeG\xa5\xabB\xef)\xd5\xb1(\x1e4j\xb1\x98Q\x00\xdb\xca\xebz\x00\x9bJ\x804(G\x1f\xb1\xb4	\x16\x9d\xd8\xe3\x88c\x85\xb4\xfe2\xbb\x803\xa1K5\x1f!\x8b\xact*'\xc6`\x9f\xc1\xc9\xfc\x93Iy@\xcc\xcco,D\x7fR\x1f/\xac\xf1GG!\xdb3\xd2\x89\x94\xf6\xf575YnN\xd2\xcb\x89\x97\xea\x00v\xf4\xda\xc8\x11\x06\xb6Z\xcb\xcbe\x11\xb8\xaa\x8f\xba\x1e\xbd^\xf6\x04i\x81\x03\xb0\xbb:\x12\xae`=\x8bi\xb9g\xebt\x93\xb2dY()+\x00u\xb4\x1c?+\xd7\xf1\x9e\x0d\xf8,\xf4L\xf0\xd49\x87*R\x14\x1d@<6\xd5\xf4\xde=p\x11\xdf\x9fANrV\xf1\x82\xad\xf3k\x9e\xfe\xc1\x923v\xbf/XY\xa69\x0f\xce\xbc\xb1\xae\xb2\xe2\xe9\xef\x15{\x97\x17\x83\xea.)F(\xbc\x02\x9b>\xa3\xa3d\x9a0\xc1\xd6\xe2U\xb5\xcf\xd2u,XI*\xaa\xf1\xe7;!\xd9\x14Pn\xab\x83_\xc9\xaf\xc8\x0f\xe8\x15&\x99\x11\xb1\x04U\xf6u\x18\x08J\x98\xca\x9dPPn,\xcc\xb0\xa3{g\xda\\\x19q\xb9\xd5\xe7\x16>+P\xea\x13\xd6\x90\x9c\x960\xf9\xef\xd9\xfd\xf0\x00<\xcf\"F\x03\xff\x80\xbe\xd4\xd9!\xa54\xad\xeb\xcf\xd4\xcf\x1c^\x95`vd\xa3\x08~\x1c`\xfb\xc0-\xfa\xed$\x82\x1d)\xa3l\nv\x0e\xc0\x07.\xd8B&\xb8*{>\xa6\xb9\x14\xd3\xcc1\xe2s\xd5\xf4\xc7\xee1\xa1\xea\xe9?$\xb4\xa8|\xed\xbc\x81@\x0fu\x88V\xb5\xd2\x10t\xa5T\x97\n\xcf\x94\xf4\xe0\x1c\xe4\x04\x9f\xcc\x88b\xc7\xbf/Y\x95\xe4A\xc6\x08 \xa6\xe0\x0b\xd2n\x8f\xe0\xd0\x10)\xc3\xca\xdf\x82e`\x19\x11\x1c\xbc\x17^p|:\xae\x0c\xe8G\xb3\x86xg\x03\xdf\x1b\xe2\x8dmr\xc1n\xd3\xbc*\xf5\xf0;e\xff}*S\xd3\x90}\xc1\xde\x80\x8a(8\x80\x85\xcd\x90F+\x9cGT\xfe\xe9\xa9\x8b\x08\x0b\x9fG\x14\xc9\xbfu\xcd\xc2\x8f\xe1\xef'Q]\xbb{Jg\x95\xa2\x19\x00\xe13\xa5\xf2~\x1eQOn\x8d\xf0y\x04Gb\xa45a\xf8\x187\xdax\xe7\xd1\xbetp\x0c\xf1\xb8\xd8\xaa\x06\xe6\x91\xad\xe99^\xea\xde\x99\x1d\x8dX8\x8bd\xc7?\x8e\xe8\x18\xc9\x9f\xa5\xec\xb2|\xfc4\xaa\xeb9\x0e\x9e=E\x1e\xbbe\\U\xf6\x1c\x1co\x92\xc4\xbcaY\xf6\x13U\xf6\xffDc\x16\xfe\xdf\xa3\x0c\x81\xfc\xf1\xfd~\x8b\x8d\xb1T\x1a\xda:#\xd9\xbc\xef\xcb\xd91\xb0\xf6\xc5\x14\xe6@\x11(\xa8c)wb\x00\x03Z\xca\x9c\xb4;\xe5\x01\xf7\xfd_Tv.	\x9c\xa0[\xc4%\xa9Q/\xdc\xf8\x92!\x0f{\xc4\x9c\xbeM\x04\x9e\x98g\x0c\x0b3\x93\xf5\xce\xda9\x14r\xc4\xcf\"\xca\x9d\x14w\xb5\x9ec\xdcH\x80V \xf4\xfe\xe5\x17\x03\xae\x1a}=\xe3\xe0\x19\x95V\x0f\xb5\xfcmkl\xd2\x0cB\x82=X\x90hp\xf8\xf8K\xeb]\x95\xe5\xd9q\xbf\xf6!\x03\x06\xc1j'\xebZ\xce\x94co\x84>X\x0396\xf6\x94\x91Q\xfd\x04\x832o\x8f\x18\x19\xe8\x97Pk0\x80\xd7\xd6\xae\xc5\x80}\xa9\xeb?W\xae\xf6\x15\xabZ\xc9\xefa\xd8k\x0dnHw\xef\x826\xf4\x91\xe3B\xcd\x13H^\x0c\xbb\xd6\xe4\x94\x8a\xa5\x94\xfd\xa4\x80\x14H\xe9\x08\x89\xb1\xc4\xeb\x9eJZJ:\x92\x06&\xc7R\x8c\xe0\xf5\x83~M}\x1f<\xbb,\xa4\xa58\xf0\x9e\xb6\x1f'\xf3\x8b\xee\xb7'\xed7m\x10\x8b&\xa9\x81F\xd5\xd4\xbfu\x96\xc9\xfc\x02I\x84\xd1\x9e\xca\xbf\x918\x11,\x98p\xa7\xd2Z\x95\x00\xa0\x07\x8c/,\xac\x9a\xba\xc7s\xa8}\xecM<\x80\xde\x1e\xb6\xd9\x12\xc9\xae\\\x93[5]\x0f\x14\x90\xcb\x88\xd2\xad\x03\xf5dG\xbd,.\x85\x9b>\xf9\x18\x93{\xeai\xfbA\x00g3\xbb\x92\xe0]\xab\xf9\xb9]\x1eC\xcdh\xe4J\x13\xcdiktc.D\x1fF\x92\xf1\xf6\x1c\x8a\xe7\x0d\x10\x81uWJ\xd9\xd0\xfb\xd3\x9b\x85\xec\xe9\x88\xfb\xfe\xe8\x9e$t4\x97\xa4{\x0d\x14\xfa\xc1\xb0\x13\x19>\xc4V\xec\x88i\x1cf\xa0\xf2\xbe_\xc6\xa7\xb7\xdf&\x90#\x8f\xfb\x1c\xf1h\xbe\xa8hF\xbd\x9cg\xe0\x14\xb8\xf5\xfdQ\xe5\xfb\x9d\xe14v\xfb\xa7\x1bT\xd1p\xb7\\;\x14?XO\xe5\xf4\xc3sDv\xbe\xbf\xc7\x87\x84\xa2\x92\xa2\x82\xa2\x94\xa2\x9c\xa2\x98\xaeqx\x13\xd55\x8a\xc3\x9b\x88\x1e\x1a\x8c\xc3X\xb3ao_\xc9\xf4\xdc}W\x19\xb6Q]\x87\x11\x96x\x90\xd2w\xbe_\x84\xf3H\xf2\x97\xe1\xb3\x88\xc4\xb4\xf4\xfd\xb5cn\x16\x96\x91\x9d\x8e\xf1\xb8\xf4\xfd\xd8\xf7\xe5\xb4\xd45JhIg\xb8\xae\xab\xe9>\xdf#0\x93\xea\xce\x84\xef\x8f\xc7\x89\xef\xc7J'\x9e\x86\xdb\x88\x86\xefHI\x92h\xa1\xdcO,\xe7\xb2\xf7}\xa8\xce\x1d\x19\xfb_\x1a\x19\x81\x03\xfb\x04\xff\xf5Q\xfc\x87\xeb\xad\x87	\x83P\xbd\x8f\xff\x8b\x9e\xcb\xa9I\"L\xd4lu\xbcmP2\xa1\xb7\xb2\xe9\xeb\xbaN\xfe\xe7\x9a\xd2\x99\xef\xcf.hr~\xdd4\x03D\x97\xe4\x86\xec\xc6\xf4j\xba\x07F\xad\x0cYT\xd7W\xd3\x92	\xc5\x0b\x95aod.\x0b\xe1U\\\x1f\xa3\xb3\xe4LU\xa0\xb8|\xeb3\x16\xdeD\xcb\x18\xe58\x98_\xc4\xc6\xd8\x10	\x1a2\xc2\x88\xe7\x91<\"n[=\xe7\x01\xd4\xb7\xe0Z>bk\xc0\x1e\xb50\xf8\x1e1R\x84i\x84\xad}\x01\xbc5x\x88\x9e\xca\xca$qop\x10K\xbeP\xcdLp\xe0\xb9\x08\xb2\xaev\xf1\xb6\xf5\xbd	#R\xd2\x0db}c);\x19\xa5\x9c\x8c\xde\x08:\x06\x90\xb44*\x81\x82\x84\xb0\xc4\xac;\x9ax2\xc1(\xa5y\x18G\x8aE\x89\xd5p\xe2\x88\xa6\xb8;\x98\x8e\xd3R\x01\xbc\x0c)Q\xa15\x0e$\xc5\x04\x12\xe1u\x94*\x98n\x1aL\xb6q\xd9\x19\xe3c\xa6/FdgVRo01\x82\xfa\x89Z\x04\x15}\x86\xf8\xb8bI\xe2:rN]K\x01\xa6\xa5n\x02\xfa\x9a\xc5\xfc\xba\xd3L;\xe2\x7fh\xf6\x0f\xb8\x82S\x10\x0b\xe5\xcf\xbc1\xc7\x84S\xfe(gF\x8e8\x87E\x92\x83\xea\x92J\xf1\x1cj\xea3&\xf7\xbb,\x90\x1fd\x07\xfa\xdfT\xba&\x01\xa0\xf7\xecB\xba\xe3\x1dn\x07\xcd\x81J7\n\x14@\xeas\xd4X}\xeb/\xdc\xeaJ\x1bLD\\\xf4\xbd\xbc\x15\xfb\xc3\xa7Y\xbe\x86\x10\x18\xbe\xdf>\xcb}\xb8\xed\x1cT+\xaa\x0e\xcc\x02\x9b\xa6IC\x8a<\x1f\xf4\x1ag\x12\xe75\x04<lN}\xbf\x9c\xc6k)\xeb\xb5V)\xa3K\xd9\xe4\x1bp\xcb\xa9\xdbg$\x99\xcb\xd1\x08)\xbb,9\x8b\xdb\x82m\xea\xfa\xdfl*\xe2+0\\\x03\xa7e8\xa8\x08\xae\x19\x1a\xcd11\x07\x17\xf0>\xc3D\x1fj\x0d2\xe3\x8f\x9a\x8a9\x96b\xb2\x17lj<\x8ajO\x9dB9\x9f\xcc\xc1^C\xcc\xd30\xa3\xee\x1a\x88u\xac\xbdL1\x18\x14\xd1V\xe5m\xadl\xb7\x17\x0f\x9d*\xff\x92\xe4\x9fJ|d@\xe2\xe2\xd3!_S\xd5\x87\x81\xde\x8e,M\x98B\xebp\xd0\xbbeq\xc2\x8a\xa1\xb1}\xa5\xe53;\xa7\xb8!0\x81C\x99\x7f\x18\xc8\xacL\xf1\xfe?.\x93c\xd0g\x80\xc6\xb5\xf1k\x08\xb8\x91\x1co\xe6~U\xa7m\x12=YC[\xbf\xef#%. qd>\x0c\xac/\xf8\x16\x9b2\xfdmn\x02\x03\xdc:H\xccLR8\x8b\x00\xc7\xf5>;j\xcfPL\xe62\x0f\xfb\xbd\x9f\xa3\xc5\xfd!\xbf\x98-\xf9X\x04\x1cr\xde2~\\\x9b\xe3j\xb7\xe0\x17b\xc1\xc7\xf4\x19f}\xdb\x03\xd6`\x92'\xc9c\xc5\xe7\x7fR<;\x1aJ\xd7\x87\xd5\xf4U\\\xf0\xa5\x08\xf8bvA'\x93ba*+:\x95]\xff\xc5\xca\xf8b<..\xc4p-M\x83-\x94s\xb1\xa5\x0e\xcc\xffN\x0eE\x9c\xa4y0\x9a)\x1cr\x95\xdf\xcb\xe7M\naZ\xc8>.\xcb\xbb\xbcH\xe4s\xba\x8b\xaf!v\x0bv\x19)\x9a\x80\xc1\x87\xb1;<\x94\xd5\xd5.\x152\x7f\xc1J&\x8e\xf3oU~=\xa4\xb3\x1dC\xf8\xd0Z?\xde3\xb3\xef\xd5\xbe\x98\x91\x8e\x03\x86\xe7-\xc4\x05_\x88\xf1\x18\x17c\x888\xa0\x94\xbf\xad\xed\x88\xad\xe9\x8a\xa1\x92X\xfe\xa9\x02+\xd5\x82d\x1ay\x905\xcd\xea\xba\"\x1b\xd8P\x8e\x92\x8dR\xba&{\xea\x1c\x89h\xf4\xb3\xec\xaf\x82v\x05\xa1,\xac\"\xc3\xef3\xe7hqc\xd4\x8e\xa5.\xd1R\xab\xc7\x04>\xc9\xfb\xee#e\x9d|\xd4\xc6@#\xbeo\xea\xb7G\x88\xaer\xf3\xb1\xfe\xc1i\x13\xca)S,:\xb3,:\xeb\xb1\xe8\xac\xcb\xa2\x93\xcc\xf7\xb3G\x10\x08V\x8d\xd65[\x18o\x0cT\xd04\\+\xd1\xca\x95H\xa8\xe3\xab\x12\x87\xcf$\xcb\xfa,R\x8e\x18\xe1:\xa21\x96i\xc7\x03l\xe9\xbe]\xef;\x86Z\x1d\xc8\xfc\"\x1dr\xfbi\xbd\xc2\xd2c{t8\xb8\x0b\x8b\xa8\xd7\x98CG\x82T\xe2+\xdb\xe2{\x86l \x8f\x16fa	%\x9b<#U\x0b\xba\x19U\x0e\xe0\xc2F\xd5\x80y/\x81\xc7\x95b8G9\xd4#E%\xb5\x87s\x98g\xe5C\x8cJ\xec\x06\xa9\xb0]\xb8d(![rMn\xc9\x03iI\xcf\xad\xef\x8fn\xc3\x9b\xc8\xf7\xd1-\xbdd\xe8\x16c\xf2\xe0\xfb\xa3\x07\x95\xf6 \xd3\x1e \x18\xc0c\xac:\x89\x95\xcdS%\xffd\xd4:\xf7\xaf\xa9\x84\x9e\x938i\x06\xa1\xac\xf4\xf4\x16\x17)x\xfe\xc3I\xa0\x08\x8b\xc8UA5h[\xd7\xdeS\x8f\xb4\xf6``\xcd\xc8A2\xd8\xd0QR\xd7#\xe6\xfb\xdb\xe5:x\xcf\xd0Z\x8a\xcb\xd0C\xb2\xa7\xd7\xcb\x07	\xb2\xcb$\xc8\xea\xfa\x16\xe2\x81\x88`#!\xe7\xda\xf7\xaf\xd1\x86\xecU\xce[|H\xe9{\x86\xf6\xa4\xc2\xe4\x16\xa5$\x8c\xd4\x87\xbc\x0f\x03\xb9\x14<b\x9a\x869,\xca>\xac\xc2<\x92\xb2\xc7F?\xc5\x187\x92\xd9Pj\x92\xbaN\x8c\xbe\x04\xe4\xa2\x9c\xee\x07\xeb\xdb\xab\xfaR\xb5\x8e\x9b0\x97\x15-\x1e\x80\xa2\x92=\x88U\xa4\xc0\xcd\x9f\x14\x97\xd2BJ\x1f\x96\xdf\x83\x07TP\xeaN\xb20U\xd2Q\xaa\xba\xa7v\xfe\x1e\x06,I\xf1ro\x0ez2b\xea\xc7\xc1\x1e\x93\x87\xa5\xee\x81 {R\xe0\xc0:\xe1\x91}\xc7\x1a\xfdu\x07\x1f\xa7D[j\x1bP\xc8\xe9\xd5\xd4\x9cj\x84\xa0H\x96\xfc@Db\x9aK	\xdb~\xf2\xce<\xb9%\xf2\xe5<\x90\xdbb\xd0\xcb\x10\xd8\xe6\xb4!1\x91\x1cm6\x9ci2\xbf\xf8\x1e\xa5\x10\x07I\xe5[\xd3px\x87\x8fr\xb9\xb3\xeaZ\x8c(\xbd\x93\xdb\n\xa5T\xe0\x16\xd0*\x9d=\xc8\xcc\x867p\x99*\x89\xb1h\xa2EyQ\x98\x108\xa2;\xd4R\x0f\x15\xafix\xc5\xd0\x1dCkL\x04\x8eZ\x8c'\x0b(E\xb9\x93\xdd\xf82\xc1)\x9bL\xd5G\xd9\x18\x877\x91	^2\x1e\x97n\x0c\x80N\xbb\xdc\xb4\xdb	Ar\xc9\xd0\xfc\xa2\xf4}\xd5\x0dx\x94\x94\xd4*0\xcb\xc9\x1c\xeb\x88s\xe8\xa0\x8eK\xbd3u\x88QN\x9e\xa9*\x97\xdeS/\xf0\xbc\xc6	kc\\\xa1\x04)/\xb8\xef\xbfn\xab,\xe5\x84\x11~Q\xa8Tj\xd2m*\x10r\xdc\xac\xb5#\x94=a\x84\x1e\x9a\x97\x1dsb}\x99\xd9*[\x06\xa5\xa38\x01M\xfd\x8e\x91--%\xbd\xb9a<\xfd\xa3\xef4{\xdb\xda\x06[\xd5\xe9\xbdQ\xfb\xa7\x1b\x94YK\xcf\xe5,\xc8\xeci\xea\"\xa6\xcc\xe0\xba\xab\xa9=#3\x1a	\xb5.\x10\xc8C\xa2jT\xd0_\x95Cd,\x19_\x04\x81\x84h\xack\x93\xf4\xcd\xec\xb4\xba\x8e\xad\x91\x95D\x12rr\xe8hNPA\xff\xb05\x80#\x82u\x05#\x1aQ\xe8U\xe2D\xceM\x00\x95:\xabr\x066\x88m\xa3\xf6PG\x99\x14\xcb\xcec\xd9\xcf/\xc2<r\xba*\xf1\x98\x1a\x80|B\x05t\xffO\x1b\xcf\x89\x06\xd2\xa08\xd1(\x18\xbdp\x1dG\xc7\xce\xb0Q\x86\x05\xf1\xb2=\x1e\xc3\xc1=b\xa4\xc4v\xee\x1b\xb2\xa1\xa52$J\xb3\xe1\xf5\x94\xc4mG\xee\xe5\xba*L\x1bJ\x0c\xf3\xad\xb3\xae\xa3\x18\x1f\xd4q\xce\x16A\x8c\x9b\x96\x02i\x83\x18\x85M_3P\x8c\xc1n[\xa6\xc6k4\xc8\xcd\xd3\x02\xc5\xf4[\xc4\x08\xba\xa59\xd9\xd1\xd9\x05z\xa0\xa9\xd1\xfc\x90{:\xbb\xb8m\x99\xd1>\xe1\x94\\\x80\xe6\x00$\xe8\xd1\x19\xc9\xa87\x03\xad\xbc\xef\x87\x11\xd9\xc8~\xef\xe9\x1dI$\x01\xbd\xf7\xfd\xd6\xfa\x1bI*\x98b\xb2\xa5\xef\xc6T\xc9Z\xfb\xe5<\xe8D\x9b\xaa\xeb\xe9\x9c\\\xd3\xc4\x0cL\xc2d\xea\xfb\xe8\x8e\x1ak\xa8\xbaN\xf1\"\x1b\xc1\xd1\x83\x8e7\x93\xd3$\xcc\"\xbc\xc8\xc6c\xa0V\xf7\xbe\x9f\xe3CLgD\xd4u~d>uY\xd7\xe8\xbd\xe4<8\x1d\xbd6\x06\x03%\xbd\x0dcm\xf0^\xa2\\\x96\xbc\x94\xdb\xffP\x18FE\xab\xb0eo\xde\xd1-nv\xbe\x8fPNG\xa5l\xcd\xf7\xab\xc9\x840\xdf_\x9b\xec@F\xab1\xcd\xc8\xce\xf7e\x7f+\xe8\x92m\xeeA5W\xa25\xd9(\xce\xd9\x92\xdd\xd9E\xa5\x95\xd7\xd9d\x82\xd7\xa0\xb6\xde(\xe5\xb5\xfc\xa1\xbf+\x03\x95\x02\xe3\xc5FR\xc1\x0dn\x0ci+\xc8\x06\x93\xd4\xf7%G1\xbb\xd8X\x1b\xc6\xf9E5~\xb0o\x1d\xcb\x0dTXT\xa5\xc7F\xee\xe8\x1e\x93uCvK\xc96\xe2\xa0\xc0\x18[\x03\x01\xca\x1a\xcb\xa3\x91\xeb\xd6t\xe0\x08T:<\x16`\xa9\xe1\xb0\x9c\x8c\xac\xe9\xa8\xf0\xfd-b4\xb3\xcd\xd4\xb52\xb17\x8e\xe1\x92\xb3_\x9b\xcd(g\xe9\xd9\x05\xca\xe9Z2\xdak\xe7p\x17\xb7\xc6\xb0\xde\xdbW\x12\xfb\xa3\x98\xe6\xe1,\xc2Z\xca\xff\xac\xe7K\xf9Z\x82\xa8\xa5=9\x1c\xc3\x03\xedQ\x0e\xdf\x82\"\xeb\xcf\x81\xac\xd9\xab\x8b\xa9\xb4\xf2S`s\x14\xe1\xb8\x83gpd\xd7\xb1\x85$-\x1d\xc9\x0dJR\xf2\x9d\x19\\\x93\xd2/\xa6n\xb4\x0c\xadc\xc1\xcbY\x90\x1fk\xc5e?\xe5\x10\xd3\x888\x03)i\xdc\xa1\xa1\x92XWT\x0dEs\xe4\x05\xad\x1e\x1d\x91q_\xce\x0d\xcb\xa3\xdc\x98E\xd7\x8dYh\x7f\xec\xdc0a)\x99c2B\xccj\xf0\x81N\xe6\xad\x97|\xeb\xeb\\`\xc2\xcd\xc9\x90\x06*\x94\xd5\xf5\x061\xb2\xc6\x18\x15`aE8\x19\x89\xba6\xbd9\xd5\x0b\xc2\x1b\xe2\x1a\x12\xd1\x1b\xe3\x9c\xebacF\xa4\xadw\xc1y\xe9\x86\x1c\x1b#\xd1\xd1(#\xef\x11&]\x8b\xce\x13n[\xf3G\xecc\x1f\xf1T\x1e0\xac\xb7B\xf8\x90}\xfd\xdf\x941\xbdG\xbc\xbf)mU\xab(\xec\xa9\xa9d~I3\xebz\xa3\x95V5\xa8Y\xb7,\xbd\xde\x8a\xfa.M\xc4\xd6#}NR\x915\xdb\x85\xae\x81\x1a\xf1\xcc\xb1oO\xe3\xb5\x9c\x07\xcf\x94/^k\xe2vd\xb2=8.P\xcd\x9d\x83\xb7\x863\x92\xae\xad>\xec\x07\x0fb\x0dz\x7f2h\x95\xd5\x8eZ\x97\x1c\x1c\xa4\xef\xff\xb9^\xb0\x9d\x08\xe34	\x96T\xa7\x96L\xc7\xbe\xeb\xf5\xa95\xb9\xd7\xdd\xfa\xf1\xa8C\xc0\xbf/\xdc\xb9W\xaa\xe2PD\xcb\xdeT\xffW6\x82\x98\x94\xacA\x97xq\x03[\x9en!\xe6\xe3\xbe\xa0\xdb\xd6\xd2K'\x85^\xe0\xa9\x18\x92\xfb\xc22\xa57\xaem\x9fy\xa1['\x95\xdc\xa8\xe8\xc4[cAGn\x94\xef\xed\xab|M\xb7\xea\x91\xdc\xb4\xf6\x9e[\xfb(\xdb\x05\xf3Ek\x1d\xbb\xd5	`\xa4\xf9\xfeD\x90\x1b8\x9c\xb3\xf6\x95|\xd1\x9e\x9f\x84B\"\xb4\xcfF\x9ds\x93#m\x10@A\xea\xfb7\xe0\x99TJ6^\xcb\x17\x8af3K\x07\x8b\x86\xbc;\x19\xc5,\x8c\x06\xf4\xf3}\x8f}\xa6\x02\x83rS\xb3cn\xf7\xad\x99j\xc0\xbc\x1dD\xdfj\x0c_\xa2N\x80\xb6\xbfh\x17\xd4\x8bM#\xfb\xfb\x8a\x9e\x7f\xb8@a<\xf9#\n?\xac\xceW\xb3\x17\x01\x843\x13\xabb\xc5W\x9b\xe8)\x0e\xbb\xef\xab\xf3\xe5\x0b\xb4\x0c.V\xe7\xab\xf9\x8b\x1a?9O\xdb^\xfd\x86t\xa0\x17#\xda \x8e\x977\xd3\xeb\x82u\x0d\x87\xda\xae\x8fF\x9d\x18\xbc#J\x8b\x06\x07\x8e*d\xa0tGV\xe6\xba\xc8Q\\\x18\xfeX\xd1\xc9\xfc\"m\xc3A\xea*n\xb4\xd0 \xd3H\x01\x81\x98\xe1\xfd\x04\xb8\x89p\xd6\x86\xa5\xf4}\xc4\xa8\x17\xf0\\ 0\x9b\xc2\x1e&**\x83\xe5\xb0:>\xdbK\xb5\xed\xfaF\xdc\xa8 \x0c/\xc3\"\n\xc2(\xe8fA\x8c\xe8\x01\x89\xa1\xb9\xe8\x02\x18\xc42vb\xef\xa2\x03\x98_\xba\xe5n\xad\xcb\xb9\x1bU8\x85\xb7\x85c\xa4\xda\x9agY\xb1\xb1\x17dYn\x17=s\xb6\x01\xb5!\x04\x9d-\xc4E\x01\xaa\xeat\x83\xda\xcd\x8e\xd2PD\x04\"M\xb7\xfaL\xac4\xe8\x9c\xf6\x1a\x08#L\xdc\x9a\xd4\xbc F\xa0\x92V\x956\xbf(\x96.ZB\x1c\x07\xdc\xda\xe9\x0d\xccY\xaf\x9d\xdftxi`'Gs\x0cqi\x07O\xb5\x1e-8\xc3\x10\x16v\xe8\xa0m\xa4s\x1e\x1b\xca\xf9\xfe\xb7\x96\x8b\x93\x13\x1a\xd8^\xb4'\xee\x80\xfc~'_\xabhb\xab\xf2)\xba\x08Ww\xab\x9f\xa3\xf1\x0b\x1c~x\x11=\xad\xff\xe6\x04\x14[ \x1b\x02}\x18\x80I\n\xe4\xa5\xb3\xac\x96\xa1\xfe}\xa0\x8f\x9a\xe3-\xa8w\xa1\xb4&\xb3\xc8\xf7\xbd\x17\xea\xb9\x0d\xb7\x15\xf9\xfe\xf3\x0b\xab\x1d[\x86J\xcd\x03\xf6\x08Q\xf0\xb5\x12\xc4!\x10\xef\xa8\x08efc\xf0,\x9981U\xb1\xce\x97\x10I\x01\xeb\x85\xc6\xc1Q\x1cja\xbfA@\x13\x13\x8aIPq\x96\xf2R\xc4|-\xbb|\xb3\x94\x9b4\x90\x94\xa7\x0dHNn$kX2\xc9n@I\xa2#\xec\xea\x9dy\xec\xe3\x13\xbc\x86U%\xda2U\x9bG\xf5\xc3.\x0b\xdc\x06\xe1\x17x\x07m\x85E\x84\x97\xfa\x01	p&\x81\xa1\x80%b\x01\xba\xe0N\xe8n\xc3\xe4\xa6\xf4u\xdf\xa9\xbb\x08\x9fE\xca\xaeUV7\x8bhJ\x9c\x8dK\xe7\x988\x1587\x17,m\x01\xd6-\xa0\xb6_\xb0\x93 \xd7R\xcdi\xc1\xe2\xe4a\xa9\x7f\x01\x12\xd1\x8d\xc4\x8d6H/R\xf5\xe0\x06;z+	k\xe4wz\x83^+0\xfdR\x01\xa9\xe2\xc1\xcbz_\xb0[\xb4\x0c~\xe2\"\xcdjp\x0e>'\xdf\xd1\x03X\x95\x15\x8c\xc3\xc9\x9b2\x00)\xe1\x92\x04v\x0f\xa7g\xb2X\xf7\xa2\x84\xef\x15\xf1\xe8\x93\xf6\xf9h\xc8$\xe2\x8c5\x1d\x1c\xb8\x8d\xcb\x81S\xe7\x1b3 Wa\xe2n\xf4a\xdc&\x0b3:[\xb0\x0b\xbe`G\xf8\x0d\x00M\x84,r\xf1[C\xd6Y^27\xf8{\xc7\xb6i\xa6\xd1o\xabX\x06\x1d\xcf1&V<\nl_\x8b6\x00\xf8\xecQC\x8bH\xc3\"Zp\xdf\x97tR,z.Qr\xb7\xb7V\x03\xf3\xb9\xef\xa3x9\x99_\xc4\xca\x14E\"\xd0\xbe\xa7\xfa	\xba\x05Q\x95\xf1!\xb7\x87\xc1]5X\x17_\xce/\x8c\x98\xda\xc5\xd79\x0er0&H\xd8\xfd\xa0U\xc5r \xba\xb3\xa6\xe3rB\x88\x86tl#6\x03Z68E\"\xac@#\x13\xc0]\xfa\xc1\x0d\xcc\xa3\x17\xbc(\xa5\xd4-\xc1\xefe\x96!\x83\x83\x83\xc9\xbc!q\x92\x04C\x9c\xcc\xf1\xdd\x03\xce\xc8:\x17\"\\3\x810\x01\xb0\xc3X\x12\x8b8I>\xef_\xa4\xe0V\x1a'	2A\xb4E7D\x7f\xd0{7\xc0\xca0\x18N\xe9\x00\xc7\x87\x01\xdb\x0fcrq\xec+j\x9c\xbb\\\x9c\xa8\x1d\x8b\xf4~\x1e\xea\xe9{\xc4\x88{\x86\x8cmn\xd8\xf5\xa7,\xd6\xfa\xc5 \xc26\xef\xdbn\xe8\xccr\xf7w\xeceq\xa3p\xc4\xa9\xbc}\xe3`]\xf7\xcb,;9\x84\x81\xea\x1f\xcb~\xa2\x85?\x1f\xb3\xdb\x0e\x0cZ\xd6\xf4\xe7\xc5\x8e\xcc\x9de\xd1R\xbd\x0c\xae\xcb;\xd4	,W\xd7\x87\x06;\xd22\x9c\"Y<<X\xde\x15\xaeef\x83\xa8\x8f3\x0f[\xf5\xeb\x02\x86\xa0.\x8fR\x02$\xc5\x19O\xb0\xdd>\x8b\x05\xf3\xe0P\x8f\xdalu\xcd\xb0\xa5\xe0\x10i\xca	\x86	\x9e\x18\xa6#\xa0}\x86\xf0\xdfE4\xa4D\xa7\xce\x85 i{\x15\x89\x07\xd3\xee\x81W\xb4\xb6j\xffDy\x90H\x9c\xe2\xfbGXG\xc0q\x85\x95\x17\xe0\xe0\x8c\xcc/\x1c\xdc\xed\xfb\xe8;\xb8\x83\xa7\xc7\x90\x92/5\x0f\x01\x91Q\nv\xcb\n\xd0*\x90\x1e\xf6\xe0\xd80|?\xd2\xf3\xf0CG\x04\x1b\xbb\xf7\xd4|\xe3\xe2\xc7\xf6\xc8\xf2-\xb2\xee{N\xea\xcf=\xd5+\x04\xc5c\xbe\xbfC\xe0\xda[\xe4\xbb\xb4d\xd8\xa0T\x88v\xc5\x19\xf0Zq\x9aIj`\xf3\x8a-\xe3mFu\x86(\xb4\xdeN1\x13$dF\xe9Z`'\xda\x1e?\xca\x85\x9b\xe6fz\x19g\xd9U\xbc\xbeq\xfc>u/\x19\xe1\x8b\x82\x1e\xadA\xb1D\x8c\x16\xea\x96(\x8d\xe6\xb4W-\xfa\x11T\xadGA\xd8ED%\x15\x96]m\xef\xb7hH\xa1\xe69%\xe2\xe8\xb4\x7f2'k\xda\xa3\xfb1\x8d\xeb\xba\x98\xe6|\xcdHNS:\x9a-*\xc35\xc8\x12\xf8 he\x14\xb7Z\x071\x1eg\x17\x0620X\x9f\x97af\x8eA%\x8bJ\x846\xb9\x9f\x96\"\xdf\x7f\xc7\xdf\xc4Y\xc9 \xe2\xbae\x06\x04\x1d\xcdqSLwl\x97\x17\x0fp\xcc3\x9ac}\xc9\x87\xef\xa3\x92\x8ae\x18\x05p\xa1\xc2\x86\x1e:d\xcaBH)\xa1\xda\xf7Gi\xa7\xee\xc9\x9cT\xe6\x88\xb25\x80=\x83\x8dm\xa6\xb67\x9b;$\xf0\xb2\xd0\xb0\xed\xfb\x9b\xe96.\x91\xc0u]\x9a\x8a\xd4\xbd\x15V\xd7ny\x18z\x07\xf1.9\x12\xb8\xc1\x0dj/\xcf!\xback\xb3\x1d\x1a\xa2\xa2-\x0e\x8cC\xf7\xca\x16&\x03\x9b]\xcf\xfdd~\x01{U_\xf7\x80\x04\x81c[\\Z\xefO2\xc7\x84_\xd0\xcc\xf7\xb3\xc9\xa41m\xf7\x99E\xcb\x83L\xe6\x17mm\x8c\x948\x98\xd9\xc5=\xb2\xdf\xec\xcc\xbc\x04-S\xbdV\x01\x0e\xe4\x8ci\xa5lg\x04\xf5\xbcn\xee\x81%\x1d\x95\x0d\xc9r\x97y\xe8W$\xea:\xadk\xa4\xea3\xcd\xcb\"\x83\xd5\x8db0Ud?\xa7\xa2\x7f\xcf\x88\xa9\x16`/dDJ[p\xa6\xa1\xb6\xf9\xd2\x18\x0f\xe3@D-@\x91\xb4\xae\x9d%\x95u\x0f\xf4u35\x8d\xf6\xefTr\xca\x0d\xf67o\x1a\xc3\xb8l\xdc\x8bk^\xb1\x0d+\x8a\x01s\xe0\x9c\x86\xa1\xc7s\x91n\x1e<IO\xf3\xeb\x82\x95\xa5G\x1c\x1c\x84<\xb5\xcb<|\"\xf5YDB\xaf`e\x9e\xdd2\x8fx\x12M\xf6*\x90\xf8\xe1l\xb8\x96\xee\xa7\x191\x15%\x9e\xaa\x15\xe2\xdb\x12O\xe2\xdc\xff\xb6\xd29\xd1\xf5\xc8J#\x92Ro\xcfx\x02\xfcBL\x0f\xa5\x88\xc5\xd0\"\xa4\x0d\x89\xb3\xbb\xf8\xa1\x1c\x82_E\x0b\xdauQ4\xe1h\x9d<@\xf4\xde\xd0\xd6\x89\x81jh\xb3\x0f\xc9\xf0\xa4\xfbN/\x00\x0b\xb7\x97+-\xec^7K\x89\\\xd2\xa0Q@>\xb4\xf5\xe9\x0e\xa5\xa1\x08?\x8e\xc0\xe8H=-\xcaP\"\xd9\x08\xf5ZdpC\xd6\xf0}^\x0b\xa0w\x0ee\xb4\x8f \x1d(\xc8A\xc5TA\x93&\x97\xc5T\xaf\xa7\x9e\"\xf9\x0e\xd7\x11\x06E(q\xfd\xd8\x93`\xeeE\xaa1\x0e\xd7\xd3\xb4M6\xb8\x91H]\xe9\xe8\xdb\xd6\x1a\"\xe7\xae\x9d-\x87\x8aWt\xd6\xb2\x03\x19\xd2\xe7\xa9\xed\xce\xea\x0eW	\x85\xa4h\xe7\x99\xb0\x81\x9b\xb9@\xb6D\xe9E\x85u0\n\x1a;\xa7r\x98R\x9a\xb7\x9ds\xee\xa4\x94\xd2\x82\x8e\x15\xf0~\xab\xec\xf5\xcfJ\x96m&0'\x95l\xc4\xc3\x0b\x017\xf8\xfc\xd5K\x01U\xbc\xd6-\xe3\x04\xa8N\xb94\x97\x11\x91\x0cU$'\xdf\x90\x12\xeb\xc7\xb7\xa4\xc48@\xd5xL\x1e\xcfdSs\xbdxrM\xb0,\x1b\x8f(\xfd\x06\xf8;\xcd\xa1\x14T\xf2(\x04\x95u\x9d\x9b\xa5\x85\xdcj*\x9a\x86\x08Z.;\x90\x0cl\x15r\x98\x9e\x16\x86\xa7\xec~\xcd\xc0\xa9\xe0\xcb<\xbf\x91\xf2\xf4\xf0\x17	\xcd\xd3R\xf2\x82\xef\x8bx\xcd0\xa9.h:\x06	}D\xe9\xdb\x81\x0e\xe6\x1a\xce\x00\x89\xea\xae-\xd2\xa5@8@N+\xd7L\x00\x8b\xa9\x9aGn#\xf4D6\x89\xbd/\xa7%\x13\xef\xd3\x1d\xcb+Pu\xd9\xa8\xd1C\xdb\x93\xe1C\x1e\xce\xa2\xf0y\x04\xb2k\x86f\x84\x91\x1d*\xf0\xb2\x08\xbe!\xac3\xe5$\x0f\xe7G9\x05^\x8a\xe0\x1b\xf8\xf8\xec\xe8#\xdco\xf6\x16\xe3\xee\xfe\xd0\x8fC\x88G_\x1a\xb5\xb4\xc4\x01\xcc\x07\xe3\xa6!%=4\x8b.O1\x8cPD\xf8,\"\x05\x15\xe1'\xd1\"V\x88\x84B TR\x00\xf6H\x12\x17\xaf\xa4\xb4hH\x1e>\x9f\xb0(|\x16\x99h]&\xe5\xb9\x9b2\x83\x1c\x92\x1c\x133e\xf2\x05\x13U\xa9\x90	\x92\xf6aR\x02\xf6\x88\xe8\x00n>\xc6+\x92\xc5\xd4Z\xbd`\x98\xa0v\x0bQn	p\x83Il'\xb6\xc4D\xb9n\xcb\xbdT\xca\x8dS6\xe4n\xcb\x8e\xdd7\xf8\xf1]}\x82rRP\xcdvI\xac\xa6o\x98t\xfa\x92;0\x870\x89\xdb\xd1\x9d\xf0G\x93\\\xbc\x92\xdf\xe4\xd3\xfc\xa2\xdf\xe8\xf2\xa8\x8d\x80\x91\xc9\x84\xf76/\x88\x8ar\x7fl\x10\xbf\xa0r[I\xd9(\xd7D\x0e\xc2\xba\xeb\xdcv_\x91\x11\xc7\xc4\xd2Q\xc0\x80@H\x11\xaek\xa07\x11\xd0\x9aH\xc9F\xf6\xa08WT\xcf\x88\x02b2\xc1?\xab\xe3\x0f\xd9\x8c\xad\xdd\xea,\x1d\xbcjD\xc0'\xf4\xfc\x03z}\x1bg\xf5[.X\xc1\xe3\xac\xfe1\xe6\xd7\xac\xfeQ\xce\x1c\xe3kV\xab\xc0,5\x18\xb5\xff\xf4\xe3[\x0c8\xf8\xc9\xf9\xe2\x14z\xe9I\xc7\x97\xa0\\\xcf3\xe6\xfb\xf6qz\x17\x17\xdc\xf7\x99\xef?\xb1N<\xf1Nb\xe3n\x16s\x03\xb1m\xe9\xcc\xb6\x04>\xa2\xd3\x1d+\xcb\xf8\x9a\x11\xa6P\x0dD\xe8\xb9Q\xfa\xe5\xd7&g'\xbaJ\x07\xd7\xb8hU\xcb\xb3\xb8\x81iy\xd3\x81\x9d\x96\x06~\x8e\xf0\xe1\xb5\x8e\xd4\xde\x8bS\xfc\xea\xbbo\xb4\xa3\xe1\xd7y\x9c\xb0\xc4#\x9fK\xd46\x98W\x85(\xfe\x1c\x9b\xbe\"u5\xabz\x19\x08\xacs\xf6F\xad4\xc3\xa1f\x82\xa2\x0eF\xec\x0f\x191l\x85\x8d\x96m\xb5W'\xce	d\xff9NE\xa0\x9f;{\x0e){\x80\xe5d\xa2+\x86\x9c7S]\x01\xaekd_\xe8h\x86G\x94\x82\xcdW'\x7f]\xbf\xe9\xec\x8a\xd7$\xbc\x89\xb4\xe6\x102\xc1\x90\xa8\x1a\x19\xf1\xd6\xf9n\x9f1\x01\xf6\x1e\xafU\x86wr\x0b\xd45\xcc\x96\x16\xf0\xdc/\xbe?z\xdd\x8fS7M\xf2w\xeb\"\xcf\xb2eg\xa1u\x8b8@\xaf\x07\"L\x9fX\xb9\xe3\x8cf\xd9\xd4\xd6\xf9u\xc0@Q2H\ng\x0d\xfa'P\n\x97`\xb4\xdc	\xbdC\\\xa9\xd7\xcb\xb3\x94\x9fI\x91\x9fp\xfc+TX\x12\x1e\x96\x11\x19\xcd\xa0\xd2\x85q_\xef\xdc\xaf	\x05v\xea\x96\xcf\x18\xaeP\xcb@\xd1\x8e,\x8bR\x98\x9b\x04p\x802*\xc8\xf1\x89\x9d\x06\xb1\xccQvs\x88\xc2\xa8O\x9d\xacG\x85@Lv\x88\x93xY\x04\x85\xb9\xb5\xb1\x8cHI\xcc'\xc7\x8b\"]\xb2 \xb3\xfc\x14\x0e\xaa\xa5\n\xbcB8\x0e\xf2\x86\xfcA\xcf?Lv\xe5\xe4\x9c\xfcD\xcf'\xcaJ\x00\xbb\xda\xa7_\xba\x1a\xf0\xa9\xc8\x7f\xda\xef\xad}\x81\xcd\xf6\x0fW\x80\xb6fd\x7f\x10oWN\x9c\xb89?\x91_\x94Q\xc2\x17C\xdb\xab\xef\xba\xf3Y\xef}4v\xce\xbf\xdb.\xfe\x13\x10GZN\xf5M\xa6\xca\xbaB>\x8d\xff9\xad\xd2d<n\xe0\x97\xce\xc9?\xddK\x97!\xc8\xd1\x11\x95\x13\x94\x85nm\xbd8*\x87\x86|\xa1.\x7fv\x035vKP\x11h]\xbd\xd2\x95\xb6N\xf0\xc4\xcdG\xb4\xfd\xb1 \xeb\x9co\xd2\xeb\xaa\x00}\x01\x9c\x93c\"\x1aR2q\xa4)\xd6\x07\xbb\xea\x14	F`\x02\x11\x1f\xa90q\x1a\xfe\x03	\x1cQ\xbe\xe8^\xfa\xa9\xbe\x14\xb8{Ee\xda\xbf>\xdaYw\x05\xed\xe0j\xd1i8\xe8\x8d\xdc\xf7{	\xaa\x07\x0d\x89\xd7kV\x96\xa7\xf4\xdem\xf5u}B\x1bk\xb3\xf0\xa5=b\x91=\x0c\xd4\x89K\xa9^	\xc7\xa4=\xe9\\\xf2@\xe0c\x1dS\xe7D\xae\xbf\xd8\x9d\xad\x0d\x92\x91}\x15\xf8\xc0)\x12\xbd[|%#\x0b:\xe7\x7f\xc8\xbeP9^\x9c\xf2\xb3b\x19\x8a(\x10\x1d}%>6\xd6\xd6\x97\xa4H\xb9\x91GQ\x83\xdc\x99\x90\xc8\xdd\xb9zV\xdd\xe6\xfe\x18\xd8in\xd0^\xbc\xd2\xf9*\xc5\x98m\\\xbe\x8aE\xfc\xd7a\xbe\x1d\xbb\xef\x8f\xfa\xfd\x11\x92\xbd\x92\xc5\x7f\x00\x9f\x85\x7f\x92\xaf\xf4\xef\xdf\xb5\xfd\xc2A\x19/<]5\xf5*4\xcf\x11~\x02\xf7\xa5\x85/'\xff\x8a\\L\xc3\xd8\x80\xf1B\xbb\xecG^\xf1\xe9\x06\x15\xd4Kb\x11O\xdc\x08:\xff\"\xde\xe4\x89\xef\xf5\x9d\xfe\xfb0\x05\x97\xe7\xf6/\xcbWr\x1d\xa7\x9e(* \x81(\xa5\x1c.\x14\x8e\xb3\x92I\xca\x97J\xa9V\xe2q\xf95U\xc1\xabRJ\xe98\x1d{\xder\x9c\x06:\xd2k\x8a\x97_\xbd\xfb\xee[e\x87\x80R\x1c\xa4\x8e\xac\xd8|\xe5\xc0\xab\xf2e2\xa2^k%v\xe3\x1ej\x1f\xad\x9a\xce\xf6\xd5T\x7fD\x0c\xd7\xf5\x0f\xce[C\x92n\x99\xce\x86\xfbj\xaa6\xa4\xe9\x83\xde#\xaf\xfaE\xf0\xe1+\xcd:!\x15w\xf1\xd7\xc7j\xfd\xa1_\xeb\xaf'\xab\xfd\xa1S-p#\xce1~\xb7\x11N\xac1\x93\x0e\x87\xafNuILs\xdf\xcf\x1d\xf3\xd3.\xc4({\x91\xce\x89MJ\xbf\x02\xcc\x91+\xdb\xad\xdc9\xf6\x1e\xfd\xa0\xbe\x10OO\xa2\x84\x8c\xd2\x03\xa0\xa0qw\xe7J\x16?\x06q@6\x84\n\xb8\xc2[\xf1\xcem@2\x05\x99\xca\xcc\xfa\x1f\xc8\x9c;}\x821aLyA\xc2\x1d\xcb\x8b\x1f\x00\x18\xfa\xed\xba\xe14S\xfdp\xa4N\xd1\xb8\x10\xa4Z\x87\x8bV\xe0\xa5\x94O\xb8\xc1\xc1\xaf\xfd\x0b\xf2\xf5t\xc2\x1d\x02\x0ej\xb5\x86Bv\xdb#a&L2\x15K\x11\xb8_`\x18n\xf2\xe2O{\x03\x97\xf07D\x1b\x0c\x1d\xcbv\xea\xcbhv\n\"[Fd\xb8%\x0dT\xd0\x184\xa5N\xc85X\xfd^\xb1\x8a\x0dST\xe5za*\xa7H\xc8M\x7f\xef\xe1\xb1\x07\x85<R\xd0\x1f,\xcd!\xdc\xf7!\xcc\xf2\xd1u\xed2\x97\xb3\x07\\\x9b\x1a\x8eq`/\x01\xc0\xa4\x90\x04\xa1!	;\xee\x94\x048\xdd\xfe\xc2\x1cjB.\xd5x{\xcb\x14I)\xb7>M\x92\xed\xf9\x15\xb2I\xe9\x0f\xda\xc7\x0b/\xe5V\xfdM\x15\xf6r\xcb\x14\x93	\xf8\x8a \xd9\x16\xd5\xf6\xaa&\x86\xae[\x16\x13MTr8\xbe\"\xf6X\xd0\x99\xfd\x9b\xa9\x1e\x8cF\x159\xc6dT\xf8>\xecQ84\x01\x05\x04\x92x\xa1\xed\xe7\x10Y\xa6BO;\xe4\xf0\x16\x16\xbd\xa8\x05\xe0\x80\xea\xec4srPG2\xa7\xb5\xe5}\xed\x8d\x83}Ba\x97\x98G\xea\x8e\xa2>6\xea\xad\x90\x05\x1aF\x9f\x99\xe3\xe5\xa3+\x0bA{'\xd9|9\xb3\x84\xc9y\xee\xc3\xfa\x05[\x9au5\x08M\x98X\xb2\x96\xcf	\x06\x01]\xb5\xee\x96V\xfeC]\x08P\xe9\x98\xb4\x8b\xeb.\xe9\xc8\xd8\xf7\xb5\xeb\xa6\x0b\xc8\x1dz\x0c\x97\x8fo\xbd^%j\x9b\xaf3\x16\x17?<Z\x8f\x06\x18\x05\xed$\x8c\x06\xd5|.\xbf6'iW\xa1\xa4\xc8\x01\x89;vU\xa5\xabC\x9bL\x8a\xbaN;RpNB\xc9\x11-\x86\x0c\xaf$f#L\xd3c}[$\xecE'p\x13\xd7\xd8 \x0f\xe3\x88\xb0\x0e\xb4\xaa\x1bQ\x00\"%\xea\x1f\x8f\x89~\x03 ,\x9d\x18R\xca\x11Q\xab\x82\x84\xd5\x05	\xb8&v\x12-%\x0b\x95<]Mk\xbcJ\xc6h\x19\x84\xecu\x04\x1fV\xc9\xb8\xc6\xe7\xfa\xce7\xc2\xbb\x97\xc9~0w\xf6bZc\xe4\x8d\x05\x1b{\x18\x04\xbb\xff\x89\x9e\xda\xabx\x0bFC\xef}\xbe\xf7\x88\xf7cz\xbd\x15\x1e\xf1>\xcf\x85\xc8w\x1e\xf1\xbef\x1b\xe1E$e\xf4H\xb0\xef\\\xa7\xca\x9c\xc3Zk\x16\xd7\xbf\xe9\x93\xa9\x0bW\x0f\xeb|\xb7\xcfK\x96\x80\xb1_\n\xac\xd7\x8fy\xae\xc3\xe7\xa0\xff\xa2Z\x1d\x88\xc9T\x82b\xa6\"\x1au\xf2\xe9)-\x87o\x9d\xf5x\xce\x15\x9b\xc7\xa8\xba\xfb\xab\x14\x0f\x19\xdc\xa2\x06\x97\x89\xd7\xda\xf3\xa4\x93\xea\xfb9\x03\x01\xd0\x96\xbe\x99\xae\x01\x11y:\x87\x87\x1bR\xf5\xef\xe9\xed8\xc3\xd1C\xb3\xb0\xbe\xb6\x02\xc7a\x1e\x99f\xc2<\"\xed#\x15a\x1e\xb5Y%\xee6\xf7\xb9\x02\x01\xc1D`'\xb7\xac\xa8\x15\xe7\x9c3#6\xd4\x89g3R\xd2\xe284\xe9Y1]W\x05r\xa3\xc7\xbbK\xa2i\x1b\x98!TTBq\x06\x07l\x10V\x16A\x06u\xcb~(\xa2\xa5\xe7\x05\xde\xfe\xde\xc3\x10\xb3\xb1e\xb6z\xd9\xeaZf\x1aQ\x9a\xf9\xfe\xb8\x92\x1b\x88)+b\xdb\x9cr\xbe]\xfb\xfe:|\x1e\xc9\x8c\xf8P\x9d\xd3g$\xa3Y]\xcb4\xb2\xa6\xe3\xaa\xae\xe7\xce.\xbdQ\x13\x03\xbd]\x8f3L\xd0|\x92\xe3\xa7h>A\xb9\xec\xf7yU\xd7\xd3O0\xbe\xa03pm\x9ea\xb2>\xa7\xf9b\xfd\x94>#G\x85\xb5\x17\xa2\xe1\xc9$\x0b\xb0\xa6\xe3u]\xcbfg\x92\x14\x87\xf3h\xb9\x1e#\xf9;\x9e\xe3\xa7<|\x16\x05c\x0e\xc7\n\x12\x13L+\x9e\n\x9a\x91bZ\x8a\xb8\x10tM\x8a)\xe3	M1&)(E\xd6\x0c\x00\xc3\xac\x9a\xb9i\xd2:\x9e\xf4<\xbeC9\xe8\x19\xd9\xb4\xa1\xe5\xd6\x17\x9b\xc5z<\xc6H\xca\xb5\xebH\xc3\xb3\xf1\xbcv\xa1\x98\x88%\xb2\x00,\xc7\x92\x85\xebH#\xb5\xc2\x81\xe4\xba\x06\x8eL~\xadk\xd4\xab\x84\xc2\xddi\xb0I\x8a\xfe&)\x19X@\xa9zQEc\x9a\x9b\xd3\xacX\x8aU\x05\xee\xed\xe8\x92\xa6\xd6\xaf\x85\xa0\x8a\xae!lH]\xa3\x9c\xc6\xd3\xab<y\xe8\xdc\x90\x12\xf7\xbc\xebJ\x8cI\xa5wb\xee\xf4\x9f\xe4\xa7\xee\xf6\xcc1\xb1\x13P\xc1\xf5\xbf\xdeU\x96\xafo$\xac\xca\xa6i\x851\xc6\x81\xca3r&I\xa5\x10\xc5\xb6;s\xa5\xd4nr\xb1\xd6tf\x97B\x9dL\xc9\x82\x10\xc7b\x1d\xf5\xa6P~9e\xcd\\n\xf3\xbb\x81=\xb8\xd1D\x16\xd8\xe4m\x9a\x0cZ[\xa8<\xb8!\"\xbf\xbe\xce\x86\x08\xb0w\x95\xe7\x19\x8b\xdds\xd8\xa5\xb6>\x95\x0d#m\xc8.\x1b0\xcf}\xaa_\xeaV\x967\xea\xd7\x144\xaf\xaalcI\xdb\x9e)\xcd\x80\x89\xc1TCT&\xb8g=a\xf4\xbc\xeb\xa5\xd4sR:O\xc9V\x16\x7fR\x7f\xd8\xe5I\x95\xb1'\xf5\xea\x1c-\x83\xdf\xe2\xdb\xb8f\xeb]\x8c\xcbu\x91\xee\xc5yJ\xae\x19=\xa8\xb0nA8'\x9e\xb9\x84hWe\"\xddg\x8c~d\x9e>z\xe1\x11\xaf\xbd~(\"b\xcb\xe2D\x15\x02\x17R\xf5]?Fd\x9dgA\xf8\xcc~\xbcX\xe7\xd9u\x91W{\x95\xcd\xbe9%D\xd1) $ \xebJ\xe1\xd1\xcd\x9a\x04\xe1\xf3~\xd6\x0bQ\xe8\xec\xc5\x8b\x812\xbfj\x17\xc5 \x9c\x11\xcf#\x9e\x179\xf8\xe3\xd6\xbd[\xd6*\x16N\xdfzw|\xad\xda\xa9k\xa4!\x9c\x0d\x0e\x86k\xea_0\xb4\x1c\xb8s\xc8\xd4\x10F\xa4\xa7\xfbSnoKk\x0c\xca@S\xcd\xbb\x17\x87\xf6\"\x9d9\xa1Zl@\x11\xb5=Y\xc8#\xe2]g\xf9U\x9c\xbd\xbe\x8d3\x0f\x1c\x89\x15\x9a\x13\xfdo\x187\xd7l\x9a\xef\x05\xac\"U\xcfi\xce\xc95\x9b\xc2\xcc\xcb$\xb1\xc9s!\x1f\xccj\xc3s\xac\x8e\xb8\xae\xc1\x8a!N\xa0\xc4\x16^\x13\x00\xfc\x1d#\xf7\x8c\\I\x18\xaf\xfd\xbf-Ww\xe3\xc5y\xbbPw\xa7\",9\x97\xa8\x0b\x8d\xf0\x0c\xbe|S\xc4\xd7\x80\xf9\xb0\x8a\xae\x93\xd0\x19\xd9\xb6\xd3\x90\\l\x17\x89\xf2Y\x80\x08L\x89D\xa53\xb8s\xa5\x7f\xf2\x91clf{Or'DQ\x1e\x05y{\xe0qe|\xc11>\x80\x85\xe4\xa6{\xd9V\xdf\xdd9Io\xd55J(\xd1\xc4<\xc7u\x1d*(\xc5\xc7\xb1\xee+z-\xd1n]_\xb3\xa9\x01k\x12;~\xc3\x95\xa4\xab7\xd3\xad\xd8e\xdf\x17L\x1b\xe6\xe6x\\I\n\xbb\xa6U83a\x8b\xd7\x93	\x8ei\xdc\x86R^\xb4\x03\x8c;\xf7\xec\xc3\x15\xec\x8e\xed\xb3\x1b\xa8\x94z\x9e\x8e3\xa4\x0d\xdd\xf4\x08\xdf\xb3{\xc5q\xe6\x12\xdd\xf7J\xc8u\xb0\x17(\xee\xc3D\xc7\x93( \xb4QkT\x98\x93\x02\xe3\xd4\x86Kr\xa69\xa3\xb9\xac\x99\xc4\xf4\x96\xa1\xee\x14K\x8a\xa5p\x9c\x07\x07L\x0f\x0c\xae\x82\xc7\x87\xb5\xd3f\x1c\xaee\x9b[\xb3Z&r\xa2\x87\xad/jn\x15K\x9bf'\x99\xfcS\x90\xd5i\xfd\xf5\x89\x05F\xf7m\x15\xfd\x0b\xdb\xfa\x17\xb0	u_\x1b`}\x0f\x93\xfb\xfe\xdd\xeb\xe6\xd2:\xe2\xb5\xd7\xd7\x1de\xd2\xb7\xb8\xc9I\xd8u/|\xbbg\x98<\xa8\xd0\x9e\x97Y\xce\x19\xdd\xb1\xe9Z>\xc0r\x8df\xb8\xf7f\xe1\xc3\x84\x03\x95\x15\xba\x8e\xearm\xe3\x82\xc5/\xee/\xce\xed\xb3G\x1e\xa6<\x87\x06.U1:\x1a\x1d\xb5\xd4\xd6\xedz\x91+\xeffI\xc6n\xd8\xc39\xb9\xd4\xf4p\x97W%\xab\xf7y\xca\x05+\xea\xb5r\x05\xde1^\xd5I\x11_\xd7I\x91\xefq\xbd\xce\xd2\xf5\xcd9y\x0de\xc2\x0f\xd3\xe8)\x96\x12\xe2\x14M\xc7\xb8\xc6\x0e:\xb9a\xee-\x036\xf9\x9d\x93\xec\xdc\xed\xfd-sE\"\xf0\xb8u(\xbcsA\xfd\xebn\xa8WG\x9d\xdd L)\xf2 P,h\x1a\x9c\x83\xc1\x97\x0en3Q\xaacRv\xcf_\xed\x89\xd5\xc1\x9c\xc1\x1e;\xfb\x82\x1e\xb5\xa8kN\xb8\x95\xcd\x05\x86\xeaK\xa8^\x84eDr\x87\x97J7\xda\xa7\xa6Pqb(M\x97(\x05\x8b\x15[E\xa0?\xf8\xfe\xf1\x01\x1a\x97\xb9\x0bR\xd8\xbc\xfa\xd5\xe9\x81\x8e4\x9e\xe2\x94\xbecv\x0f\x8f\xda\xabX\xac\xe7\xaaD\xbd e\xa4\x04\xa5\x83\xc2.\xc2\xd3|\xb3AL_\x06xl\xab\xd8\xe0\xe9u\x95&4\x86\x1f\x88\xa0\x07\xef7\xf03\x1e\xc3\x0d\x16\xc7\x8a\x19v\xcb\xb8P\xa6FJQ\x94\x92\x02\xf4\xc2\xed\"\xbd\x92\xb3\x08\xcb\x93/\x91\xa6\x9c$%\xa39hOmy\x99v\xb0Wh\x07\xa39\xd9\xc2\xe5/\xc5\xf1\x81\x12\xb8\x1dk:\x0b\xb6< \xbf\xcd}6M\xcb\xf7Ez}\xcd\n\xed\x9d\x95*\xbfN\x13*\x04#\xd3\"\x845\x88\xb30\x8d\x94GK\xc22v-\xf1\xaf\xb9\x00_\xe4\xfb\xef\x8b|\x1f_\xc7j\xacv\xfe\x8b\x013\xa4\x1fZ-t\xaaN\xe3s\xd33\xa2\xfb\x810)F\x94Z\xe5\x8e\xfe\x8c\xebZ,;\xc5\xe1\x02lzh \xbb\x138C\xf6\xe8\xedn\xc7\x924\x16\xac\xd35\xc2\xc0\x83\x8bq\xf1Ja\x03\x84\x89\xbe6W\xd1\x986R\n\xea4\xa5\x8f\x88\xcd\x9c\x085u\xc8\xea\xb4\x8bp\x16\x91\x9b)XH\xb4g\xdb\x98\x146\xcc\xb3q\xe5$\x8f\xf5Or\xe8\x8e\xee\xd1hZSp\x82\xed\x02\xc0\x0d\xc3\x8dN\xa3\x07\xc5=\x05\x87\x9e\xd3\x9c l R\x94\xe2d\xc8\x9e\xe8X\x8ef\x9a\x014n\xf1\x81O58\x81\xc0\x8ar\xca\xb1I!)\xcdm\xd4\nPY\x9f\xf0RLe\xa7	7;\x84\xf7v\x88\x14,oU\xe7K)[\xb6o\x10\xf0\x13\xc5\xf4V7\xa9L9\xcc\xdb\xc0\x86\x1db~o\xda\xe9\xd2+\x057\xf8\xab\xf0\xc2K\xf3I\x8a~\x10\xf8\xc1\xc4\"t,\xd9\xd4\x124\x98d\x141\x8a\x14\xe5v\x0f\x91%\xf3\xd4=\x8c\xca&\x13\x9c\xd0k\x8aJ\xfaZsZ,\xcc\"\x1d\x8ch\x1e\x91-Ee\xf8L\xdf`\xa4#\xe2LMH\x1cL\x12\xdfG\x1b\xda\xdfu	\xec:\x92P\x94.7\x9d\xbd\x17l\xa6W)O\xe0\x0c\xb6\xae\x13r\xb2\xec\x9a\xb6G/r\x02\x82\x84\xe4Ez\x0du\\\xab\xe3\xc9\xc2b\x10N\xe4\x12\x05j\xc1\x88Y\xea %nt\x8c\x00\x16\xfeD\xe8\x0cs\xf0JZ\x0c\xb5\xd5\xe1~\xa6\x1e\x9cG\x10\xb4\xa7\x95b\x85\x91~\xa4a\xd4b\x96\xcb\xbc\xe2\x82\xce\xc8F\xee\xc0j\xef\xfb\xa3\xf9\x88R\xfd\xa6\xfdMIA\xb6$\x968\xe1\xc82\xc9\xf7\x8f\xd3PBb\x8c\xc9F~\x90\xf3,\x7fMMkL\xd6\x06\xc0\x0d\xc0v\x13\xa8\x9a\x0e\x8cI\xda\x86\xb8\xdcw\xfb;\x1e\x93\x19Y\xe3@s\xa9\xeb\x16e\xab\xcd)\x079\x9a\xe1f\xd0\x0e\xe2\xaf\xed\xd2\xf6T\xd9\xf75jP{\x16\xd4&v?\xe1CF\x91>;\xfb+@\x9bnP\x0fn\xc5\x7f\x04\xb7\xf8p\x12\xf4`q)*\x1e\x07]\xb8:\x84\x94\xb4\x84\xfb\xbbz7$\xadVS\xec\x8d\x0d\x04\xadVS\xb4\x0c\xa6OW\xabi\x8d=<\xf6\x90|z\x82=\xc9\xa9\x0f\x065]CLS2J}\xffzD\xe9zj`\xbf\xae\xc1\x1dA.,\xa4\xab\x95/}\xdf\\\xe5\xb8\x9eZ\x08\xc6u]\xf8~\x01\xf9J{\x0d$\xf2\x9e>\x05_\xc6\xba\x1e\xb5\xe9\x12\xaa-\x90\xe4d.\xa1\xab-\xd3\x83\x9a\xc9\x84l\xb4:\xcc\xf7\xcd\x939\xd4[c\xbc\x88}\x7f\xb4o\x89\x92\x94s\xe2\"\xc9\xef\xb8\xdd\x15&\xc1\x94\xda\x12\x07w\xde\xb8\x16\x97\x88\x91\xa4\xfdh\x8e\x12a'6\xd62)9K\xf9Y\x85\xcd\x82\xda\x03\xbad,\xa1\x02\x00u4\xc3\x8b\xbe\x11J\x050is{\xaa\x913\x05\x92\x9e\x04{\x83n\x87\x99\x14\xa3Q\xb5\x90\xb4I\xef%\x0fV\xc1\xa1\x8a\x92\xd6\xfa'w\x80\x9d\x1d\x1e\xc13\xad\x01\xa3\x12\x96*\xec\x1a\x00\xd7\xfa\x08B\xedW}\x06 \xe5VZ\x12A\xe7\x0bqt\x1e\x0e\xd1P\xaaPD\xad\xe9\xb5\xbe|\xb5l\x01\x1b.\x84P\xa7`\xa3\xb5\xe43^\xe9\x11\xd75,U'\xcdq\x9b/A\x927\xfd\xd3x\xa7t3\x90L\xc5e\xd1\xc1\x18R\x1a\x87BJ\x97\x00\xabi\xe9\xb0\x10\xefD\xbe\xdf\xb3\x04a|(\xa7\xeb\xaa(\x18\x17\xbac\xe9\x94elGx[ONS\xdb\\\xc8\x9d\n\x87\x98\x93\xb6\xe6rZ\xd8\x8d\xa1\xa10\x9f\xba)n\x06#\xfe\xba[	\x95\xba\xd9\xef\xae~\xa39)\xa7\x92\x06\xd1\x1c~Z\xb31TPt\xc4\x84\xe6v\xfbjnTUT\xd7\xb9\x19	\xd6\xe4\\\x0f\xb7\x82\xfb.$\xdb\x8f\xcai\xc1\xca*\x13\x14\x14\xe2\xe51'X\x1e\xf3\xb2\xd8\xca\xe8\xeb\xe9>/\x85Y>\x08/\xe9\xbcw\x96\x93\x98\x96\xc0\xd4K\xcd\xef\xf01*\xe9\xc4\xbd\x16]\xdc\x00\xd1\xe3\xd55#\x12\xd2*\xdf\xcf\\;\x19\xe4\x81$\xea\xde\x9b0\xbf\xa0l\xaa\xeef\xd0\xc6\xaa\xd9\x88\xaa8C\x19\xcd:\xbe\xe9\xc0\x8d\xea\xc0\\n\xb5\xa6\xd6Q{\xdb\xc3H\xaeFfoB\xc7:>\xad\n\x99qh\x88\xba\xe3\xa0\x02u\x9fe]\xe30\x95+\x08\xe1O-\xfa3\x17\xe6\xc6a\x1a\xd1\xa2\xc35\x80\xb3'R\x91V\xb4\xf1\x0e\xca\xb0\x8e\xcc\xa4\xd3\xf55\xb6\x99%b\x98\xc4p\xefhn\xaf!\xc8-\xaa\xd4\xee\xb1\x07	\x05A\xd6\xaeC\xde\xd8%\xcd\xd4f\xad.\xc4\x9f\x962\x1e\x97\x95\xe4\xe0K`\xb3%\x9ctXm|\x18\xb66=\x12\x0b\x88 \x07\xc6\xab\x1d3\x86\xa6}\xc3S0\x00\x85p1\x8e\x04i\x8c\xa8\xe4\x06Hy\x9cA\xa5V\xe8\x19\xfa\xd698|\xb4\xf8\xf1\x97PD}\xc3\xd7S\xe3\xd3\xe2\xec\x9f\x0c\xe9\xaeH\x85yV\xc2\x94\xba+\xa2!\x9bt8\x06Ih\xed\x87\xa3%\x0b$\x19\xd03	fu\x1a#\x04\x87,\x8f\x93\xe0\xc0\xf3\xcf\xab+m\xb6K\x00\x84\x83\x03\xf0\x8b\x03F\x96\xb2\xc3umu\x02{\x8d\xa4\x84	\xad)\xa6P\x81\xef\xbfD\x82\x18\x1d\x9a\xef\xbf\x82(\x8cj{H\xf1\x8b\x8c\xe6\x0d\xd1\xf2\xc5\xff?\xad`\"Gc\x0f\x17\x8e\xdb\xb0\xf8\xe1?lD\x13M\xdbN]\xc3\xf7\x18\x08\xf5\x15\xdb\xe4\x05\xab\xb8\x9aX\x17\xcbu{`\x105\xd3\xd8\xce\xf7Y\x17\x86\xc0J\xb6\x932U\xfd\x04\x1d\x9c-\x87\x9b\xa6Q~*\x96S9\xb2\xcagC\x9e#\xb2\xc1!\x87\x12e\xf4\xa8\x81\xa5gq\xa0B\xc9n\xd3\xb2\x13\xbdJem\xc3\xc5\xba\xb0F\x04x\xa7j\xe1q`\x13\x99pO`\xcd\xae\xb2\xa9\x84\xb4\xd4\xb4\xe5{EiXBm0K\x9bT\xd7\xad\xdd\xdf\xd1GM\xba\x98;o\xcb\x1b\x16\xbc3M*\xd2n\xc0\xc0\xf7\x9f+j\x00o\x8e\x81\xb2Ii)@`\xd2TE]V\x81u\xdfU\x16\x08h\xcb\x12\x9b\xa5\xf3\xae\x0f&\xd5\x14\x10\xa1\xc4C\x109\x85\xb6~R\xdf\xd3\x1d{'\xe2\xdd\x9e\xaa\x195\xafu\xfd*\x16l\xca\xf3;\xa4\xd5@\xed\xde\xa7r\x0f\x1c\xe1Pzp\x82\x94\x05\xfa39\x9eq9UC\x1c\x92J\x7f\x84\xd1Q\x19\xde\xa5\xbb\n\x86\x19\x8c\xe6\xa4\xcb0\xb8\xc8U\x19\x82\x1d\x83\xc6\xe2\x14\x1c\xdc0\"i\xb8\xfel[\x81\x1b\xaczlICz\\\xc9\x7f\xd2\xf0\xf1\xb8\x1ek\xfa\x88\xfdQm\x0f\xcd\xd2\x7f\xd2\x89Gf\xf9\xcfzsB\x8d\x07\xd9\x8f{k\xe3\x93\x1c\xe2L\xfc\x9d=HZs\x05d\x01\x82\x9d\xad\xe5v\xcf,\x81\xda\xc6\xfc\x9a%\xef\xf3\n\x82\xcd\xcb\x14Qd\xbaT\xc2D\x9cf\xf2	\x16\xe3\xfbm\\B\xa1\x1d\x13\xb1\xce\xb2\x8f\xaf\xd9/\xe6\xe1\x9f\xf2\x01\xec5\xf5\xd7\xdb\x94\xdd\xc9_o\xbd\x8d\x0bO\x11\xc4\xc4\xb4[\\\xea\xe7\x1b\x95\xf9\x86=\x98\x14}e\x97}R\x1d\xcbR\xc6\xc5/\xed#4\x97o6%S\xa9\xea\x11R\xf5\xe9\xc5\xdb\xc4y\x01\x99[vp]0\xc6\x7fi\x1f\xa1\x84\xc2\x03\xce<\x88\\\x9f-\xa8\x17\x9b~\xb7M\x87\xc47\xcbs\xda\xb3m\x1d\x8a\x17\xf2\xfb\xfe{\x13/Z\x11\xa5\xa5v\x12\x9e\x9a\x89X\xb6\x8f\x01\x9b\xda\xb9\xb0\xe5]\xe7\x87\xcb^]s_,\xe7\xc13_,\x9f\x07\x1f\xfbb\xf9,\x98\x05\xba\xa0\x82\x86[\xad3\x95\x80\xd2F\xe9Rw\xe9\xa9\x93\x92\x94{\xe4*\xab\n\xfd\x9aW\xc2kz\x9e\xc9})\x84E\xb4\xcf\\X\xb6\xe5\x951\xbd$\xdf\x9eb\x11\x8e\xf3*B\xdfQ\x91\x087\xaa\x18\x1cLI\xccQ\x04\x1e<\xe7\xb7\xac\xf0\x08<f,\xbee&\xb9\x12\x9eYt\x9d]\xbf\xa9\x02\xfaE\x171\x9f\xfa#N\x87G\xdc\xe9^J\x8c\x06'H5\x9b<$\xd8\xf7\xe9\x04\x98\x08X\xe1\xcf\x0d\xea\xca)\xd5,S?\xb8\x1f\x87{#\xd4\x8a\xd3\xc2\n\x80D\xd0\xc2j\xe9\x06\x0fj\x88.\x93b\"\x9a#s\xe2\xce\xc5x\xc6*Ow\xe8\xa5]D\x95\xde\x90\x9c\x1fk\xecNe'sY`\xb3	\xfa\\\xccm\xebP\xc3\x8eQ\xbdL\xb1ScX\x91\xce|\x91\x1b\xc4z\xfa\x06uXU\xb4\xf2\xf5\xb2\x9d\xa1\xb17\xf5\xc6\xce\xa7\xc0\x99\xbc\xc2\ne\xc4\xce\xa2\xc2\xab\x83G\x82\xfaV\x9e\xf4,\xe5g\x0c+T\xbf\xd9H\xb9\x8c\xb00\x1d\x0c\xad	\x8a\x01\xe1\xfbm\xa0\x89\xd6,\x1b\xee\x01\x11D\xd8\x13D`s\x14\x18\xbc\xd3\xe1\x05O\x1e\xa1u\x9c\x0b\x08W\x96\xd2\xda\xb2\xe970_Z\x8e\xe2\x82\xc5\xf5UQ\xaf\xf3\xacf\xbb+\x96\xd4\xdb\xa2Nw\xd75\xb0\xc0u\x96\xf2\x9bZ\xe2\xf2z\x1f\x17\xf1\x0e\xa3\xc7,\x9e \xe2+^\x9d\xbf8\xbfN\xc9\xef\xb2\x01u\xfa__\x80	Y}!k;O\xc9\xd7\x8c\x9e\xeb\x03\xecU\xf9\x14-\x83\xf0\x03\x8dj\xba*\x9f\x9as\xed)>O\xc9\x97\x8c\x9e\x7fX\x95O/Fh\x19\xac\xc2\xcbW/\xdf\xbf\\\x85\xf5d\x82k\x99\x10\xad\"\xf9\xfcbU>}\xe2\xbae}\xd7=\x1fVq\xdc$-\x93\xfc\xfcKt\x1cGP\xb8\xb1\xe7<Q\xc8|\x10A\xd8\xc4\xa0C\x1eX\xd3x8\x9cEu\xed\x04/\xfb\x9eu\xbcHa\x0f!\x85\xb5O]\xc88\xf6\xce\xbd\xb1\xe6}\x9d\x9a~tj\x02g\xaese\xe6\xdbZC\xd8;\x83>\xc1K\xe6\xb2\xd0\xd6O'0L\xfeQ\xabnK\xdf\xb0A\xc5\n\xa9H\xb6\xd0j\x0e\xd1\x8d?\xde\xd5\xa7\xa3\xdcq\x19\xc1$\xa6\xfa\xf8\x8f\xe4\x98d4\xb7\xbau\xbb\x0b\xb4\xf64\xd6\x9b\x87\xc4\xed\x01\x16\xc9tT\xd0\x19)h\x16\xa6Q\xdf(\xaas\x18LR\"\xf3\x84<\xc2\x8b\xaf\xba}*\xe9WN\x9f*\xea\x06S+1\xd1\x1e=\xa4\xc2\xd8\xb9\x99\xee-C\xf6\x94\xbf\xa0\xd7\x1a5\x86\x91	\xbf&q\x91=a\x00\xbbU\xeb\xc7\xb2\xa7\x9b\xc9\x9c$\x14N3\xb7t\x87\x128_\xd8\xd6\xf5\xfcb3\xe0\x0f*\xe5\x13\xd7\xd0\xc3\xf7\xbf\xd6\xf49ie\xa9\xde>v\xee\xc9e\xbf#\x86\x17[\xdf\x87\xe3S\x9a\xb8\xa1D\x89\x00\x0b#\x841&o\x19\xd2.g\xb8\x81\x0em\xc0;\x001z\xc7PAx8\x8bzF\xac\xa39\xe1$\xc7\x9d\xf0\x8bJ\x90j\xad\x8eZ\xa5:\xa3R>\xa9\xeb\\\xab\xb6J\x8ab\x1d\xc0P)\xb3\xad\xb5\xcf\xf7\xcc\xdejb-K+\xca\xc8zD\xe9\x1eNan\x94\xfd	\xaa\xc8h\x067\x86\x95R\x16RVO1\xb9\x95\x1flu\x18\x1b\x07\x1e\x1e\xae#R\x915\x8c\xae\x04\xe8\xc9h\x1c\xc66l\\\x7f\x80\xaaw1\xf9\x91a\xa2\xcc\\K\xdd\x99XVe\xcc\x8e*\xd7\xechd\x01\xbc\xea\xda\xdb\xc9\x1eZ\x92\x9b\x91J\x82^5-\x8b\xb5\xef{\xca\xc6\xd3\x1bQ\xeaV\xd6\xbb\x81\xe2f\xfa+\xbb\x8d\xb3\x9f\x8a\xcc\xf7G\xd5\x94\xe7\xdf@)Y\xaf\xf9\xa0*$\x07\x9e\xf35\x0bd\x1e\xbefu]\xf5\xd0	${\xb8\xc1\xc1\x95l\xaf\xb5\xe9\xb2~\xa5_2\xb8\x8c\xa2\"Y\xab\x9bu\xcc\x12\x7f\xb6\xde\xab\xf6\x8a@\x92R\xb1tBH2\x1c0\x92\xd3\xd9B_ZT\xa8K\xf8\x16\xf9x\x8cy]\xcfGn\xf8x`G2\x16s\xd8\x95\xb7\xe0\x9eJ\x8a\xce\xa5\xc3\x88\x83/B\xa1\xaeC\x91Y\x9c%\xee\xe4\xed\x18>\x17\xb8col\xbdL]\xbb\xbaA\xbd\x98\x9d\x8a\xdf\x18\xf1.\x9e\xcc_\\\x9c?y\xf6\xc2SA\x8f\x8f\xd8\x14\xcbu\x10\xe7`\x91\xf6\xcc\xa4\xc8\x86\xe6\xc6\x81}\x84\xfavUjN\\\xbf\x81y7A\xf9H\xab\x0b'\x10\xc3\nK\x82\xe5\xdc\x1a\xebh\xcb(\x97\xaf\xce\xf6\xb1W5\xd2<,\"\"\x01\xb70V\xa8\xa4\xbd\x1d\x04e\xb4:q\xcf\x02\xf6}\xa3\xef*\xb58P\x19JKK\xf3\x14\xe8\xba\x94W\x83g\xcc\xc7\xe0\xbd\xaeQ\xd5\xb1\x0b\xa3e\xe7\x15\xe6C@\xf0f\xact\xdey]\xc30\x08\x98^\xba\x03\xcc\xfb\x03\xfb\x86!\x18Y\x0c\xa1\xc7\xe1|\x0d\x88\xd4\xda.\xfb\xecBO\x92\x03/\x16+=H|1\xda\xf8~\x17\x05\xc1mP\x16 ;\xf0a\x00^\x9f\xa7\xf5O\xbc\x00\xe4\xdb\xd3\x15N\x99\x85\xfat\x83\xbe@\x1c\xeb\xc0\xee<\xfc\xa1\xf5V\x87$C\xfd\xda\xc8\x05&%\x0d\x8bh\xd9c\xcc$g\x1ct\x0f\x1e\xc1.\xcc\x1c<.\xdc\x064\x07\xd8\xf0\xf0+'z\x01r_\x0d\x93x\xcc\xc2'p-\xd0\xd0&\xf9\xd9\xf2\x87\x8e\x13\xeb\xa3\x19\xf1\xc0E\xd6:\xcf\x80\xdf\xae\xfe\xd2\xaa\xee\x96\xea\x0e\x16ep\xaf\xad\xf4\xe1\x8ao|\xc4\xc7\xce\xf5\x99L\x7f?\xf5\xd2>\xeb'\xd5\xb5R>\xba\x96\xae\x1d?\xde\xa3\xf3\xd1\x86(\xb3\xcc\x01\xd1\xf3-\xebIK\x9d\xe1\xfd\xf7]\xfc\xce\xceg\xc7$T\xf5s_\xb0\xff\xaa;\x86\x81\xeb65\x1fH\xfb\xac\x9fd\xb8\x8d\xb6_\x0b1My\xc9\n\xf19\xe8\xb9%\x96\xec\x84]\x96\x1dU*\xf0\xff\xb8\x9f\xd0\xb2K\x1az	G\x0d+\x93\xb0\x86\xc4\x1b1\xa8 \xf8\xdfo\xaesW\x8el\xfa(\x8c\xaa\x0d\xb9\x0fG\xce\x9aF*\xf5^(\"\x0cG\xe1\xfdKvP\x8fH2\xb8\xd5C\xca\xdf\x1d\xab\xec\xae\x988@\xae\x1c\xf3W\xad%\xf2}\xa6#\xf6P*\x96,\xd0\xcah\xc9\xfd\x1cO\x97a\xbe\x0c\x0b	\x03\x94\x04\xf5/\xee\xea\xf6\xe0\x06D\"u\xb6\xd9\xbd\xb7\xa5\x13\xda\x80\xa9`\x18\x8e`a\xc6\xd7\x9a/\x0f\xc6\x84a\xbe?\xfa\xdd\xb9Fmt\xcdBk\x9f\xcf\x1e\xb3\xcf\x8f\xf0\x81\xd1\xbe\xf5\xbd\x04\xea\xe2\x01V\xce\xca\x18p\xe4\xad\x07\xc3\xf5a\xf9\xe9\x05\x13j\xc1\x9c~SY)\x9d\xb9\xe6\xc5BC\x98Alj\x83\xc3i\xdci\x0c\xa4\x99\x95n\x94[\xe3`\x1eZ7\xc9\xc7!\xbd]\x187\x8a\xbe\x13jX\xe9\x89.f\x03#\x83\xabo\xd4\xcd#\xba/\x1a%\xa9\xed';\xdf\x89\xe0\xafF\xf5>\x0f<\xf5\xe4\x19\xb4%\x93\xf4\xa3G\xdc\xad\x15x\n_\x98\xd4\x97\xb0\x9b=\xd8\xd4\x9e\x99\x80\x97Y\x16x\xcedtUn\xb1\x8e\xa6\xce\"z\x82\xa4S)\xc1Q\x88G\x95\xda\x0b\xfd&s\xa0\xea\xf9\x05M\x81\x90\x0b\x9aC<\x14a]\xd6\xd5\x86\x90,\xde\x0d*$\xc1\x0f\xe3\x08	L*\x1bdT\xa8k\x12:{s0>\xfa\x93\xbe\x83\xb3qj^\x8e\xf6\xf7Xy6\x8f\x8dc\xf3\x9b\xae\xad\xa6Q\x14w\xa4\x18\xcbk\xa5\xec\xce\xb9\x12AL\xf3=\xe3\xac\x808O\x97Xu\xf02\xdf\xed+\xc1\x92w\xca\x1b\x147\xe4\xf3Nw\n\xa6-\xc4j\x0fC\x0fZ\xcd\xc9\xaf\xfd\x806\xda\xe6B;\xec\xea\x96\xd5m<o\x80G\xf5}\xe4\x81\xc8\x13S.\x1b7G\xdd\xc0\x14B\xf0m.Qa]\x03\xd3\x0c\x16\xaa\x8e\x9f*\xc6d\xf40\xdd\xa7\xf7,\xfb<\xbf\x87\x0e\x97\x08\xfb\xfe\x13\xbd\xe1c\xec\xfb\x9f\x9b\x83Z\x15\x97\xa4\x9c\xc2\x95\x80\x10\xb8q\x97\xf2\x9f\xe1%\x97/\xf1\xbdzi\xd3\x9dTS\x8e\xc6D\xf6\xf4N\xe7Ti\x85[&%N\xa9\x1c;\xd1\x99\xe2e<\xf6\xbc\xc0\xb9\xe5\xfd\x8f\x8e\xa6\xe9\xd0	F\xa5\x0eP\xdb\xdb\xf9\x14G\x02vO\xf8Dd_\xad(1\x19\x9b\xa6\x19\xb9\xd4\xc6\xb4\xcaT\xdd\x15>\x94\xda5s]\x96\xef\xd9\xbd\xa0\xde^\xdf\xe5\x18\xc4W\x10\xda\x96-2\xb6\x11\xc1d.\xff\xdb\xdf/`\xbc\xc1\xa7\xb3\xfd\xfdb\x17\x17\xd7)\x9f\x88|\x1f\xc8/\xfb8IR~\x1d\xcc\x16Wy\x91\xb0\"\x98y\xa4:Y\xbd\xb9\xb2s\xa1\x9dB\x03pD]\\\xe5\xf7\x932\xfdC\xd6\xa3j\x99\\\xe5\xf7\x8b\xfc\x96\x15\x9b,\xbf\x0bJ\x88\xb0\xa7[\x0e\xe2J\xe4\xa61\xb7\x07n?\xffg\x01\xfd\xfb\x1f\x8f\xa4]\xc7\x99\xb2\xcb4UZaC/\x8fw@\x85\x17\x9cz\xf3\xff\xd1\x86:\xf9\x9e\xc4t\xfeL\x12#\x08\xce/\xfb\xf25\xdb\xc0FW\xc3-\xd2\xeb\xad\xa0\xde\xa7\xb3\xff\xf1HN\x9f\x7f\xaa\xb3B\xb2\x94al\n\xf4\xb2-gf\x87zf\xf6=\x92\x9a\xa6\xaa\xa9:y\x02\xb8:\x7f\x8e\xe5\x80\\\x19\xb7\x04\x03\xbe*\xcb\x1c\xfd\x94ph\xb0\xbaq8\xafx\x82 B\xd3\x9b,\x8f\xe1\x8a\x9d\xa6o 5\xec\xf6D\xaa\x13\x1f\x16\x95\xf5\xb96\x03\xb9\x8a\xd77\xd7\xd0\xd6e\x96\xee\xa9\xa7\xef\xbc\x90\x8b)\x81\xa2\xeb64\\\xc4#\x0f@\\\n\x90\x8ea!\xba\xf5PJ\x87\x9bk\xa3\xdf<\x90\xc3U~\xff\x0e\xe0\xe9G\x96\xa5'b\xd33\x84I\xd1\x90.\x169\x91/\xd7\xf9\xcc\x9d\xa7'\xb2qI\x8cU\x83\xdfX\x009\x917n\x88\x82k\xd5\xd7S=L\x9b\x06\xe3\x06)8\xfd\x89\xd1\xd0\xfb\x99]\xdd\xa4\xc2#\xde7\xf9\x1f\x1e\xf1v\xa5\x17\x91_\x06<\xd3`\x95\xd4T\x91\x7ft\xfd\xed\xbf`-\xc5\x00Or\x89~\xcb\x90Eu\xfd\x0f\x16\xb2N @&\xa5\xd1_\xd8\x92\x05\xf0i\x88\xe6\xc0\x9d\xb9n\xb4\xc41k\x9d28\xfd\x89um\x86\xb9\xb2\x88F\x8c\xfe\xc4B\x1e\x8d\x05\x86\x16\xac\xa1U\x03\x18\x9f)\x08\xfd'8\x81\xf1\x9c\xb3\x1at\xf1h9\x9a\xacC\x16Gx:\xc6\xe7\xe4\x07\xf9y29'_1z\xb0h\xc6\xd9H\xb7i\x99^\xa5Y*\x1e\x02o\x9b&	\xe3\x1e1\xc8G\xbb\xc17\xe4\xef\x8c\x1e2&\x04+\xde\xed\xe3\xb5D&\xde\xcc#\x9b\x9c\x8b\x9f\xe1:\xd9\xc0\xfbx6\xf3\x9c	\xfcW\x97\xe0Q\x13\xc5\xa1\x0d}[,a\xe7\xed\xe2{4#E\xf8,\x9a ^\xd73\x8c\xc7\xa8\x80\xf8\x11\x10,\"\x10\xed\xae5q\xd2:\xfec\xd4S7\xd9B@\xc2y0#*\xcc\xe7L]nG)*\x96\x9e\xc2\x85^`\xf6\x89g5\xc33\xb0\xbb]\xc4\x17\x1f/\xe21}\x86=\x85\xb6L$\x84jl#z\xf0q\xc1\xc2\x18\x82\x7f\xa6\x18\x93b\x89lm&\xf3\xa4\x0d\xff\xa1Q\xae\xd7-dj\x1f\x1d\x17\xd0]T\xf9\xc7\xde\xcf\xeav^U\x0eb\x9c?^w\x9b\n\xc1\x0e\xdd\xec\x8f\xd4\x1c\x94\x7f-\x9fY\xb3Q\xe1\xfb\xb3\x0bp^\xab\xc6\xd4Y>x\\\xb34C,\xf4\x14:\xf6\xc6\xe2\x18\xea\x85\x85\xfah\x92O\xaaI9\x99~\x82\xb1\\uR9\xd8Yta\x07\x18\"\x92R4z\x98\x1e\xe1-$9!\xec\xfb^K\x17;\x81Xl\x01\x8f\x8c\xe6\xa4\xc0$\xa7)\x89\xa9f\xc8\nLJ\xfa\xd7z,\x01\xaae\x9fz7%\xc7\x8b\x98z\x92\xeez\xe6R\xbf\xe1\xbe\xfa~Z\xd7*#\xa54\xae\xeb\x91Cs$S\xe6\xa5<KO\xc4\x92Q\x03\x80\x00\xf9\x92\x16\x83\x15\xc6\x8fl-J\xd4\xaa\xecPJ\xff\xf2L\xa0\x9c\x96\xea0\x15\xbc\x11!\xc8\x068>u\xfa$Wgl\xb6^]\xa3th?\x91\x9c\x14$\xc6c\xb9g\xdb\x85\xe4\xc2u,\xd1S%\x19f\xd7\x8a\n.\xadt\xf29z\xe8uY\xaa\xc0`\x87\\b\x1d\xf1\x10\x1c\x8e\xc3\x92\x82zT\xcbr\xb0\xaa\x9e\xcelE}\x88J\xc2\x97\xde\xdc\x0b8\xd8\xf9\xd9x3\xc1!\xe6\xe9\x0eLw\xde\nV\xc0\x03\xd8=+c\x99\xac\xda\xb5\xaf\x9b4\xcb\xbe\xd3\xdd\x90\xaf\x19\xbb\xff\xa2\xc8\xef\xcc\xf3\xbbm\x91\xf2\x1bxk\xb1\xe2hF\xae\x8b4yY\xb0\xd8<_B\xad\xdd\xb7\xd7<\xe9&\xbc\x13qaK\xff\xa8\x1a\xd1\x8fN\xde\x1f\xf3;\x9bQ\x02\xcd\x97\xb6\xd1\xbc\xed\xa7b\x03\xe1a\xbf\x8d\x95-\xcf]\x9a\xe4w\xf0\xf4\xc7[\xb8~O>\xe5\xf9N\x19\xaejb\x17\x1c\x1a\x02\xb4q\xc0\xe6@\x19\x0f<\xefi\xe4\xffo\xef]\x0b7N\x9c!RBPTR\xd1\x1f\xac\xf0\x01&\xe6J\n\x92\xbc7H\\_0\x88\xe2\x12+\xe0\x05\x18\x80\xd0@\xcek\xe9\x84\x8bh\xb7\xa1\xef{\xd7Lx)<\xb6\x8a\xe6\x94\xc6\xda\x8dQm\xa1e\x1ad\xa1\x88\x16\xadR\x84\xa2\xdc\xfa\xfab\x15\x1aOS,\x8e\xe1Z\x919\xa8\x84\xa9\x0e\x03\x94J<\xe2q\x00\"\x0f\x13\xa5(\xe2\xbe\xcf\x15f7_F\x94\xe6u-\xc7\xc4\xc74\x95\xd5\x1c\x05F*\xdb\xc0H\xf8\x98\xb5\xabk\x10\x02%a\x84\xb3u\x1bQ\xb2e\xed<)\xfc\xc9\xd1P/\xe5[V\xa4r;\xca\x89({\x13AA\xe3\x1ek\x97^\xb83\xa2\xaeQ\xb5\xccdJkIM8\x86\xc9\xa1\x1c\xae\xbbX\x1f\x85\xea\xedD\x8e\xd2+j\xe3\xf2\xd9eu\xd7\x11=\xbe\x90\xb8\xb3j\xeeb\xcd\xe0\xae5\xdb}\x15\xb3\xd0\xa2n\x88\xdaS\xec\xe2L\xc73\x14\x12\x99\xfd\x9dA\xa6\xbf\xc3]\xa1* \x11\xafk\xbeD\xb9\x8b\xd6RL \xa2:\xaf\xeb\xb4|#1\x10C9^\xe6u=\x0bR\x1c\xa4\x8e2&\xf4\xd4\x8d\xf9\x1e\xd1\x9cF\xe7\xae\xb1\n\x1f\x9c\xb1T\x11=\xc2P\xfa\xc2y{\xf1\xed?\xf5\x14\x1d\x87\n3q\xcc\x861;|\xfa\\\xaex\xca\xaf\xdb,\x08+ii	T\xb3\x92\xab\x07a\x17\xbf\xea\x04d4\xec\xaa\xc9\xd3\xe0\xc7CJ+\x92\x9b\xd3\xd1\xc3\xb4\xc3\x83K\n\xd6\xf2\x8er\xda\xadpFb\x8a\xf2\xff\x84\x16\xa7\x92\xf6\xf2%\xd3}\"\xb1\xe4Hl\xd4\xdb\x18\xa2E\xa2rB\x07\x99\x8b\xea\x98TW\x0es\xe1.uXEx\xa2[1\xa4K\xb5\x0f\xdc\x07)A\xfd\xd12\xa8r\x00*\x04\x99\xcb\x88\x82!\xb9\x8a\xaeVE`7d\x86UaL\xfe\xc5\xd0\x8c\x08R\x9a\x03\"\x03\x11\x8e\x18L\xff`\xe8az,\xfd\x90\x01r\xa6y\x08g\x14\x8a\xb2\xb5\xb5\xb5\xd02\x08\x12\x19\xdb\x88	\xc0\xc1\xa1-\x13\xcc\x9a\x01\x98x\xbc\x92\x06k\xa2\xee\x18\x02*-\x83\xe7\x11\xa3X\xf0<\xa2\xd5\x0d\x9amt\xda\x01N\xdd\xd9\"\xe98\x8f\xe8A\x9d\xab\x0d\x1f\x1d\xd2\x99\xba\xe7o\xe0\x1a@\xb6d\xc6\xfb\xf3\xcc\xc3\x81\x14\xc4\xc4\xc5\xc7p\x1c\xc0\xc3T\xb2\xae\"\x92\xf5\xab@rE(&\xcf\xe0\xd7\xb9\xbb\xbdi\\6<5\xf1\xe7\xda\xceIlH\xff\x05\xd75t\x0e\xfa\xfa\x88\xf0\xb4\x12\xbf\xa7\xe8\x93c\x89\x95@\xd2\x0f\x17\xaeF\xddr\xb8\xf6F\xe2\xf8\"]\xc4\xe31\xceC\x11\xc6QdaM\x00\xc7/i\x98\x19Pn\x0c\x10,\xb5\xe3K7`\x1d\xdc\x7fh\"\xe75`\x1ex\x1ck\x17\x94\xcf\x08\xddL\xdf\xdf1\xc6)\x17\xf8\x94\x95;\x17Db\xcb#\x92\xa0$2\xa5\x9a\xcf\xd8\xce\xf8#\xec\x8b|O\xb9\xb1\xa5+S~MS\x89\xfd\xd5s\x1b\xdc\x072\xa8\x10K%\x15\xc6\xb6:.\x849I\xbb\xd3Q\xee\xab\xc2\x98^3\x9e\xd0B=B4\xbd\xbcGXyKX\x1b\xb2\xae\x8a\xbe\xe6\x9fQ\xc5\x85\xee5U2\xdd\xb5\xa0\xc24\xceU\xa1\xaf\xf4\xa9\xaa[\xc6v\xbf\xfd\xde\x90\xa2:\xbe-G\x10\xfeg\x8d\xb9\x130M*\xc5\x8a\xea\xfb\x7f\xf3\x92Jl\xa3\xe6,t\xe622\x07j\xfd\x82O\x19\x99\x91\xf9\xf07}2\xacj5\x07r\xf9\x1dEfV'\xed\xec\xe3\xa7b\xdc\xbeu\xeb+\x05\xdb\xeb\xc3\x187\xa95\xa0R\xce\x8f\xa6~u\xd2A\xc0\xdf\xb9db\xc9mh\x8bS\x93j\xbf\xabkS\x1a\x0c\x92\x82\x03\x98\x1d\x7f6\xe4VB\x0f\xd6a\xa9G\x8f\xf5\x82\xd8X,\xc0\xb2\xca\x8e:\x07\xb8\xc68\\&\x87L\xad\x93	\x1b\xa33+B\xa0\xbf-\xbbY\x03di\x83\x9a\x02\x95N<\x88\x07\xab\x84\xbf\x11\\\x9f \x11r\xdf\xbf\xedf\xba\xb9\x87\x89\xb4\xb5\x1f\xa5 \x86\x83\xc1\x8e\x8f\x1cT\xf6X\xbf\xbf`\xea\x9a\xbb=\xee\xf7\x1d\xf8\xf7\xbb\xc0\"\x90\xce\x00\xe0\xdb\x98\xc1v\x03B\xa7y\x83\xf7r\x8f\xbbK\xa8\x92\x81\xe8\x1d\xfa\xe3\xebuZ\xee1H\xe8X3\x0du\xca\xb8V\x00\x129Hy'\x1e\xb6MjHy'\xa9\xd2\xf1\xb7\xe9'\x13\xc5L\xe4%bO\xe1\xf1\xfb\xb7\xf8\xfc\x99\xe3\xdf\xe6AYO6\xb5\xb9\xa7Gr*\xb1\x8bA\x0f\xea\xc2\x83B\x90T\x90\\\x90X\x90R\xa8\x18N*\xceb]n\xf3\xbbz\x9b&\x0c?9'\x95\xa0\xe7mh\xe0'N\x8c\xa6L |H\x85\xef#\xb0@~=UZ8\xdf\xbf\x9c\x16\xec\xf7\x8a\x95\xe2\xa5\x11R\xdf\x14\xf1\x8e-O\xa4\xa3L\xe0\xa0s\xc9N\xa6\xfb\x0b&\xf6\xb7q\x86\xd5\xabH\xd77\x08;1\x7f$\xb57\xd3w\xea:\xa6\xc2\x18J7\x98\x14\x82\xb6\xbeRm5\x1b\xe5\xa9f\xec_\xc1$\xe9\xa0\x98\xe7\x80)\x0fw\xa1\x94t\x8b\xe2\xe2\xe3E1\xa6\xcf&\x02\xa7\xa1\xa1\xc7c\xc4i\xc1\xc2\xff\x97\xb7\xef\x7fn\xdcF\xf6\xfc\xfd\xfe\n\x0b7\xc7\x02F\xb0,ORW\xef\xa8\xc1ce'\x93/[\x99L^\xc6\x9b\x9d\x94\x86/EK\x90\xcd\x98\"\xb5$$\xdbk\xea\x7f\xbf\xean\x00\x04)z\x92\xb7\xaf\xee~\xb0E\x82 \x00\xe2K\xa3\xbb\xd1\xdd\x9f:\x15\xa9\xca\x97\x9d\x9e\xabLU\x08W\xce\xf3\x99\x95oUn\xcf\x99`\xf3\xec\xda\xb1\xf3\xfa\xa3\xd0\xfe\x8e\xaf\xcd\xcc\xc0\xf6\xa6k\x92A\x96\xa9\x98\xad\xaar\x95\x99\xde#\xf6\x92\xa5\xc0\xfa\xcee\xa6r\xb7\x1fW\xaf3g\xa5D\xa6z\xd6fR\x1a\xa9\xbd2\xb1\xee\x9a\xb06\xbc\x92Agd\xb6;\xd60\xa1\xec\x91y\x10\xa1:\x8cb=#T\xce\xb0\xef]\x94\x04\\\x15G\x10\x9f\x83\x87\xf9\x86gN\xb6\xb8\\x\xcb	U\x9b\xb6\x85\x81\x95&\xd4\xd4\x15D\xcaa\x80\xa7\x85\xdf\x13\xce\xb5\x90\xa5\xba<\xe7\xe6\xa2KD\xb5\x1c5\xbb\xa0\xeei\x86\xf6e.}Y\xa7\xb3z_\xf2\xb2\x8bh\x1d\xc0\xfe\xf1J.\x0bYJ\x10\xc8\xca\xd7\x97Q\x94'&\xe6y\xdb\x9ef\xba\x94\xf3\xd4\xfa\xae\x87\x01\xba\x8bT\xc8\xc9\xa58\xcaB5>L69HWr\x87\xda\x8a\xd0.\x19D\x97\x9d	\xd2&s\xf9dM\xd0\xde\"\x01\x89!\x17]\x9d0#Gi\x84tNdV.\xceu\x13k\x9f\xf8\x9e\xf6\xbb\x18V\xbc\xed\xcc\xd8w\xb7\xeb\xbe\xd8\xf8\x9e\x94\xd4K\xf12\x956n \xdc\x0f\xb8\xc9\x83\x0d\xef\x8f\xcfx%\x0b\xd8V\xc9\x9f\x83.g\xbd/\xc0\xb3\x08\xfb\x80>\xc0\xf7\xbd\x1f-\x8b0 K\xf2\xa1;\xdd\x06\x91\xd5\xd6\xc9`xc\xe4U\xb3\xd01{A\xd6\x94\x93\xf9\xc2\xbc.\x91\xdf\xf6Coh\xe8/;K\xd2\x84\xff\xf9\x81\x95:\x15\"nB4K\x97\xec\xb6}\xb9R\x05n\x04\xd4\x8a\xc9H\xb7\xb9C:\xccP\x92N3\xdf\xf0\\\x99e\xad~\xe1\xa5He\xa50\x00h\x9f\x03\xafH\xd5S-/]\x06U-\xa1\xa1\xa5E\xf3\x02j\xa4*\xe9at\xcat\xa8\xcd\xa8Q}A\xf2\x0cj0\x84oC\xa52k@\xc8+\xd1\x95Q\xa7\xb2\x12\xd4\xc8\xb6\xe5\xb6\xd22\x95\x06\xaerk\xa6i\xa0\xe2\xfc\xc8W\xa3\x83/\xfc\n\xc4S\x8e\x1eU\x81\xb5\x88\xc4\xa9\x90\x95t\xaf{\x1a\xb5\xe5%:-\n\x14|B\x88\x80\x82\xf6z[\x1d>\x10\x98U\xd1\x1b\x18.\x07\xf1*\xbc\x93\x1dY\x80\xaf\xe4\xce\xc8B\xc8-wME\xd61\x8a\xc2[jR\x05\xf9p0	L\xd7\xe6p\xf7\x16S\xd7\xa6\xc2\xb5k\x8e\xc3\xa2\xb3(\xbb6\x15\xae=\xbd\xb4it\xe7w\xbam\x18!m/\x1d\xcd\xc8\xca|\x1b\x17\x92\x80\x0d\xc2O>\n!\x8b\xe3\xdd\xcco\xad\x9d\xc3\xc3\xda\xc8'\xb7K\xc4O\xec%\x8b\x97c+\x98\xe4\x94n\x99\xe33\xbf45/-O%;\x8d\x83\xc45\x9a\x1e\xa5-~@\x190\n\x83\x85\"\xa0\xed)\xd6\xaa\x83\xbf_\x04A\xc1\x91Z\xeb!\x99.\x15\xce\xbap\x97+S\xd5\xbf\xc5\x105\xfd$\x0f\xffa\xc8n\xd2N\xb0\xfeg?c\xdd-7\xee`.\xc7SR\xa7D\x83;\xb9\xa3\xa0\x17k\x90\x8co\x9d\xf6W\xde\xf4\x94\xc7\x0d\x8a\xc4\x07\x1f\x7f\x8em\x1e\x80\x9fb\xf4\xb9h\x01\\\xd2\x90\xb5\xad\x8d\xed\x88\x0b\xb3\x8f{\x82\xc0-b\xb6/1u\x1dE<\xf37j.\x1bX\xa1\x1e\x8eD\x867\xe1\x16\xdb\xbd\xd3\xb6\x0d\x17\x88\x99\xeaR\xa6S\xb9\x1b\xd9\xb2\xc7\xd2\xba\x97\xce\xcfe\x87\xe3\x82m\xb4C\xd6\xb6Y\x1f\x1f\xc5\xc2\xfe9b\x96\xca\xc6\x077\xc3\xad\xdfY\xb6 U\x01\x19\x98\x11\xf3\x89\xba9\x99+\xa5\xf8M\xc2\x80	e1\xa3\x0e\xc4\xf7\xe8z\xa2@\x1c\x9f\x1c\x82\x00\x00\x07\xa0h\xab\xaa4y\xb9\xd7\x8b\x1b5\x99\x1f\xd7@\x8b\x0eQ\x04\x8f@xw\xca\x85Z\x1c\xf3\x0d\xe7{5\x02\x07&P\x1a\xe9\xa7\xaeEg\xbd\xbd\x19bxE\x11/g\xce\xa0E-o\xfd\xb5\xec.?\x06\xd7\xbf\xa6\xd2\x8ez\x81msQ\xd41\xd0|7k:\xe5j\x17\xe2\x9d\xafFp\x1a\xf0\xbdd\xa5\x8a\x98o(\xd64b7\x0e!\x1f\xda\xb6\x90c\xafKzI\x08!yp\x86\xb7j[{wNG\xe9\x90F2\xd9D\x15\xa3\xc8\x11\x9b\xa2\xca0@\x06\x9e\x85\xec\x88$\x06\xb3\xe8\xb6\x8b\x17\x7f\x14\xb6\x0f\nD \xf0Od\xa1|\xb9\xab\x84\xb1x%\x84\xec\xde\xeb\xb7\x08\x08\xba\xef\xd5(\xe2]\x17+o\x0f06\x9b\x83|\xdd\xfb\xe8\xb4\xd5\x8dW\xf8\xe42|\xf2k\xf8\xe4Uz\xc4\x90\xfd\x93K\xb9\x16\xf0\xd1\x87\xc4\xd5\x9c\x97g\x87(\xe27\xea`\xa5\"\x11\x1fB\\%G\x15\xe4\x933Y\x80N\xa9\xa2\x88\xbb\x17\xd4\xe4F\xc8\x9b(\n\x06\xf5\xb4O\xfd\xb4\xbci[;\x902\x8c$\xe6h\x8f\\\x07H\x12\xb5\x84\x85!\xa8\xed;\xc3o\x12X \xf1\\\xd6r'$\x16w\x80\x8f\x81\xd5\xb3\xb7\x1a\x94\x1b4\x06\xd2\xe5\xda\xa7\xd8_5\x17\xe2\x98vTv\xb0\x05\x98\xa4/98\xca\xacE\xdc\x7f\x80,\x9f\xb6\xc6\xa4\xcdN\xeb\xf5I\x1c\x13:T\xd7Qt\xeaa\x9b\xf4\xb9\xe8\xf8\xc9\xed\xbbq\xd9\xb6\x932\x8aL\xdbn\xd1ZXw|\xaev\x9c4=7Q4\xd9\xa2Q\xa1	\xd0\xb67\x0f\xb3j\xb3Ij\xcf\x13\xaby\xdc\x9d\x92\xd9\xfa\xbb\xa7\x08\x92\xe1n\xa0'I\xee\x86\xefi\xc2B\x82\xe4e\x97\x9c\xc6\xe3Y<\x8b\xefN\xecj\"\xc2Q\x84\xf1\xa5j\xbf\x9f\xd8+Dl\x12\xb2\x9eU\xc5Z\xd5\x9e	\x91\xdde\xb8Kl9f\x14Q\x84\xbf\x9d\"\x0cJ\xb0\xf5\x0c\xb0\x91l\xba8\xca\xfa\xd8\xd76o\xb2\xb5\xbe\xaaFN\xdeB}\xa15\xbdn\xb4@\xe2\xe1\x0f\xbe\xe5\xdc\xc1/\xc0T\x03\x91\x12\xb9\x19\xcd\xfd9\xba9RXVq\x94\xf6YW\x95\x8d\xd8j\x0f\xf9\xd4)mG|1\xecQ{\x8e\x18\"y;\xe5\xea\xda\x06\xc4\x0bg\x14\xbc*\x16 \xf9\x85\x11\xf36y\x997\xb7\x8c\x0c\x1b\x80\xd3\xe4\x93\xb9\xf0S'\x9b\xd1s\x95I\xd8\xaa\x08\xaa\x0f{-\x00\xbc\xcb\xacR\x93\xba\xd6>\x97\x99\x18\xca=\xb9\xd4\x9d\xe1\xd0\xa94D\xd5;kQ\xba\x93\x86W\xbe5'\xa1\xa8s\xf4\xda\x05\xc6\x0c6[\x8f\x13eC\xd2\xe5V?J\xcd\xca;d\xabq\xe7r\xea\xb8\xc9\xdc:\x1bL\xf0\xc4\xb8\x07(%a:#S\xdb\xf4b*['\xb1\x1aq\x08\xe1?6=\x8a2^\xa3\xbf\x84\x8f\xac\x88G\xa4\xf5i\xc6\xbd\xe9l\x81\xddK\xa4\xaf)}\xf8\xc1\xf3\xf3\x85(\xe1\x15\xe0_'.R\x82o)>\xc2\xb6\x12W\xc11\x01f\x17\x0dj%\xa4\x06\x12_\xba\xb8\x94F^\n\xb1\x98\xe8(\xaa\x80\xab\xe8-\x8c\\PT.\x18\xf9n\xf827\xfd\xb1w3\xb4Z\xc9,:\xdfg\xbaT\x9a\xb0\xabd\xa9\xcc2sHo\x84\xf3\x9f\xf5z9E\xc75\xdb\xcd\x95*\x93\xb2\x13\x8c\xb1S\xdc\x94\x9c\xcce\x0fx-\xc3\xb1\xad\x81\x00P\xb7\xd2o\xe0S<A\x141\xafV\xd2\xd0\xa7\xf9RS\x9fZ\xbf\xa1(\xc2\x14\xa2@\xf4\x99\x98\xd0u%\x94\x92\xbbn\xd4\xd8\x8d\xd0.\xad\xe6\x0b\xfd\xbaZh<\x0e\xd3)\x8c\x89Nmc{7\x01m\xf2\xb6\xd1\xf6\xd1Q\xf4\xce\xbe-7)\x89_\x94\xc4I\xf6N\xc0\x03B\xb1)\x97u\xba\xb0\xbf\xcf\xa1Z[}t\xdb\x8e\x81\xce\xe4\xe3\x06\xdc\xb4\xba\x1d\x15\xdb\x18^SOb\xc1\xa1\xe3DS\xe4k\xfduu_\xc6\x1bcy\\!1\xf1o;L\xc2\xf6\xdb\xa4+\x0b\x87c\xb8\xfbL!\x81\xee~_v\x06GT\xc6\x11\xd3\xdf\xefM\xf0\x00K\xa2\x07\xb6\xa0\xee\x99-\xeex\x1ct\xd4\xa9\x7fE\xafkz_Y\xf7>\x8ff\xa3Z\xa6\x9d\n\xf7\x84\xe6J\xd2\xe7\xb8\xcc$(\x85\xaa\xda\x85y]\x86\xd1D\xb9V\xe8D\xc0\xad7\xc1\x04\xc7\xa5[\xa0\xe7\xe7\xf2R,J/\x9bX\xe5w\xb5\xe3\xa8\x03\xb6\xfa\xe0@\xd4\xee\xd1T\xd7\x0e\xc7\x938\xddyVcD\xa9\x9efZ]~\x11<\xee\xa97M\xdb\xf2\xdc\xc0Z+\x0c\x17\xeeETL\xf4\xb2\x91\x8d\xb7\x0c\xb6{\xf5\xd4\x14\xd5}\xfc\xbf\xe7s\xb9\xc9\x1a\x13\xbf\x9a\xcf;E\xff\x97\xf3\xb9\xddr\xd7\x1a\x98b_V-\xbb\xb3\x84\x1a\xb9\x07\x8c0\xde\xb1\x19i\xdb\xd6\x1d\x14\xa0\x0c(\xbc\x96c\n\x81\x9eF\x1d\xa6\xc1\xc2\x9c4\xff\x0d\x19\xfe\xb8\\%\xb9\x00V\xe6Y8\x0d\x99\x8d<\xa3p*\xec\x0f\xc0:\xe8\xb4\x0e\xf8\xfe\x8ab\xf4)\xe6@\x98\x98\xc3\xccx_*4<\xaa\x0cE\xa6\x97\x8f\xb3jg(\xb4\xba^\xab\xcc\xd8\xd0-z-\xf9gZ)\xe8m\xc5\x0c\xeb*#\xc8\x0f\xf98\xc3\x8b_\\\x06\xd5\xd5\x86\x07+\xb7F\xde\x18eC|g\xc6\xd4\xdf\xa1\xeb\xee\xa2\xc7&A\xfagO\xe5\xef\xf0\xd5g\x8f\xbde\x17\xd6c\xec,itO\xb9\x0bb\x818\x87\xce!\xbc\xecI\xbb\xfa\x16\x01\x9d\xd8\x0b\x1b\xf7\x17\x13\x04\x84\xf87\xfay\x05?V]\x17\x04\x96W=|&\x1f\xae \xb9C\xd5\xa8;\xea\xe7\x16\\\xa2\xe7\x0b\x0f\x0bHQG\xd8\xe3\xe7\x81?\x1f\x9e\x9c\x07\xa1\xd4\x810;~ \xb9u\x90\xbe\xa2\x8f\xa7\x8ed\\\x95	\xa6\xf5\xfa\x04\x86!\xce;\x0b\xb4<4\xc5\xabU\xee-\xd0\x8c\x10I\x1d\xf3\x01\xaa\x8b\x91\xe5\x941\x01\x9f\x93w\x96a\xb9\x93\x9a\xa9\x08\x87\xbf\x0b\x05X=\x00.\xd6\xbc\\\xe3\x87\xda\x87To\x8c|\xae\xfb\xfc\x98\"\xd0?\x0d\xed\x9f\xc8\xa47\x9c\x96Qd'+A\xa6b\xbc\x1b7\xb3\xed\xe2\xd6v\xc6zc\x9a1\x94\x1b\x0b\x13l\xf3\xaa\x92bA=3\xf9\x86'h\xe8\x8d\xe6u}\xf9\x86\xe7'\xf0\xe7\xd6k@\xe5\xcbz:M\xc5i\xb0\x1a\xdaEnO\x8ec\xc3\xdd\x07Yk\x93\x0cF\xb2\x14\xf1\xe0\x9bJ\xd2V\xfa8w\xa73\x87\xc0Hm\x9b/>\xddO/n\xc4\x08e\xcc\xd4\x8d\xb1\xc6\x80~\xd8\x16\x984.\xb2\x12\xdew\x7f\xeaz\xb3\x1e\x9c\xe37fY\xa5\x12\xff\x13:\x0bN\x98\xcc\x96\x92T8S\xec\xf3\\\xc8\xday\x15\x1e\xec\xb9-\x05f\"\xca\xd6\xba(\x0d\xad\x0df\xfc\xe2\"\x97\x8f6c\xd6\xc2\x13H\xea\x8eq\xb7\x81S\x11\xd7\x03\xb0\x7f\xf2\x0b<c\xc1i\xe9\x83\xe9\x05\xd4\x08\x17\xb55<	\xd1\x90\x8a\xac\xc1\xa0\xe2,0\xaf\xbe\x0eK\xe8\x1flh\x91\xe8x\xcc\xe5\xb7\xdf\xb0>\xc6\xe0\xae\x17Mx\x94\x98\x92\x1d\xc0\xe7\x89i?(\xf1\x1f\x10\xd3\xc03oI\xc5\x7f\x93?\xe0\xd9\x96N\x87D\xf5\xa4}\xff\x1aQ={\x86F\xc2f\xe3\x1a\x00\xd3\xd2\xa0\x18\x10\x18\xed\xa4=\x02\xf8\xe7	\x9cFk\xda?I\xcc4F=\xf6\xb5\xc6O&\xbb&#\xedqK\x96\x01\xd1c&\xbbF#\xe1\xc0\xd1=A;\xc2\xefK\x03\"\xd7\\\xc4\x07\xe3\x02\x18\xba\xa0&\xa2m\x1fO\x131\x18\\\xad7\xc9<>\xbf\x04ze{'~b\x9b\xaaf1\xbb5\xdb\xe2\x9b\xaaf\xd2\xce\xcf\x98~\xe1e\x06C\xd7c\x1ap\x93	lD\x1c/\xf1\xccg\xe9\xd0\xcb\xba;\xfc7\xfd`\x03a\xa4\x01W\"\xf6\x96$nph}2R\xb6\x89\"n\x06/\xff\xd9Z\x06\xb2\x92\x1d)&Y\xad\xb3\xf5\xfb\xb2xd\x92m\xb3\x87\x1fp\x81@7\xe9\xa2\xb0\x0eT\xf6\xee'k\xe4 Y]\xdd\x7f\xd8e%\xa4W\x85\xbd\xda7\xfa]\xb6c\x92m\xeal\xab\xffbmW\x9d\xdb\xc5\xdb5\x85\xb3\x0e\xe51`O\xfc$\x86\x05\xd7\xdf\xe9Q\xc0\x1cZ6f\xeb\xf5\x1b\x18\xb7@\x05\xe4$\x8a^\x183\xb2a\xdc\xf2.H\xf1\xc8\x92\x06\xc6\x9f$\xcb\x99+\x97\x9b^T-\x17\xa1D\xd8HZ\\\xabk<2p\x84\xc4mgH\x14\xf6\x166/W\x0f\xc0\x17\xcbZa\x98\xbe\xe0\xdc\x80\x9d\xb1\xe9\xd6\xf0\\L\x81\xc0>e\x01\x02\x9e^f\xf0z\xdd\xd9\xcd\x9f\xb1i\x85\xf9\xd0O\xbf\x9e*\xba[\xe4\xb0\x16\x1b\xb55\xbc&\xd4\xeef\x84\xfe\xca\xc6\x87{\xc2~\xb4\x04\xef\xffI\xe7\x05E\xffA\xffMN(qO\x9c\x04\xba\xe0\xda\xcf\xd8\xff\xa7\x1e\xa7\xdb\xf3\xcb\xd7c]/jU\xfb\x00R>Y\xfe7\xc6\x81\xe4\xed\xc18\xe4\x8e\xd1\xf0\x9e\x1d\xb9\xcc\x02\x9bbU\xb5m\x7f\xd7\xcc\xbd\xc7\xd0\x89j\xc2DQ\x96\x18R9\xfay\x9d[\xc5D8V\xb9\x88\xb7<\x17\xc9\xe7G7h0\xcfGG\x17\xe3U\x8b\xe33h\xbbV\xde\x87)F\xb6\x1aOV\xf6w\ng\x18\xe0\\\xd8a\xd1\xaa&\xb0\x8erv\x9b5T\xab\x16I\xd9k\xb8\x16q\xd9}\x9a\xb6p,~_\xcb\xd1\xac\x9fz\x85\xdcZ`\x1a\xf9\xb9\x18E\x01V\x19\xfb\xed7\xbf\x11\xfc\xf6\x1b\x93.\x9af\xd3\xe3qN\x92\xfc\xf0j\xab\xe8\xcd\x13\xc6\xe2PU\xdc/\x17\xd9!A\x01V\xecg\x8d\x99\xde\x02\x1f\xbd0\n&\x9a\x86\x89\xb6\xe8\xcd\xf6\xda\xce\xf6\xe1\x1c?\xbf|\xcd\xed<\xc7e@s\xdd\xcff\xbf\x8c'\xcecar\xe9\xd8\xc9{\xa3.>\xd5\x177}Q\xf5\x90\x05!`<\xe3\xa2e.}\xb8\x17\xaf\xf3\x1e,\xe8\x84\xe7j\x0bKq|R\xe1w.N\"\x11E\x91;\x177*O\xca\xde,s\xf3\xf0\x90\x15\\\x88\xb8\x14\x89Q\x8c\xf9\x13\x99n\xda'f\n\x0f\x86\x86\xeb\x18\xfc\x90\xcc?\xccXP*\xab\xe4\x83\xf1\xd3S\xc6\x8eBH\x94\xcf\x0eY\x11X@\x1b\xc2P\x19&\x8f\x87ZC;\x1b\xcbp\xd5\x01\xc3Uw\xf3\xceH\x86\x12\x16\xbaDaQ$p\x19\x98$\"6\xc9\xa0\x0dc\x0d\xf8\\\xed7\xa7\xb5\xc3\xdar@\x06\xb6\xee1\xd6\x9bkeu\x1b\"\xe9\x82\xe7\xdd#t~\x1cv\x96C}\xeb1\xbe\xaeu\xb1\x83\xb2\xfes\xac\xa0m\xcf\"\x18\x93	\x9a\x1do\x0d\xb7\xa1\xc24\xfax\x11O\xf3L\xa96\x92\x9bC@n\x90\xd3\xee\xb3K@U1\xe1\xdc\x1e:\xdb(\xac\x8d\xcaPO\x10#\xb4L\x96T\xd3\xcb\xd8\xeb\xbe\xc9\xf3\xa1z=O\xf6q\x96Th\x14\xbaw6M\x9c\xa4Y\x8f\xdb\x02\xecc\x8d\x90\xc5Q4)=\x0eL\x14\xf1I\x19rg\xeeA\xdbN\xbe\xe2\xe1\x13\xc9\x1c\x983\x13.\xc8\xdc\x1d/\xed\n\x90\x9d\xb1\xdb\xc2\xea+\x8d\xdfb\x9aSW\xa9\xc0\xec\xac\xd71\xb0\"\xee\xb4[$\xa1\x01)\xd1\x9c\xec\xfc\\p\xb4 \xcd\x82OS=<\xe0n2\xda\x82qz\xd5BVh\xa3P\xaa\xc9\xbc'\xf6\x0e\x06C\x9d_\nY\x1d\x8f=\xce\xd4\xaa\xdc:=\xdf\x80c\xec\xad\xbf\xf4TK\x80\x1dv\xe2\xbc\xe2eW\x17\xf0\xb0\xff\x1d\\\xbb\xde50\xc9\xbcf\x11\x05\x81~\x8d\x18\xbd\xe5Y2r\x12\xf0\xd7N\xec\x84U%\x8b\xadV\xe5(P\xe0\xb0\xe1\xd4\x15\xabJ\x17Y=/\xcf\xde\x10\xd8\xae\x95\xde\xed\x03\xfci]\xc0\xf5\xebb_\x8b\x17\x17\xf2M\xbf!c\x80\x04\x0b\xbf2m\xd4A\xf9t\n\xc12\xe2)\xd9\x01\xea\xa9e\xd9\xb6oS\xb9V\x07\x07\x99f\xe3	\xdb\x00\xc4\xb1\x96\xb7\xc13\x1f>\x1b3t\xc0V\x1d\x12^\xbcD(\xb0JmT\xa60\x8c\xd0[	\"p9\xf0\xcf\x0d\xef'WV\xe6[OO\xa0*a\xaa\x9d_\xbe^w\xec\xdb\x0cmR\xd6\x8a\xdf\xaauP\xb1\x98\x91%\x82\x90\xb7\x16\x8dO\xc8\xbd\n\xde\x8b\x89\xd7\x86\xb1\x9a\xae%\xd7\xea3\xa0:kyj\x93\x10EZ\x88\x0e*V\xd5\xc9\xab\xf8\x0b\x19\xf4\x82\n\xa0\x1d\xa5\x0ep\xbfT\x90)\xf9\x17!\xfdHe\xe4`b\xec\x91\x87t\xc0%\x14\x13\x9e\xd0H`{\xf6A\xe2\x96:\x8d{\xc4@\xa2M\xc9)\x04\x9c\x05)$\xf8>\xdb\xfb\x1e\xab\xcd\xdewa\xb2,\xe9\x9a\xd4Q4Y\xcd\x1c\xdeP\x14M\x1e0t&\x05\x0e^\xf5p\x0e\xdbv-\xdd87\xd35\xec\x8c\x95\xaa\x02\xca(\x16\xd5b\x90\xb2s\xe0\xe02S\xd5\"C\xc7\xe1~\xd8\xac\xb6}+\xa2\xc8\xe6\xcb\xc2 Zm\x9b\xd9\xa2\xfe\x9e\x97\xeb\xea\xbem\xdf\x88c\x1e\x02\xbe\xed\x96\xb9\xc5y\xd3\xcf\x01\xc7mT\xe5\x02\xe7_\xbe\xce\x93&^y\xb0F\xf8\x1e\xee1\xf7\xaa!\xe0\x1e\xbd\x95:x\xa1\xca\xa1\x00\xa2yAa{\xb2\x02\xe6\x9a\x17j\x1fE\xd5r\x9fvO\xa2\xe8[\xb2	\xf6\x03\xde{\xc5\x03\xe18\xa8\xa1!NJg\x03M\x8d_\xc3\xc0\xea\x11\xf0\x15.\xdav\xe5\xcdQ\xac\xb9@\x97`+\xdd\xcdvx\xd6fD\xdbN\xbe\xe5\xa5h\xdb}\x14my\xb9\\c\xff\xc1\xa8G\x11\xe7\x99*\xe938\xfc\xe2$\xec\xa0?\xfd\x92Vk\xf9\\\x87G\xd1f\x0c\xae\xf4\x8d\x11\x12\xeaB\xd0\xe2\xe7\xdf\x1c\xc3?\xa2\x97O\xdb`\x17P\xe6\xda\x9aa\x88F\x0fU\xd7X\xf0\x95q5_g\x8b\x1b\x10\x0dY\xca'K2{X9\xf3\xa3X\x0c\xb1\x92k\n\x12h\xc4I\x18\xd71\x02\xfeG\xc7@\xfd\xb2\xa1\xa1\xc6\x05\xd3\xb4\x89\xdf\x9d`b\xf7\xed\x84\x81\xe7\xc7\xc8\xbe\xb6\xa2\xb12K\x0c\x1f\x1bnn\xe4@\xf9_\xc3\x10	\x0czz\xf2\xa8#G\xb6\xdfx\xedi\x9b\xec\xa3`\xd2\xb6\xd7\xa3]\xf5\x18\x04\xc9!\x00\xe2\xe9S\x0cb\xd1C\xeb\xbdZ,\x0c\xac\x8f\x93\xa9W\xca\x1c\x0f\xf8\xc3\xbc\x92\x1b\x0cEr\x89Qr	o\xf4_\xaf\xf8\xfcra\x92^\xf1F\xc4|\x1c\xcb\xabk\x8d\xb7\x08\xac\x05:\xc0![\xf1\xd6\xa87\xb3\xa2Z\x91\xab\xc7]x\xe0.?\x80(\x98\\,\xeef\xa8\x83\xfd\xf8\xee\x87S\xb3#\xd4\xe1\xe8\xb6=1.\xf2\x01\x9f`\xd2btM\xa3p\xe6\xbf\x99}\xfd\xfe\xddOP`-\xa8\xe0o\xeaj\xfb\x01_GVB?\x98\x8b\x87m\xc1D\x17:\xd3\x1f\xd7w\xba\xd4	:\xae\xda3\xdb\xe6/\x8fW\xd9\x0d\x08>\x9ca\x91\xb5\xae\xeb\xaa\x0e,\x93\xeff\x98\xc2\xd9\xf7\xe5!+\xf2\xf5\xd9\xc7w?\xc4 T\x0bi\xc8\x17\xeeG\xf8\xda\xe5\xa7\xf4\xc5\x85\xfc\ne\xe0\xe4Syq#\xbf\xb6|W\xb3\xbf\xde\xe6\xc6\x9e\xa7\xb4\xf96\xbb\xd1m\xad\x1bm\xdaM^h<`\xf9\xfd\xb3'1w\xfa\xf1F\x97\"<u\xf9\x87\xe1\xa5\xd4\x1dr\xb19u\xb3\xd6B\xd8\xf52<\x9f\xaf\xdb\xf6G\xbb5\x96\"\xc9q\x87\x8d\xa1\xc4)[\xb2\xe9)\x02\x89qj{\x03\"\x1e\xf0	)\x93\x86\xa2\xd6\x04\x90\xf3m\xeb\xde\x9c(u\x0f\xf5C\xd1zh\x86\xa5\x85\xaf\xca`IziR\x17\x02\x071A\xd4\xa8\xcc\xa1\x96\xa9\xcc\xc7\x1e\xa9-7\"1\\\xc4fQ/]|\xceT\xe9rU\xad\xf5\xdf~\xfe\xfeM\xb5\xddU\xa5F\xf4\xc2)Sl:\xf2\x848\x98\x12\x84\xd1R\x1c\x91:\x91x\xea\x8e\xa4\xd9X\x0f\xc3*\xfe\xfd\x1f{]?F\x11\xda}\xffTdyi-\x06G\x07@<\xe5$\xa2\x03\x8b&;a\xdd\xf7d\xe0\xcaC\x83\x8c>22\xef\x02\x88Y\xe6-bb`<\xd9\xe8:\xcf\x8a\xf1\x90q\xb6k\xb9\xd5>\xd9\x8c\xf4\x1d\x02\xa3b\x84I#\x05\xe0\x8b\x83\xd8\xbfD{\xecQ<\xa9\xaa\xb4]V,\xf0\x8b\n9BM\x9a\xbd\xa3pV\x9c\xe3\xa4\x0cf^\xcfG\x1c\xba\x0b\xfa\xd8*p\xf2\x86\xb3\xd8I\xbd\xc0\xa1\xff\xee,\xf9BM\n0\x0b_\x9b.\xd6/=]\xb9\xd0\xfa\x93\x9d\x8f\x03,\x8e\xa2\xffq\xa1oZ\xa84Z\xf4D4\xb2\x07\x18\xa8\x89J\x91\x90\x8a\xa8\x1cQ\x11=\xc1\x87\xc4\x86\x86\xdf\x82qz\xc5\xc8WF\xb2O\xf5\xa7\x92\xc1\xc6\x17\x8fd-\xc7\xb3\"\xcf\xe7\x83\xc5\xfe`\xd4\xc5\xffz5\xbf\xb8\x91\xdf\x19u\xf1?g/_\\\xc8\xf7F]\xf0e\x12\xa5\xe27\xb5\xfc\xcf(}y!\x7fB\x9a3{\x99\x88xy\xf6\xc9\xa4/\xf9\xf2?\xa1\xc4\xf4\xa5xqq\xb3\x95?[\x9a\xf4\xed\xdb\xab\xf6\xbb\xb7_}\x0dR\xe2;H\xfbt\xf1\xe9\xe2B~o\xd4\xd3Q\xfe\x1d\xff\xbf0\x8a\xbd\xbc`\xce\xad\x94\xbddB~3b\x1e\x93\x85\xb1a\xffbx\xe5gW\xbf\xdfO\xb7\x04T\xbci\xa9\x15\x94\xbd8\xf4\xbc~z\n\xab\xde9\xaf?z\x18X\n\xb0)\xc6\xc7Y\xce\xd3\x84\x97\xaa\xf4\x01T\xda\x96\xbdd\x92W\xce7\x8d\x0e\xb1;w \x11\x0f\x9f9\x9dIw:\xfd\x9bA\\\x99Jf4\x7f\x10\x95f\xaf\x8cR\xea\xef&\xf0~v\xfb`\xed]G\x97:%\xd3J\xa4\x19fi\xf1\xb3G\x99(N5<g\x9f[b\xf4\xa5fY\xa6\xc9>\x99\xf0Z\x95\xc2j\xd8b\x9e#\xd83\xc8\x14\x9d=})d\x01\xff&\x97\xe2(d\xedQz\xc3\xcc\xcby\n\xfc8y\nGQ\x81\xc3\x1c\x84\xae5C\x0d\xd1\xdd,\xfb={\xf8\xa0\x8d\xc9\xcb\x9bf\xb6)2c=H=\xf27\x12:#\xbcZ\xd1,\xcb\x148\xfb\x1cZ\xae\xe3\xbamy\xad\x9e\x8eB@\xaf#\xa0\xb1\xa7\x82\x01\xce\xe5d\x0e[\xa1\x90\xfa\xf8\x8d\xc1\x93[\xf5\x96~C\xcb\xa4\x95\xc9\x0f:\x9e\xcb\"k\xcc\xbbj\x9dor\xbdFwX\x93\xa1[l\xd8\xd6\xf8i_\x17\xb1+\x04\xb9l\xf6\xed\xdb+&\xf3\xe6\x87j\x95\x151YC\\W{\xd3f\xbb\x1d\xfc\x9d7\xa6\xaaag\x9fM\xcf\xb1\xce&\xafJ\xdc\xe0a\xafo\xef\xf35Bu\xbe\xb8 \x8a\xf3\xd6z\xd8\xaf\xaaBH\x02\x84A\xd0\xc0\xba\x02\xc6\x0c\xf1%&s\x995\x8f\xe5\x8ab\xb2\xe1\xc9\xe7\x15\xb6\x04\x84\xa4\x9c\xd8\xae\x8b\x87\xf3\xfb\xfb\xfb\xf3MUo\xcf\xf7uA\xfb\xdazq\xb6\xba\x05V\xc6\xa8\xbf]}s\xfeoL\x02\xbb\xb73\xd6{\xef\x85!\xb4\x05b\x97v\xb0a1\x8a\xd4N)p\xc9\xe4\x03\xdc\xf7j\xda\x16\xf2\xccsX\xf2\xf7\x06\xc3i\x06\x19 \xc5\xe6\xf8=;d\x165\xe3\xe8\xda\xde\xc4OP\xe6\xc5\xa7\xeb\x87m\xf1\xe9\xfa\x82\xaa\xbc\xf8t\x0d\xbf\x17T\xde\xc5\xa7k\xf8\xfdt}q\x94\xb5nvU\xd9\xe8or]\xac\xed\xcb\xcc%~|\xf7\x03\xb3_\xe1\x92\xae\xf4\x83q\xcdri\x7f\xfd\xf0\xfeGj\xc1A\xd7\xc6\xfa/b\x13YLl#1\x8dg\xf8\xcd\xd0\xd1t\x0b\xa5\xb0\x18\xde&6\xd3&\xc3\x87\xc7\x1dK{\x94\xc1\x94\xa6)\xe3\x86\xea\xc1\x80 \xe6'\xd5\xfe\x19\xfb\x12\x93\xfc\xd3p\\8\xfd\xb5\x02\xa2o\xfcO\xc3\xfb\xa9\x08\x94\x01	\x1dF\xcd_\x0c\xff\xde\x08L\xbc\xaa\xb3\xb2\xd9U\xb5\x81\xc4\xbf\xdb\xc4A\xb5cZ&KW\x9d\x0d\xbfQ\x06W',c\xd4\xd9\xc9R\xaee-o\xe5\x0dR\xb5C\xb7\xac\xf7;\xeb\xdc\xf0\xa8\x0e3\xfb\xd9m{\x90\xdb\xee6\x8a\x10\xd1\xc6\x05\xeax\xb4\xcc\x92H\xee\xf8\xa3\x88\x9d\x1a\xf1\xa1\x17\x08@^\xab\xbb\xd9\x9b\xac(\xae\xb3\xd5]\xc3YU\xae\xf4\xd9Vo\xab\xfa\x91	y\xaf\x0e\xb3\xc6df\xdf\xbcA0u\x0c2\xf4t\x94\x96\xcc2\x02\x1a\xd5k&\xaf\xd4S\xad\xb3\xf5\xe3\x07\x03\x825\x02{\xffl\xe7\xc5w:[\x8f\x01G#\xe2\x96\x0bq\xf9T\xaa\xa7\xa3U\xdf\x18\xf5\x93!\xaf\xd5\x9d\x10\xe5\xd2\x9c`\x03 \xbc\xba\xe2\xcf>\xea\x85]0\xcbW\xa98\x1aU.\xf5H\xd6c\x8f\xc30\xc4a\x18\xcb\xf4\xc93\xe0\xfan\xb4\xf9\xaa(\xfa_3\x16\xa1\xf86\xd9\xc5\xde \xe4g\n\x9eq\xf2\xed\xc1|\xa4*	\x97\xab\x196.\x1dIj[-3\xd8\xb7,\xca\xf0QV\x07]\xd7\xf9Z\xbf\xcb\xb78\xe8c\x16Q]5\x87\xd9\xd6\xe6S\xda\x95\xd0\x8d\xee\xf8\x00\xa1\x8b\xfb\xad\xb8r\xbeyzyeg\xc4\x89\xc7\x87\x16\xf7K\x93\xaa%\xfcG1\xa3\x17w\xe8(\xb3kX/'\xb5(\xdd\xb6{\x97s\x15E\xab\x19f\xc4\xa0\x8d|\xee\x10\x95\x8f(%<\xf8\xb8\x0cWB\x1ef\xfb\xbaP\x9c\xeb\xb6\xc5\xcb\xb6\xb5{\x88\x982&<\xef\xf6\xce\xc8\x80\xfeO\xd9\xc5\x05\x83wQuff[mn\xabu\xdb\x1a\x8b\xf9u\xf0)\x94E\x1e\xba=Y\xf1\xee\x06\x99\x17\xf1<7\xc4\x98s\x1d=\xccVu\xd54_W\xdb,/\xc5S=\xce\xa8\x81\xe0]\xd3v\x8a\x1f#\xed\x0d\xfd\xc8^!\xea\x9b\xc1\xf7La'\xae\x1a3Q\xf5\xe0A\x8d\xe9\x9d\x80\xde/\x07\xa8f\xbe\xb1_\x15E\x87Y\xb0#v(x\x9e\xcfq\xf9\xec\x0b\xcaI9t\x0b}Zgk\x0c\xd3\x97\x15B\xc8\xdf\x80\\\xca\x834\xf2JHo/r\x85\xacH~\x96\x97\xfc\xc6)\xaa\xa1j\xda\x96E\x14\xcd\x15\x10=d \xa6S\xe4;z\xda*\x86\xf4\xd0d\xb5\xe9\x86\x91~\xfa\x91\xfa\xe4\x01\xcd\x10,$\xcb\xe4g+\x98PV!m7whkxJ\xda{)y\xa6_0\xc6\xa5%\xba\xc4%\x10T\x9c?\x8c\xf8C\x96\x01\x0f9l'\xd2\x8fo\xc7\x0fF\xb2)C\xde\xd7\xb6\x90\xd8\xe5\x8d\xb3\xa2\x91\xc1(\x04\xcd\xea4;\xaa?\\P\xd5f\xaa\xf8\x07\xdb\x01\x1b\x91\xb0\x88\xc5,abj\x07\xce\x9aG\xda\xfc\xa4\x80>\xccV\xd9\xea\x16v\xac\x8d\xda\xf8\xd6\xbd7\x92\xbd\xb8dBV\xe3\x05\xb2\xdf\x14\x9b\xde\x99\xe9tZ\xb9\xf5\xb9\xa1\xcb|\xe3\xd8@\x8c	\x11\xf2\x85\xcbM\x1aEW\xb3!\xd1\xe4\xec\xfb\xcd\xb9\xcbs\xfe!/W\x9a\xc9\x937Q\xfdk\xb2\x9b\xcf\x15\xf2cU\xea\xf3w\xb0\x04X\x97[\x08\x19L\xfcn\xd4\xad\x9a|0\xbe&\xbc\x15\xe35\xd9\x02\xce\xaf\xd0\x1c\xbbW\x80\x90c/|\x85\x8c\"\x0bI\xccr\x9eBs,\x0b\xb9\xec?I\x93g\x9fLA>\xc0f\x87\xc9	\xec`\xd3\x17f\xca\x16g\xffP\xf3\xd9\xfc\x92\xc5\x8c\x89\xb8+\x86\xe2\x10\x1df\xb7\xb4\xab\x89\x91f\xe6\xddc\x04\xc0E\x82A80\x1ft\xb9v\x11\xa5\xc24:R|\x94W\xf2 \xda\xf6\xd6\x1f\xe2^Y\xd2\x8e\x85\xec\x15\xc3;&\xaf\x11\x1f\xf4\xd0\xc5\xdf\x90W\xe4<}\x985{\xd4\x9dB\n\xc6\xe48\x90zP\xc8\x95\xfa\x0d\x18/K^\x90\x8b\xb8\x9au\x1c\x88\xba\x947Q\xb4\x1dP\x0d\x04\xb4Y^\xc9C\xda#H\x87\x19\xb2\xfeQ4\x7f}@w\xa2jo\xf0\x14\xf2\xb90U\xee;\x98\xcd\x0c\\\x82\x7fS\x101\xbfU\x93K\xb9\x9a5 \x0fe\xb2\x08\x94\xa4\xb8\xa1\x9a\xdb\xba\xba?\xd3\x8b\x82\x9f_\x02\xabI\x86Px\xc7~\xac\xce<\x7f\x19\xca\xf0\xc5\xb3\x07\xbe\xca,n\xdb\x96\xa3\xb3\xe0:\x8a\x06\x8e>k\xe8.{&\xb2S5P+\xd9\xeb\xac\xf9k\x9d|\x19\x83|\xffj>\x7f\x8dXT\xaf\xbf\x98\xcf\xdb\xf6\x8b\xf9\x97J)\x8d\x8e\x05\xcd\xf3\x06\xf3\x16e\xc6\xc9\x1er\xaft7\x0f-S\xc7^\"h\x04\xc8\xb5{\x7f\x9a\xeb\x03B\xd4\x18\x1eU{\x06\x05V\xdc	\x0b9Xb\x02\xa7Q\xdd!\xd56\xc0\xab\xc0$\x8d\"\xf8O\xe4\xa9\x16\xe2i\xefE\xef\\,\xaek\x9d\xdd\xc1\xb6\x07m\xc9\xcb\xb3RT\xd8,\xe4f:\xf0g\n\xa8;\xd9\x13f\xf0\xac\x93j\x9690\x8e\x18\x1b6\x15O\x95\xcam\x89\x19\xc2\xe3\xe4\xe2\x88\x1122\xa8\xc1\x1b\x83W\x13\xfa\xf4(\xea\x9aR	Y.\xab\xf4\xc8\x0f\xf2\n#\x10\x0f;xl\x9c\x9f\x8e\x88i\xd9\xe9\x14hw\xc0\x9eX-/S\x02\xa4Dn,h\xb2(\x96\xd9\x90\xbd\xec}R\x96.*\xb5r\xc3\xe2\xec,\xa1;\xf1\xd8,\x10\x0d\x97\x16\xb5p$\x1d\xf9\xd2\xc9>\x8a\xea(\xa2&~\x83r\x13I=A\x027\xb2\xfb\x04<\xc9\xafdP=TK\xb3\xa5\x82\xa1\xf1*o\xa2p\xfb(\xda\xa3\x9d=\x0e\x0f\xcfT\xb1\xdc\xe3\x80Ti\xdb\x16K\xf6\x12/\x03\xfc\xe2\x02M}\x1a\x95\x07A]\xc5\xf22%\xfb\xfc\xa0\x00$\xa4\xbe\x0c\xbc\x13\xe2	#8gI\x86\xf8\xc61\xba\xf0\x178\xc5x\xa5 \x8f\\\xf9\x11m`\x00\x82	\x86\x993\xa8>\x8b\"\xbdd\xb8\xe6\x1b\x96\n\xa32n\\\x0c&<q\x81\xfb\x8eBX\xe5e\x83\xb2T\xef\xa0D\xe2O\x9c%:\x062AC\xd8`\\\xbc\xba\xda\xc2\xb4\x9c\xb23SA\x1f\x1c\x8f\xc7~9\x96\x962	]\x1f\x9b#\xcc\xbcF^\xc9\\\xc8<\xe1\x83-\x9a\xef\xd5\xd5\xd8\n\xfc!k\x8c\xdf\x95)\xa0\xc9\xc9\x9e\xac\xf6B>\xf7>\xec\xbe\xee5\xbb\x13\xab\xbd\x10\xf2\x15Q\x9a\xb6e\xdf\xbd\xfd\xeak\x86\x1b\n\xf01	F\x19q\x10\x00\xb1%H\x94j\xb6\xae\x1d1/\x14\xc6\x802\x1a\xd1\xa4\x90\xad\xc9\x15L\x8f\xc6\xee\x18\xc0Re\xaa\x90\x13\x1dEE\xdb\xf2B1\xd7\xa5h\xa5\xad\xd5\\\x08\xdc\xa7Q\xbaQ\xda_\"x\x127m[\x80`!\xf3\xe4\xa1\x17`\xecQ.+Y\xc8\xabT\xc4\x0fa\x84\xb1G\xd8i\n\x99\xa5]\xa1 f\xf1{\x14\xab\x89\x1a\xf7\xf6\xa8<\xa1]\xca\x0eS\x8cwo\xa9\x8d\xb0i\xc9<\xa9b(\xee\x1a\xe3\xf6\x04\x95\xa4\x18|\x84\x0f\xb6\xbb7v;\xf5[\xde\xf9\xb9c\xac\xf1\x9cm\x8c\xad\xae\xd0r\xcd	\xc5W(\xfc\xfe\xf5\xc3\xfb\x1f\x9fq\xe7:\xbbsn%\xb2\x94\x0c\x159$0\x7f@}\xd4\xb8\xdc\xeb\xde\xb1}`:\xcc\xd7\x9e9\xd9\x8d6L\xb2]\xd5\x98\xbe+x.\x9e\xee\x96\xf9\xd0}+\x88Z\xb1u`cu\xdb\x96\xb2\x0f\xbfO\xea\x94.X\x17\xea\x914)\x1ds\xe9hR\\\xdb5\"\xed\x9a\x89\xcb\xa3<=pB\xa3!j\xb3C\x7fV\xcf|1\xd6\x1aVF\x1aN_\xa1\xeb\x02\x89\\w\xa0\x8d\xbc\xf4\xfa\xca\xcb\xbef\x0dud\xf6\xadP\x19q<\xca\x8e\xd2\xf6\xa4\xed\xbbY\x87\x85\x8d\xcdC\xf7\xdf\xde\x99\xd6}\x9d\xed\xbe*\x8a\x11U@ \xc9\xe3>\xc6)B\x0b\xd7\xb0\x918\x9b_\xd8\xdc\x854\xea\x8e\xd3a\xdb	^\xb9\x98\xe9\x7f\xf0\xb9\x08\xc0\xfa\\\xb6\xbe+L\x0f\xcf\xd3\x95,\xcd\xf8\x89\x18<w6\xe8\x04pj%k\xc29\x85\x16\x9e\xa4\xfa\xe3\xb2\xa3Gw4\x16?\x11u\x15\xd0\x11\xdf\x97\xbd(ie0\xc1\xca?2\xbd\xf7\xef\xf3\x9eI\xb4\xf8\xbc\xad\xbd\xb7\xac7\x017\xc5\xc5\xc2E\xecN\xcc\xcc\x8e\x10G\x97I\xdbr\x8a\xb4\x0fON\xfcC\xe8\xb8\xb6w\xb6\xf7\xd96c\xd1I\xcf\x15D\xc4\x04/\xba/\xfb5\x0c|\xeeh\x00\xb9\x16\xb3\xb22\x9c]W\xebGv\n	\xdc9\x9ex|HwL\xe8\xe0\xea\xc5\xb1\x0b\xcah\xdd>w\x8d\xde\xaf\xab\xc6\x05[:m\xc2d\x90\x11\xe1\xa6,|\xe2\xf8\xa3\xb1B&\\\x870o\xc0\xf6\xd1-a\x9e|\x068\x01\xab\xe9\x9d\xbf<\xdc\xd6\xa1\xeb=\xaaw\xac>\x0em+>\xbe\xfb\xe1;cvV\xd4\n\xc1G	b\xcc\xa8\xa79\xc6\x12\xb8|\xf5\xea\x8b\xf8\xd5\xfc\xcb\xa3\xfch\x86\x87<\x0f\xb75\x17\x8b\xc7\xd9\xaa\xaa\x1b5\x99|4Q\xc4\xeess\xfb\xa6\xd6k]\x9a<+\x1a\x96\x97g\x1f\x8d|\xc4\x17\xd5G\x83\xd9lc\xbd\x90\xd1\x8d\x905p\xa8d\x06\x1c%\x95\xdc\xb6P\xf0$\xef\xe9\xb1\x1ck\xd1C9\xee\x19\x10\xe4\xb6y\xc0\xa3#\xd0%\xcfI\xa3\x96\xa3~+'\xa1\x0b\xee\x1a\x8d\xe6\x942\x9f\xed\xb2\xa6\xb9\xaf\xea\xb5\x90\xf86\xb1\x96]\xac\xcb0\xb1\xc6@\x96]\xc2\xb2L\x17AF'H`\x9c\xa4\x81\x96t,\x8dw\xaf@\xe5\xc1\xa7\xb6\xad^\xb2\x8f\xe7v\xa4\xf4\xfa\x1cAMS\x8c\xaa9\x92\xaeX\x7fh\x99\x90Z\xd4\xa7b5\xd9\x1a\x88E5f\x92\x1c\xcc\x9c\n9\xcdL\xd5\xb3\xaa,\xaal\x8d\x17\xc8\xb9\xe0\x15\n\xa2xe\xc5O\xbcF\xd9\x0e\xd9\xa1\xd5mV\xde\x10\xc8\xb0\xb4\xf262P\xb5\x13\xc5c\xcb\x06a\xeaH\xb0,\xe2\\\x12\xc3\xe7\xd2\xe6\x14\xb1\xe1.]\xd6\x01\x93\x04\x0f\xfef\x96.)m\xdb\xd1lt\xbeC\xd0\x17^\x8a\xb0j4|\"F\xcc\x94\x82\x9c\xfa\xc1$O\xd7y\x99\xd5\x8fq\x97|\x8c\x9f\xf0$\xa8\x9f\xf1(\xd1q\xe2T\x7f\xcf\x05:%\xf8^\xad\xb8\x90\xd9\xa0o]\x8fV\xdc}\xb9\x0c\x1cBl\xdf'\xdd(d\xf1h\xdf\x07\x83	\xcck\x1dH\xde \xaa\x8f\xab\x18\xaa(\xca02\xa3\xac\xa0~\x1a9\xa7&F\xa5B\xdeSZ\xd1\xd11EJ\xea\xeb\x1a*\xa7k8\x9e\xa8\xe0\xb1\x9a\x8a;<\x91\xdeiWo\x8b\xd0\xe1\x82@\xcbU\xb7;\xcd\x88\x05Qt\x96\xdd;\xad\xf2\xe7\xa0\x94\xc5\x1exv\xa7\x95\xf2\xacw\xa0\xf9L\xba^mG\xd3\x1f\xce\xbb'\xbdsO[\xdb\xc5\xa7k\x9e\xc4Pj\x0b\x19\x05%\xe3a\xe7\x9f\xe0\xa3t\xc8\xad\x06\\\x93\x90z\xb4\xb3<\xff\xd6\xe3\x9c\x9cvC{e\xaa\xbd\x82\xee\x92\xa7\x9d\x8a\xcal\xe4\x0c\xbb\xce\xec\x88\xf4i\x1d\x9d\x0e\x06M\xa8\xfaT\xab\xb4C\xf3\x951u\xf3\x19z]\xab;\xce^S\xde\x7fg\x82\x1c{z/\x13\xa66\x9a\x1d=\xd9\xb3\xee\xd8exC\xf7\xb2\xa9Wq	\x84\xfd(fU\xc9\x19,\xaa3+`\xf5\xadAkg\xe0(dNt	X>\x1e\x90!\x12\xfc\xbe\x9c\x7f\x89\x1b \xddB\x87\xbcE=d/\xeeL\x0d\x8c\xe1\xc8\xbc\xce\xa3(\xe7\x9d\xf5\xe4/F~k\xd42\x95\xbf\x1au\xc1\x95\xf8\x94\xf0DE\xed\x0b\xd1~J\xc8r2\x98\xb7 \xc6\xecb\xb6\xb2\x87\xa2t\xcc\xbdsg\xa4\xa7\xd6\xa0\xdf\x1a\xb2\xa0F\xa1\x8a\\\x0e\xa6\xec7\xd2\x83\xf7\xf8f\xb28\xd1\xa3\xf3\x07\xea\xc0\x93\xf0\x1d{\x1e\xe4EfjB\x98\x0c\x983\x8a\xf8\xaf\xde\xa1~_\x17\"a\xfb\xba`#!\x19\xac\x9e\x1bO.\xf4\x7f\xf7\xe4\xa2\xab\xd3\x9e/0\xf8%\xa7\xdf\xacm\x19}\x05\x0ed\xa8\x8c\xf6\x01\xec]\xf3]\x8f\xaa-\x1f\xa4\x88d\x90\xc0\x81\xdb\xed\xa5\xc8,\xd1\xcb,U\xf0\xcf\x1fO\xfcJ\xc7\x13\xd3Z\xc4\x83~\xc2\xfe	NA\\\x7f\xb9\x83\x0b\x9b\x13\xed\x15k\\\x9a\x9dr\xcc\xae;\xb2SHCr\xee\xb4{\x9d\xd9j=eg\xf7YsVV\xe6\x0c&\x10\xf4\x98\xac\x96\xf3\xf4(\xfb\xbd\xa1HZ\x96\xb9z\xb3\xacS\xf9\xa6\x17\xcbL<U\xca\xbbg\x1ee9\x12\x18\xb5\x83+K\xee\xf8\x1b\xe7\xc9\xfd\x13\xac\xd1Z\xc4X\\.\xf5\xb2N\xf1\xe3\xfb\xfdm\x06]	\x13x\xdf\xdc\xf2Z`T\xd3-\xcf\x05,\x1f\nR^\xa9\xbc\xc3dp$\x08\x0d\xc5\xe9\x14\xf3\xbb+\x8cn\x81mU\x9c\xffb\xd4\xdbY\xbe\xdd\x91\xac\x853i$#\x87Y\x07\"\x82\x08p\xf8\xd9k\x98l\xff\xfe\xfa\x82~\xc2\x1b&_)\xa5~1\x81\x98\xe0\xcf\xc3\xac\x89\x08\x161\\94@\xa7\x06n\xc92\x8d\xf9\xa8\xff5'}\x01\xd0g\xd3\xb6|\xec+\x13\xcek\xc5\xff\xf4w\x8a\xe1q\xefu\xd6hH&\xdb)o\xd4m\xcd\x9fFh\x9c\x10\xb1Qo\x11)\xad\x8c\xa2e*y\xae\xbe&\x03	-D\xb24\x83\x1a\xf2\xe5e*\xd2\x98\xe7\xea\x9e\xe2\xd1\x1aY\xe1\xc8V\x1e\xe7\xed\x8eW\xa2\xa3\xc3w\xb3\xad\xaeo4_\xa6\xc0\xfav\x92\x98 \xea\x89\xb4\xc7j\n\x90Y\xfa\x1c\x81B\xa1\xb1Q:t\xc9w\x02\xe8\xf9\xe5k\xc2A\xdb\xc2\n$\xd55\xc8\xdc \xa2\xd3\xdd\\\xc2=\x9a\x18\xf3\x9e\xe6&6#\xa1d\x0dA\x91\xfe\xf4\xfe\xc3\x15\x13r\xfe:\xeb\xbe\xefT\xe3\x92\xb7\xedP\xe9B\xc6WV\x1d*\x06\x01{uo\x15\xcal\x06\xb9y\x9d\xc0n\xb9\xce\x0f\xff\xee#\xa0\xf1`\x06\xc2\x80\xa0\x7f,\xacB\xd8\xb4\xdc\xd2-\xa3\xa8\xbf\xedf'\xb2q\x19\x86%\xac\xda6\xd0\xb1#\xdbl\xa4N1V\xb7\xd5QxMYw\".;5\x9e\x1c\xe8\x00CmbO\xcf(\xbb\xb3\xb1\xa1\xf9#F\x124\xe9\x98\x84\x82B;\xc5q\x15#\x92\xba\x8d/\x18L\x95\x9e\xfe\xaf\xd6;\xee\x83_\x8e\x95\xae\x1c\x98\xd0\xd1\x89\xd8\xf0\xbd$\x8d\xa3?\xc8{\xbc\x1c\xf7\xe0	\x83\xc4\x07\x88\xc6\x16B\x90\xa1\x87\x1cG\x1b\x80\xa7\xe3\x82\x01\xab\x9e\xaf`\xbf*:\xf8\xbd\x00\x0d\xde\x81\xe53!\x1b\xb5\xb2m\xe0\x14\x9f\xd6\x16\x8djS\xb9\xef\x12\n\x04\xcf\x93\xbc\x87bX\xb4-\xdb\xe4\x0f\x18f\x0d\xc3r\x9f_\xbe\xe6\xd5t\x1f\xee\xbf{S1\x91\xf0L\xf1Z\xad|#\xb8\x10\x88|\x9f\xab\x1a\xf1\xf2P\x95\x1d\xe0\xf6U\xa2m\xe72\x0f\x93\xf6\x04x\xbcun\xf4\xc6\xb9y\x96\xbd`\xb9\xb0\xc6m\x98b3\xc3h\xa2|\x03\xbftw\xde\xc0\xffi\xd6e\x81\xda1\x0f\\\xd8\xfb\xf3\x06\x7f\xa6\xb9\x90l\xdf\x00\xa1\xcd\xcb3\x93\x98\x19\xde\xb8z7\"^a\xf7l\x08c)\xd0:V\x83\xb1$O\xe0\xe7\x02\x8e\xf8\x9d\x8f\x02e\x8c\xa9\xd3P\xd9I\xa5\xce\xfc\\q\xde\xfbh\xfc\x7fp\xb1S\x86Q\x11\xea\xa4~N\xc9\x9387\xfc1\x1cCY\x82H\x18\xea:C\x7fE\xf9d\xaa]\xac\xb17\xcb\xd9.\xbb\xd1\xbfR\xa3$\xf4\\\xac\xa9\x03\xe9\xc9Gzr\x141\xbe4\xa7,\xf3\xa3s\xda\x97\xbb\x11\xf4\xfb|\xe3\xd5\xa4\xc4\x95\x92N\xdc}\x9d\xccU\xbf0\xe0\xd5\xba\xc9H\xf3\xb6\x0e\xd7\x880\xcf\x7f+\x9d\x94Z\xd4<\xbf\x1eN:@Bw\xd1\xe3\x9fP9\x082\xc9\xda>\xb4{\x95\xd3\xdb\xa2&Y\xa9\x12\x99\x82\xb6\xa5\xebA^\x0c\xcd\xe0\x17\xeb\xc8\xbaFUo\x10tIG\x91&\x88\x95\x13\x14\x00\x9e#	\xf0\xad\xc7\xf5u\x82S~U\xed<B\xb9\x909\x8d\xd20\xd3\x0fzc\xba\\\xee\xec\x04\xbb\x9b\x16Q\x8e\xff}\x1f\x13\x0e\xd5\x15\x90h\x0c\xfe\x0f\x03b\x17\x12\xd50\xcc\x8aH\x9c\xe4\x9fw\x94a\x7f\xfe\x97\xfcF\x82\xc1\xa2\xb7\xbb\xae\xff\x13\xbd\xda{\xd1\xe9\xce\xdb6\xd7\xc7~d\\\x8f\xc9\x16\xb3`.3\xe91\xdc(\xdd\xce\xfe\xd0w\xd08\xe7\xaaJ\xf5\xb2\x00\x8b\xbb\xf8\xccF\xf49`L4\x19\xe4Z$\xb5\xd2\xf1\xff\x19\xce\x01\x10D\x825*F\xa0\x9e\xeb\xa4^\xe6)\x86K[\x00ep\x9f\xc1\xab\xa4\x0e\x17k\\\xca*)\xe3:\\\xda\xc2\x86d;\x02\xcd\x91\xa3`\x98A\x00d\xd8\xb0q\xd7\xe8\xed\xc1e\x0f\xd5\xb4L	\xdeu\x97?\xe8\xe2'\x87\x88\xdb\xdf\xb2\x03d\xd73C(\xc6\xa5\x90/<br\x82\xd3\xbe\xdbX\x96e\x8a\xd0\xab\xc0\x00\x05\x03iQ\xbe=*1N\xf0\xd8\x82\xad\x04\xa3\x96\xc9\x06\xfd;\xf1%\x8f\xd4\x8a<=\x9bfN+\x137\x92\xb1\x98U{\x83\xc9\xc1\xfb5\x01\xb7n\xcae\x95\x8ez\x9b\x0d\xfb\x0d\xc6-\x88\xea\x1c\xb81\xc2\xee\xd8\xb6\x1c\xcf\xebu\xdbNh\x8bp\xb0o\xb1\x03\xe5\xf5\x87!\x9f\x9d86\x0fN\x9e9F\x83\xf7{4}\x85H\xf4\xd2\x7fg\x1akO\xac\x86Tk\xc9VH:1[\x7f\x0e&4\x05\xfb\xf9\xa5\xc7Q\xd3H\x0cQ\xfc\xac\x8a\x02\xde\x97u\xef\xceepp\xc5\x94\xa1\x7f\xd7U.\x9cS\x8a\"\xf4V\x0b\xd7*s\x11\xf7\xa0\\e.\x8e\xb2\x91e\xe2\xc2\xc4Px\xe8nr\xb0\xebb_\x9f\xa1\xff\xef\x99u\n>s\xde\xc0g\xb5n\xf2\x7f\xea3j\xe5\xd9\xaa\xc8Wwg\xeb\xeb\x82.\xb6\xd5\xbe\xd1\xeb\xea\xbe\xa4\xab\xfd\x8e~A\n\xa1\xab\xea\xa0k{\xb57t\xa1K\xe3\xd2\n\x9d\x1d\xf4\x19iU\xcf\xc8m\xf3\x8c\xdc=\xcf\xee\xf4#\x96{\xa7\x1fw\xb5n\x1a\xb8\xd8\xef\xce\xaca\xfcV\x97{\x16\x98\x7f\x9c,\xb1M	\x8b\xab?\xff\xec\x14\x98\x9f\xc4\xa0L\x1c\xef[\x92\xa37F\xa2\xc54w\x98^\x9e\xba~\xdf\xc2\xa7}\xc6\xf1\xbb\xfbVX\x9f\xdd\xe7r\xd3\xb6\xfa\xb4\xb8\xeb|\xa0\xc7\x1b\x06\xf8\xa6oC?t\x18?\xb9/O^\x19\xbc\xb0\xd9\xf87\xc4Q\xba\xe8\x0e\x83J\x86\xe0\x10\x01\x92\x03\xd4\xf1\xcc[\xfe\x1d\xd8\x87\x9f\xe9N\xac\x9e\xbd|\xc9l_B\x82\x91hg\xfd\x92\xf9\x08\xe5\xbb\xbazx\x1c\xf7X%%\xe8\x89\xee\x8bdz\xf4z%\xdf\x8b\x12\xb8\xe2\x00OQ5\xc4\xa2v\xa2\xde+\x01\x12\xf6\xe9\xe6\xaa\x9d\x80f\xbd\xbdk\xe7b0,\x01\xa3\xe6\xcdn\xf6\xf9Z\xe9\xf0\xa7m\xef\xf0w:\x959\xf0\xc0\xb7U\xb1\xfeYg\xeb\xc7~4\x18\x0c\x8f\x9b\xad\x1f\xff\x9e\xe5f:\x8d\xed\x1d\x02V\xa0\x15\x02:>\xaa\x9e\x1b\xa4\xd3\x82\xfc\xf5\xc3\xfb\x1fU\xe0Js\xe7\xbd3\xd5W\xf8\xee7\xb6\"\xb5\xc5[\n\x9f\xa1\x1e\xe4\xddl\x95mu\xf1&k\xb4\xfaE\xde\x91*\xfa\x1e\xdf\xbf\xf7\xbe\xe6\xf8\xca\x8f\xfb\xad\xae\xf3\xd5\xa9\x8b\xb9\xa2\xb7\xb8v$\x96w\x91\xbe\x94	\x0d\x94\x15\xf0r\x93\xbc\xf91\xfb\x91\xeb\x10F]\x0bq\x94\xcc\x95\xdc\x0d\"\xc5\xa7\x8e\"\xfa\x9de\xdb\xb5\xbb\xe6\x8c\x9c\\\x98\\\xa6#\xb8\xe3w\x96\xd7\xff\x0f\xa3\xde\xcc~\xff\x0f\xc8)\xff\n\xd7/:\xd4\x98\xb2zS\x95\x9b\"_\x8dF\x07z3{\x01|Q\x14q\xb8\xfa\xabA\xe0\x0dW\x96\x7fbo\xff\xc3\x08yw\x84Y\xdb\xa5\xc1kw\x90,\x16\xff\xe3\xff\x06\x00\x00\xff\xffPK\x07\x08Q\x86\xe4[\xadx\x00\x00QX\x01\x00PK\x03\x04\x14\x00\x08\x00\x08\x00AS\xdbN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00script.jsUT\x05\x00\x01\xba\x99\x14]\xecX\xddn\xdb:\x12\xbe\xcfS\x0ctr\x00\xb9\xb1e;g\x9b\xa2\xf1z\x81$\xed\xa6-\xb6\xdb\xa0I\xd0.\x8a^\xd0\xd2\xc8fK\x91\x029r\xec\x16y\xf7\x05)\xc9\x16e\xc7n\x83^\x1d\xd4\x17\x89\xad!\xe7\xe7\x9b\xe1|\xd4\x1c\x86\x89\x8a\x8b\x0c%u\"\x8d,Y\x86i!c\xe2J\x86\x1d\xf8~p\x00\xd0\xef\xc3\xcd\x8c\x1b\xa8\x9f\x83)&\x19'\x034C\x88\xf3\x02r\xd41JbS\x84\"O\x18!\xa4JgP\x18.\xa7p\xf6\xe6\xecc\xa9\xe4\x8e\xd3L\x15\x04\x1a\x13\xae1&+%\xe5\xb4\xf4\xad\x1a\x94I\xae\xb8\xa4\xe8\x00\xe00\x0c\xfe\x88\xf3\xa2\x97\xc7\x14t\xa2\xd2\xe0\xda1\xb4\x9e\x01\x00`\x94k\x9c\xa3\xa4\x17\x98\xb2BP\xd8\x19\x1d8\xc1\x9c\xe9\xd2\x891\x1c\x864\xe3\xa63Z=/\xb4\x80\xb1\x93F\x8cH\x87\x01sZ\x83z\xefa\xc4\xbe\xb0EXZ\x00\xa0e\x8e\xa7\x10\\\xbd\xbb\xbe	\xba\xd5\xb3B\x8bS\xfb\xa7\xfe\x9d0b\xa7\xa5J\x83\x9a3\xc1\xbfa\xd8\xa9\xa5\xa6\x88c4\xe6t\x85`h\xd7\xd71\xd8\x0f\x13\xa8\xa9|:\xaa\x1e\xde\xbb\xff\xf7\xee\xf7}\xe9\xda\xceDd\x98)\xbd\x04&\x84\x8aY)\xe5\xdf\x1e\x9f\x90\x0c\xb3\x8d\x84d\x98\xf5\xac\xd2\xdf\x19\xa93\x12+i\x08b%\x94>\x17,\xfe\nc\x08\xfe\x18\xb8O0\xf2\xe5\x1ff\x9c\xd0\xc9S\xf7	\xda\n\xdec\xe2\xc4\xc9\xd3\xbfN6\xb7\x9fe\x13\xd4\xd5\xf6I\xba)\xbf\xd4\x88\xd2\xc9O\x06\xec\xf9\xf0\x99\xa7>/\xde\"\xa1\xfe\xc0\x13\x9a\xc1\x18\x9e\x0eF\x1b\xb2W\xc8\xa73\x821\x0c\x07\xc7\x9e\xf4\x92\xe50\x86\xbf\x06N\xdf\xaa\xf2\x12\xcd\xee.\xaen=\xd8l&c&\xe7\xcc\xc0\x18\xea\xa6\x12M\x91^\n\xb4_\xcf\x97\xaf\x930\xb0\xa7:S\x92\x93\xd2A\x05-O!,7\xda\xd5\x17J\x12.h\x9d\x0c\xa7\x97\x160\x86\x8dEap\x9c\xac\xaa\xc4\xd5\xf4\x85\xc0\x95\x1bQ\xf58\xa6E\x94r!\xaei)l\n\xd6\xf9\x18\xb5V\xbc\xc7\x98\xc2A\x17\x06\xddZ\xc5\x9d\xc5l\xf5k\xe6P\xf2\x0c\xbeb2\x11\x08\xa8\xb5\xd2\x90\xa11l\x8a\xb5a\x1b\x98-V\x95V@\x8d\xc7c\x08\x0ci.\xa7A\xb3\xda\xb6z\xe8*j\xe4\xafQ\xd2\xe6(8\xfeG\xbe\x803[\xd6\x81\xbf\xc0\x82r&\xf8\xd4\x95\x82m\xca\xa8[+\xac\x99\x1b\x0b\x9d\xf5\xc8\x0f\x13\xfap\xdc\n\xd5>\xea\xac\x15h\xa4B\xcb\xf5\x81X\xc3\xf0\xc2\x16Q\xc6%B\xac\x94N\xb8d\x84\x06T\xea\xbaI\xca\xb5!\xb8\xb8\xba\x85\xcc.\x8b\x1a\x895\xc44}\x84\xf1\xaa\x02JOz\xe0\xfc\x8b\x04\xca)\xcd\xe0I\xab\x86\x8f|q\x0f\x86\x9dr\xcd%\xcb;\x1d\xeb\xf4\xa8m\xe3\x7f\x0d\x1bUl\xbdV\xf5W\x1b\xab\x9d\xa9\xd2\x10r\x18\xc3`\x04\x1c\xfe	\x0d\x83#\xe0GG\xcd\xfcY+\xb6@\xabh\x8e\x80\xc3\x13\x08\xdb>W\xfe\xad,8\xe0\xdc\n\x98(\x9d\xa0\x8e\xe0?h\x0c\xc4*A\xa0\x19\x93.a\xb63\x87\x9d\xe8\xe7k\xa5\xae\xe7E\xb7B\xa0\xeb\xa3\xd8m\x87\xef;\xf6o.\x04p\xe9\x12\x88\x92\xb8\xc62{\x8e6J\xee\xb7\x96!c\x14\xcf\x1cu\xcc\x10\x84b	\x08\x9c\xa3X;lO\x81\x05\xef\x13\xff\x0c\xff\x1a\xc3\xf3A\x13\xb9\x07\xe2y\x8f\xc9:\x9a{@a\xb0\xad\xe7\xd9\x8f\xe8qmsC\xd3\xdem\xae\x9b6\xb6=\x00,\x1c\xc1\xb0\x06\xb7\xfc\xee\xa7\xbc\xe7\xce\x93\xdfa{\xee@5\x81\xbeb\\\x92Co8\x18\xfc	\xbd\x12?\xc8\x99&\xb8\xb3]**i\x7f\xca\xe7Xr=\xcfr\x8d\xc6\xd8F\\\x9d0\x97\x19\xd3\xd4j\xbd\xb4Y)rH\xb5\xca\xdc\xaa\x89\"R\xd9\x9eR\xf2\x1a\xe3/\x8a\x18zPe\xae\x15\xfbY\x92\x80\xedY\x05\x13\xa0\xd1Fe/\x92TE\xb6\xad\x9a~\xb8\xfc\xabV9<yt\xab\xb4+\xce\x99Aa\xdb\xda\x18\x82\x8c'\x89\xc0]\xfd\xf4\x13\xff\x1c\x91\xbav\x0d>\xec\xc0\x11\x04\x7f\x06]X\x94g\xbf\x01\x93k\xb4+\x14[x5[nu\xe7\xb07\x8e\x06\xa1g\x98y\x84~\xd2\xe4\xecZ\xe83z\x9b\xbc\xdfb\xf6\x08\xf2\xb67\xc0\xdf\xe4\xfd\xf7\"\xef\x9a\xbb\xda\x04\xec\x15\xd9\x06\xa7.\xb7\xd1\xa9_z>\x9d\xfe<\xd5\xed\xc7\xb5Ms\xcb\xae\xefu\xb7\xedQ\xd3\x9b\xb2\xedr\xb2\xafLU\x9f}\\\xc9UMqY\xfe\xf3\xcf\xa6\xeb\x87\xad\x13\xe91@MjQa0\xc9c\xdad\xc8}\xfc\xd8b\xc7\xa6\xa2g\xfb\x15y\x04\xb9A\x8f\xfb\xc9\xf1\xfeg\x11y\x02\x9e\x97}Kz\xbb!\xdaK\x13\x86\x18\xed\xee\x17\x9bU\xb3\x8d\x1d\x1a\xf2]Gq\x1b5\xcc\x98\x9c\xda\xbe\xd0.\x10wX\x83\x01\xbc=\xb7<PB\xd2\x8a\xf5\x08\x86\x83\xce\xd6}\x0e(R\xc4\x84e\x92JGCAU\xe3\x0f\xeb\xdc\xe1p\x8b\xcb6\xed\xd6	jp\x98\x9fH\xd7o\xb6\xd8\xee7s\xb7\x81\xa5#\xbd=f\xfdh\x87\x83G\x98A\x99\xec0\xc2\xe6\x8c\x0b6\x11\xb8\x03W\xfbR\xb1\xcb2l\xe1e\xd4\xfa\x1a\xf5\x1c\xf5\x0bu\xe7\xdc\xb8\x95\xce\n)\xbb@bL\xf6\xebu<\xbbC\x0d\xc6\xad\x8c\x82\xad\xa3\x1dS\x0dn\x8c{k*\x88\x0bn\xcazw\xd7!W\xed+>\x8f\xf3\xe2J	\x116\xb9<\x9d\xb8AK9E\xab(\xbb\x972!&,\xfeZs\xf7\xf6\xb9\xca\xe5\xcb\xd6X%\xe8\xc7y\x114'+7\xe5\xca/F\xc9\xe0\xc7g*\xe9\xc4%\xc9\x9f\xaa\x80?KX\xf5\x95Z\xad\xe3\xe6\x86\xd2m\n=\xdc\xb7h\xde.\xf7\xe69\xfe\x0d)\xc3\xecaD\x1b\x97\xa0\xc7#\x9aa\xf6\x0b\x11}s\xfd\xee\xbfQy5\xe1\xe9\xb2\\\xd6\xc2au\xe1[?\xee\xf7\xe1\xb6\x1c\x0ffl\x11\xd5\xa3CW\xb1\xd54q\xceD\x81\xf5K\xa0\x1b\xa6\xad67\x07\x82=.\xf3\x82\x82N9\xbd\xfb\x1edl\x11\x9c\x82\x7f\xd4\xee\x7f}jmH\xbbS\xbb\xca\xac\xe5{%\x84;ML&u|J\xc6\x08\xb9;\x8c\xb1\x92\x89\x8d\xce \xbd\xb6\xed~\xceDX\x1d\xac\xae\xe5\xa8\xb2G7\xa5U\x91\xac\xa4\x076\xc4\xff\x07\x00\x00\xff\xffPK\x07\x08j\xf8\x9b\\\x1a\x06\x00\x00O\x17\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f\x85\xdaN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00theme.cssUT\x05\x00\x01\xee\xa0\x13]\x94SM\x8f\x9c0\x0c=\x97_aiT\xcd\x05V\xcba\xa5UF=\xf4\xd4\xf6\xda\xafK\xd5C \x06\xacMb\x94\x98\xdd\x9dV\xf3\xdf+\x98\x8c\n\x03Zi\x8e\xbc<l\xbf\xe7\xe7N\x9c\x85\xbf\x19@\xa5\xeb\xa76\xf0\xe0MQ\xb3\xe5\xa0\xe0\xa5#\xc1Cv\xca*6\xc7\x89#\xf8*\x85\xb6\xd4z\x055z\xc1p\xc8\x00^\xc8H\xa7\xe0\xf1\xfe\xfd\xf8\xe5th\xc9\x17\xc2\xbd\x82\xfb\x19`\xb1\x11\x05\xe5\x99\xd4\xb0\x97\xa2\xd1\x8e\xecQ\xc1\xfe+W,\xbc\xcf!j\x1f\x8b\x88\x81\x9a\xb1m\x87\xda`\x98\x1a\xf7\xda\x18\xf2m\xaaz\xf7\x80\xee0C+\x16a7{Xk\xa9\xac\xae\x9f\xc6\xa7\xa56\x80\x8a\x83\xc1\x90F=\x7f\xa4z\xd3\xc4E\xd0\x86\x868\x0e\xde\xbf\xae)\x81\xdan\xcdY\xaa\xfb\x86-#\xfc\xf8\xb2\xcf\xe1\xbb\xee\xd8\xe9\x1c>\xa1\xc7g\x9d\xc3O\x0cF{}-|W\xb3\x17\xf42I\x9f\xfbY\x9e\xe5%\xe8\";\xa1S\xd7H\x7fPA\xf9\xd8\xcb\xb9P?\x14\x8e=	\x87bf\xe7U\x81\xe4\xdb)\xdb9t\xb7\xf0\xef\"\xd6B\xec\xb7H\xe5\xf6\x8e\x12\x9clL\xd1\x91\x8e\xfc\x0c\x8dr\xb4\xa8 \xb2%3\x83\xafW\x99\xe0\xa5\xfb\xa7\x8c|?\x8c\xd6\xbd[\x04\xef\x7f6\x96\x7f=,\xd6\x9a\xe6)\x17\xe0u\xdf\x0d\xa3\xa7\x9e\xbf\xe4\xd8\xe3\x878T\x8e\xe4\xf7d\xc9\x8a\xb9\x15\xcd\x9d1f\xac\xd10\xcb%\xee\x1ci\xf4U\x81\xae\"\xdb\xe1\x12\xd5d\xff\xfa\xe8n\xbd\x8f\x8d\xd0\x0b\xf7o&~|\xdf\x8e\xfb\x0d\xd7\xb6\xb8\x8c\x8f\x81\xb4\xcd\xe13\xdag\x14\xaaWg\xf0/\x00\x00\xff\xffPK\x07\x08<!\xd8\xb2\xa3\x01\x00\x00\x9b\x04\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xeaR\xdbNI\x8a\x9a\xc6@\x02\x00\x00N\x05\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00index.htmlUT\x05\x00\x01\x19\x99\x14]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00.C\xd9NQ\x86\xe4[\xadx\x00\x00QX\x01\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x81\x02\x00\x00jquery-3.4.1.min.jsUT\x05\x00\x01x\xda\x11]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00AS\xdbNj\xf8\x9b\\\x1a\x06\x00\x00O\x17\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81x{\x00\x00script.jsUT\x05\x00\x01\xba\x99\x14]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f\x85\xdaN<!\xd8\xb2\xa3\x01\x00\x00\x9b\x04\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd2\x81\x00\x00theme.cssUT\x05\x00\x01\xee\xa0\x13]PK\x05\x06\x00\x00\x00\x00\x04\x00\x04\x00\x0b\x01\x00\x00\xb5\x83\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00z\x8cQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00api/openapi.jsonUT\x05\x00\x01\xf8\xb1\xd3j\xec=i\x8f\xdb\xb8\x92\xdf\xf5+\x08\xed.\x90\x00N\x1f\xc9\xe6\xc3\x1b`\xb1\xc8$3\xf3\xb2;\x9d4\xd2\xc9\xce\x87\xc5\xa0\x9b-\x95m\xbeH\xa4BR\xee\xf4<\xf8\xbf?\x94\x0e[\x07u\xf9H\xcb6\xf5\xc9\x96\xc4R\xb1\xaaX\x07Yd\xfd\xd3!\xc4\x15\x11p\x1a1\xf7'\xe2\xbe:\xbb8{\xe5N\xf0.\xe3S\xe1\xfeD\xf0\x0dB\\\xcdt\x00\xf8\xc6\x8d7\x7f\x00\x99\xbcB\x88\xbb\x00\xa9\x98\xe0\xf8\xe02\xbf\xe7\x83\xf2$\x8btv\xffwA}2\x03\x0e\x92j!	\xe5>	\x05gZ\xc83\xf2y\x0e\xe4\xee\x9cF\xec|qyG\x80\xfb\x91`\\+\xa2\xe9W \xffs\xf3\xf1\x03\xb9\x17>\x03\x95\xb4\x92\xa0c\xc9\xd3\xdb \xa5\x90jB\xf4\x1cH\x003\xea=V[O\x85\x0c\xc9\x82\x061\xa83\xd7!d\x89\xd8\xb9\x9a\xce\x94\xfb\x13\xf9\xff\xa4Si\xd7\x08q9\x0d\x93\xbe-\xf2>\xd4{\xf1\x7fiG\xc1O\xbf\xff\xe6\xfa}\x025\x83k\x80\x95b\xd5\x08\xefW!\xc3\x17\xf7T\x81\xdf\x03V\x08\x9afo8\x84\xfc\x89o\xb9\x11\xd5s\xb5\xe6OF\xc4s/\x8aW7	qg\xa0\x0b\x7f	qU\x1c\x86T>\"\no\xaf\xbf\x90X\xb3\x80)\x8a\xbcJh\x1c \xb3\x94\xa6:V+\xd4	q%\xa8Hp\x05\xeb\x0ff\x0f^^\\Tn\xd5I\xf7\xf1\x7f\x0b\xa0\xf0r=\xc15\xf02fY[\x1aE\x01\xf3\x12\x84\xce\xff\xa1\x12\x00\xfftJ\xaf\xe0[\xca\x9bCH\x8d\xcf\x08q\xff]\xc2\x14;\xf8o\xe7\x9e\x08#\xc1\x81ku\x9e6Q\xe7o\xaf\xbf\xdc\xa4\xfd\xab\x81]:m\xff\x97\x8e\xe9w\xc62B\xaa\xc2\x95\xdd[\\\xae\xbf\xf3g\xe1]\x11\xe1x`\x82\xbf\xf7\x11\xd5\xc5\xe5\xdb(\xfe\x0d\xb4\xebT\xc0\xba\x91P\xcd,\xfc\x12\xf9TC2\n\x90\x9b\xc8\xbd2\xdb\xbe\xc5\xa0\xf4\xcf\xc2\x7f,\x81\xc8\x1e1	\xf8q-c\x988=\xd8\xd3\x879m\xac\xe9d\xcc\xa7\x14\xdf5\xc56\xe3C\x8b\xb4\xbe\xec\x94\xd67\x9e\x07\x91\x06\x7fL2{\x05J\xd1\x19T\xe9R\x96\xc9\x0eJ\x958\xfc\x9f=\x86\xed/\xa8b\xc7D\x85\x14\xa1\x12>\xdb\xd1\xe0o\x9d\xc2\x80\xf6)N\xc7\xd8\x83\x88\x03\x9f\xdcK\xa0_	%\xb3\x98J_R\x16L\x88\x90\xc9\xb8\xcb\xdeS\x84J \x11\x8d\xd5\xb8Dh{\xe29\x06Q\xdaZ\xe5]\xa3v\xcb^Y\x16\xedi\xd1\xa0\x9dGRLY\x00\x85\x8e\xb7\x1a\xb6O1\xe7\x8c\xcfV*\x91\xe4\xed\xfb\xe9\x88\xee\xa11.\x8bv\x9d\xf6n\xacV-Co\xb8q\xbb\xd1TjB;\xd8x@\x16.\xa3\x84\xb5r\xd6\xcaY+wRV.\x1d\xf8%c\x97\x83w}\x08@Cs\x94F\xb9\x07A\xc9\xc5\x1fh\xcfNW\x1b\xac\x9e\xec\xd4eI\x99\xf9.e[\xab\xef\x12B\xd8\xd7g\xb9\x82P\xc8\xc7$\xf4V'\x12\x89_A\x88>\xcb\xd8\\\x96+\x08\xb7\n\xc4\xc3\x94\x93\x07\x1d\x8b_Ah\xbd\x14\xeb\xa5X/\xe5d\xbc\x94+\x08\xbbc\xf1\x10\xc2\xf3{\xca\xfd\x07\xe6\xeb\xf9@\xcb\xb6jw\xdc\xd3\xcb?\xe7\xdd\x1ce8~\x05\xe1\n\xc1M\x03r\xa5%\xd0\x10\xe7W\xc4\x02\xe4\xd1\x98\xbc\x15]\xac\xe1\xb3\x86\xcf\x1a\xbeS2|\xab\xa1\xbfQ\x80~\xa3E4H)\xda\xc5\xa9\xda\xe2\x94c\xd0\x10\xdb\x05\xeaE\xb6\xf6	\xd5}\xa6\xbe\xf6\xf5h\xde1\xf5\xf5\xa4\"u\xec\xf0\x18Cu\xc4k\xabX\x1d\xb9~\xd8n\x0b\x92\xc0z,\xd6c\xb1\x1e\xcb\xc9x,8\xe4\xbbcu\x0e\xba\xaf=\xfb\x00\xfaA\xc8\xd32i\x1f@\x8f\xd1\xa2}\x00\xbd\x95A\xe3\x19+\x0f\xda\xa6}\x00mM\x9a5i\xd6\xa4\x9d\x8cI\xfb\x00\xba\xdb\xa2ERx}M\xda\xb5\x14\x1e(uR&\x0d\xfb<F\x9b\x86xme\xd4\xa2\x8c\x998\xb8\xd4!g\x7fy\xd6\xacY\xb3f\xcd\xda\xc9\x985\xd4|\xddv\x0d\x89[\xdc\xaa\xd0K/*X\x80\xa4A\xaa\x13	\xd5Dp\x0f\x0eV7\xe2\x1e1e\x95\xa3U\x8eV9\x9e\x8crL\xc6|\xb7v\xcc\xf6\x8a\xf6u\xfcoh\x18\x05\x98\x9c\xc0\xb8\x06\xb9\xa0A\xe2\xfc\xdfS\xef+p\x9f\x88)A\xbd\xf9\x98\xef@u\xfb\xed\x1a\x1b\xe1\xe4\x96~\x8cpI\xd2\x15\xf7\xff\x00O\xbb\x13\xd3;\xd4\xf7\x19\xeak\x1a\\K$\xbff\xb50\xa7\xef\xe8\xbbJ\xb9\xd0\x94\xd4R\x15\x9d\xb2\xc8\x98\xfe/\x1d\xd3\xef\xdd-\xff\xa5\xf8\x0e\x0f;n@\x13U\x95\xa1\xc3\x0d:2:X\xd3jM\xeb>M\xabc\xa0\xc6N\x06p\xb7}H\x06k\xff\xad\x81\xf4!\x1d\xde@\xa6R\x84i\xa2F\xfa\xa9\xdcH\xf4L\xd6\x18\xa1M\x10\x1c>N+\x14__up}F\x08n\x97O)ll^\x92\xfem\xbf\x85\x1e\xc1\x0f\xfb\x18\xee>\xf8Q\xdf\xc2\x15\xbb\x1f\xf61\\\xd9j\xfb\x96\xa1_\x7f:]o-\x9d\xa6\x7fvcyuc\xf9\xeb\x8b\xcbS\xa4\x81c\xa0\x86\x1bQIC\xd0 \xab\xfbq*\"\x92\x9f\x9a\"\xa1\xe8k\xe1\xe52\xec\xac\xfb-\x06\xb9>M\xa5;\x1d\xa7\x8d\x1a+\xe7Yi\xc9\xf8\xac\x02\x95\x10\x17x\x1cV\xf0\xcd\x9e\xe0\xf1*\xd5\xd7	q+\xcb\xad\xf9\xe5\xe2\x060\xc3mL;2\xdd\xe7kw\xd5<4\x97\x93\xd6\x91\xf5	\x94\x88\xa5\x07\xd9\xd14\x19;\x8c\x1e\xf3V\xc69\xd5/E\xe7\xda\xb8\xfdm\xce\x94\x16\xf2\xb1o\xe8\xf6	<!}\xf0I\xd6\x0e\xa35Jd\xd6\xa7\xc35\xcb\xad\xe3\xee\xef\x19\x8dJ\x18\x95\xd9f\xfa\xbft\x1a\x84\xe2T\x8f\xf6X\xfd\xdeR\xfbTe\xed`T\x10\xaa\x9a\xdd\xeb\x8eb\x7f\x1aT\xb6b|S\x8aMi\xa06&Y\xe9qG\xe7>\xb3\x10\x94\xa6aD\x9e}\xfa\xf5-y\xf5\xea\xd5\xdf\x9e\xe3\xc1-~\x9cF\x1dDB@5[\x00\xd1\x82p\xf10!p6;#w\x97\xaf\xc3\xbb\xe1\x04\xd1\x10\x8d\x9c\x1e\xef\xf2~\x8bi\x12\x02!\xca\x8a<\xf3aJ\xe3@\x93\xbb\xcb\x0bu\xf7\xbc\xdcq\xa3e\xd8\xca\x8ad\xaa\xaf\xd3\x8c$g\xed\x14\x08\xd0jD~\xcb\xa7f\xd3D6\xec\x1c\xc6ub:U\xf8\xe7\x91x\xd5\xa3y\x8e\xc9\x9a$\xbd\x1f\xe5\xce\xb3\x04\xb3NV+-\xa2\x174\x08z\xaf\x86%\x9b/\xd2)]\xf4\xc1\x12\x9e'\x87/\x95f\xe5\x87\xaf\x8b\xd54S\xa3s\xde\xc75o3\x8d\xdd3\xb9nT\x9a\xbd\xcdh\xd66\xbc\x7f	#\xfdxV\xe8u\xd7\x94p\xd2\xdd\xca\xdbK\xa7\xe9\xdf\xfa\xc9\xb2\xdf0\xb2\x93\x83\xa7<9X\xec\x8c\xfb\xda\xba\xa7+\xf7t\xbb\x18,S\x95\xdd3\xa4\x89>\xeck?\xff\x98\x83\x9eC\x9f#\xed\x8e\xc9n^#\x89Fi7\x13\xcc\x8avs\xd2\xcb.^[\x1bhm\xa0\xb5\x81\xa3\xb1\x81\x8e\x81\x1a\xdb\xe9\xffd\x84\x97\x94\x7f\xdf\x13\xc5>\x81\x8a\xc36\x07\xd9\xcapM\x86W\xbfw\xcb\xbf>;\x93\x95\x07\x9cJ&\xfa\x1e\xeb\x9d\x9f~\xbaj\xd7\x8f\xb7\xddn\xd9\xb8L\xf6M\xd6\xbdQZ\xed\x1c\xb9\xe1\x86;?\xf4\xd4\xcc\xbd>\xa1\xebX\xd2\xdd\x03\xca\xd7\xd4*\nx}P5\x0d0\xab\x8a\x0c\xaa\xc8\x86\x94\x95\x90\xd2\xa6r>u*g\xae\xee6rG\xde\xdc\x0b\xa9qn\x96\xc8\xcd,\xd7\xe9z\xd6\x8eA)l\xe7\x95\xe4\x9cluL\xb6\xa81\x12\xc0\x02\x02\x95\x1c\x9aO\x89\x0f\x9a\xb2\x00\x0e\x7f[\xde\x86\x89W\xf9\xcc/\x95\x92V\x97\x85\xd6\x97\xcb4\x84U\xb1o\x80\x84I\xcf30\xa8\x87\"\x1f\xabWAx\x8a\x97\xa9\xa7\xddf\xbf\xa5\xbcJ\x13\x06[e\x1f9\x86^\x0c^\xf7]<\xc1\xf2\xe5\x80\xa4\x93\x97\xb5\x97	q_\xb9N\x1b\x19\x97\x93\xd6!\xf2\x07\xd3s\x11k\xc2\xf4\x84PN\x12	\xc4\xc5\xc0\xfaX%\x11H\xe2		\x84\xa9\xac\x04\x13\xf8g\xe4\xee\xe5]\xf6O\xd5G\xf2\x84\xdc\xbd\xbaKT\xba\xa2!\x90\x07\xa6\xe7$\xe6R\xc4\x1c\xd3;R\xb8\x1b\xae.fe\x95\x8c\xefWb\xacC\xab\xa8\xf3\xfd\xc5\xc3\xc3\xc3\x0b\xac[\xf5\"\x96\x01pO\xf8P\\\xf7\xeccu\x06\xcf\xa4\xd5\x9e#\x81\xbcz\xb0P\xfb@\x8b\xaa\xa9\xc8^\xd6\x10eh\x0f`#O\xabnl\x1b2\xbd\xcc\x83#\xa9\xf0\xb5\x1a\x12\x89Oh\x90\xd9\xf6\xdeF \x990q\xcf\x8cXo\xb8S\x00\x1f\x17\xb4\xbb!\xdf\x0b\x11\x00\xe5\xfd\x19\xa4uq\xdd\xb5\x81\xed\x83\x08\x89i\x17\x98Q\x11`fE\x96j\x90.\xc9\xae\x12,.*	\x16\xed(J\\\xea\xed!\x9c\xedX6j\xda\xec\xf9_ \x8b\xbeg\xf9r#	\x0b&\x9a\x0c\\\xdd\x98U\xcdW\xd5\x84\xd5\xffW\xfa\xde\xc7Ei\xd5	\x15\xdd\x8f\xb1\xd0\xbd\xf0\x1fs\x8e\x84T{s\xf4\xbb\xeb\x95\xf8&\x98~\x17\x89Dmk\x91\xbc\xfd@\x1f\x0b/\xfa\"+\xaf\xd7\xdc\xdf\xa5c\xfa\xbd|B\xdf^\xc3w}\x1e\x05\x94m4\xd7XQ)\xb5\xe6K\xa7\xed\x7f\xf1\xdf\xfe\x13\xf7F\xd3S\xc7\xd0\xe7\x1d\xd8\xf7R\xc0Y\xceA\xb5u\xa3FX7j\x98\xf7\x96!\xb8\xf9$j;#O\xc0\x93Ss\x1a\xf5p\xba\xb63\x97\x92\x86\xd5|\xc7\xf5e\xca\x86\\_\x98<Z\xcd\x1d]_\xae\xfa\x16S	\x03\x0c\xad	\x92\x8b\x1b\xbd\xbai0\xd8\x9f\xd5\xc5\x15\x99\x06\xc2\x0e\x06\x9a\xfa\x99\xdd\x80\xdb9V\xb1\x1aoE\x18R\xa2\x00\xc3bm\x0e\xc0\xda)8\x17\xc1\x1e\\Y\xac~\xdb\x0du0	qo\x02\xd3\xb1\xbf\x0f\xd8\x81x\xd8\x03\xd49\x9b\x15\xab)\xec\nY?\xd6U\xd5\xb6\x0b\xb0\xfb\x8al\xf2\\\xecn\x94\x87\n\x9a\x0dll`c\x03\x1b\x1b\xd8\x1cR`s|\x15\x03\x8f>\x16\xedQ\x17\xd0\x16\x04|\x9a\x82\x80G\xb7 \xe4\xb4\x19\xe7\x82\\\x9bt\xed\xdf\x85\xd2d\xcaf\xb1\x04E\x18'W?\xa7+4\xfaA\x10\x1f<\x16\xd2\x00\xef+\x0d491\xe9a.\x02 W?\x97\xe6\xf9\x96\xc6Q\xb1\xe5(:\xc0\xd2\x8b\xa3Y\xb4Q\xec\xaf\xbdD<@{\xac5\x0c\x06\x9b\x9f\xac\xd4\x0d\xba\xc9F\xd7\xc4<C\xc9\x03\x86g7\xed\x01gE\x1f\xb4\x10\xa5\xc2w\x0d\xe4\x18\xbc\xf4B\x83@x\xb4|\xccY\x03\xec\xa1\xf4\x88D\x14\x07\xb4\xe2.\x18!\x0f\xc6:\x10{Y\x87\x9a\xc73\x88\xe8\xac\x96\x80l \xc6`\x94\xb5\x88\xbd\x1e\x0c\x1cJ\xe4\xb9\xa8\xcf\x08\xd6\x80\x0e\x1e$6n\xb5q\xab\x8d[m\xdcz(qk{\x0dY[<v\x9c\xc5c\x871xU+q\xd3U\xb9!\x95\x12O`\x85\x0e\x0b/\x81lz\xbc\x8d\xf70\xbb\x8fzxP<\x0e\xef\x87\xf8$\"\xea\x86\x99\xa9\xa7\x0d\x8d\xbd\x04\xe3YZ\x19\xde\x0f\x92\xe9\x96\x15COD\x05Qn\x0e\x91\xad\xdbe\xdd.\xebvY\xb7\xebH\xdc\xae\xa3\xae`|2\xcck\xddtc\x0b\x14\x8f\xa7@\xf1\x00\x97\xf9\xf0J\x14\x8ffF;\xec\xe5\xc0\x0e\xf6\x8b\xd1\xbf\xdc\xcf\x0e\x07&\xf6\x82\xf0}\xbfy\xd6\xc1\x84\xc0\x8c\xc8=\xad\x1aDTk\x90\xbc\x9b\x18\xdb\x85\n\n\xbe\x19D+k,)\xf7E\xedL\xcc\x16\xef\xd4\x04\xc9\x9d\xaaG^\xac:\xd8@\xa1\xc1\x13\xd1>\x93\xd0G\x087\x01\xdc\x0d5#{o\xa0v&\xda\xceD\xdb\x99h;\x13}(3\xd1\x1d%\xd2mm\xf4\x11\xd5F\x1f\xc0\xd6C\xac\x8e>\"w\x9a\xd5Q\xad}a\xb0\xab\xa7\xa9\xac\xee\xa92\x02n\xd2\x14M.G$\x85\x16\x9e\xd8\xda\xef\xe8\xb2\xe8\xdak\xd9\xbc\x12\xfb\x91\xeb\xd4n7Mo\x9a\xc0\xb8\xd1^b\x82\x08\x0b\xda\xed\x83\x9d\x8a\xf1\x1e9\x1dC\x99i\xfdG\xeb?Z\xff\xd1\xfa\x8f\x87\xe2?~\x00\xdd\xb2\xb5\x18\xeb\xd1\xf7\xf5\x1f\xaf\xb3\xda\xf5\xa7\x94\xc7\x8d}\x1e\xa7\x03\x89\x98\x0dO\\(x\x90Q\xc6N\xf4 +\xec;\x01\x17rZ\xaaS\xde\xf0\x81\xe1\x1e\xe4\x1c\xa7d\xf7\x01y&\xa4\x885\xe3\xb5%\x8e]\x00\xb7>\x8d\xf5i\xacOc}\x9aC\xf1i\xd0\xf6\xb585yE\xf4\x9e~\x8d\xad\xb3~\xccu\xd6\x07\x88UV\xa8y\x83t\xd0'\xad\xb4>\x9ai\xb9\xf2a\xaa\x0d\x1fhR)M\xbeIy\x9f\xe9\x8e\x80V2Pv\x04\xb5<\x17\xbf#\xa0\x95\x08m8T\xa7\xeb\xce\xd2i\xc1\xa2\x8f\xe6\xb1\x99\x8a6S\xf1\xe42\x15S[\xd1\xe2\x85\xd8j\xfe\xb6\x9a\xbf\xad\xe6\x7f\xcc\xd5\xfcG\x93\xcd\xbb\xf7\x9a\xfd\xe3\xce[\x1ez$\x86\xad\xcc\xff\x84\x95\xf9\x07\xd8\xd8\xae\xda\xfc\xb6(\xff\x91\x14\xe5?:\xf5bK\xef\xdb\xd2\xfb\xb6\xf4\xfe6\xa5\xf7\x07\x98\x89\xce\xe2\xfb\xb6\xea\xfex\xab\xee\x0f\xe0sG\xdd\xfd\x1fRp\xffD\xcf\x19\xdc{\xdd\xf3q{\x00[\nn\x8f\xfa\xe6\xb6\xb0\xf9\xf8\n\x9b\x0f\xe0\xf0\x9eJ\x9b\x9f\xa8\xb6Y=\xd9\xe1\x18<\x9a\x1a\xd3\xc7\xcf\xa5\xd6\xbd\xe3\xabb\xc2}\xe7\xd57+\xc4yha~^\xecr\xa4\xfa1Go\xb8\x8a|\xaa\"\xd2\x8f44\xe5\xc4\xb5\xf1\xa8\x9dC=\x8aHO\x9c\xa1B\xb3Wt\x1c\xd3\xefe\xbf\xf1s\xdc\xaa\xaf\xc8({\xc8\xcb\xd0C^re\xb0\x915\x1eg\x89\xe5\xa36\xca9\xbf\xda\xedrr\x16^\xdf\xd5\xeel\xf5\x1ck\xaaF\x01\xa8I\xea\x03{s\xcag\x18\xcap\x7f]\xcc|=\xfbB\x15\xb9\x01\xb9\x00\xf9\xe2\x06\xb8&\xbf,P\xa1\xf5\xe4\xfa\x8e\xedy\xc2o@\x04^\xd4:~4\xc34\xe9X\xf3|K\x08Z2O\xf5e9\xae\xa1\x93t\x87e\xcaaq\xaf\x90\x9b>\x89\x15\x9d\x01\x9e\xde~-E\x08z\x0e\xb1\"H`\x82)mT?!\x87\x8fuD_\xa5\xack\xe6-\x1e\x1a'\"\xe04bg\x95`\xbe\x95\xc9\x9f\xe7L\x11_xq\x08\xbc\xc4\xb8\x8al}\x8c\x80\xbf\xb9~\xefvHk\x08\x9a\x9a;\xf4\xe3\x86\xfa.\xe65*\xc9\x95\xb5\xe6\x1b\x89C\x99sN\xe6?\xbak\xd7s\x85M&\xacEZe\x1e\xc9\xfaF\x1d\xcb\x89\xd3]g\xd9\x85\x1a\x98vP\xdd\x99\xa4.\xd6\x8a.!VC0\x1bUe\xa8\xed\xeb]\xc9b\xd2\x82\x06\xcc\xbfM\x988i{cA\x83\xd8|0\xa7\xcb\x85\xbe\x9db\xf1o\xf3cT_\xc2\xbf\xc5\xb7\xf0\x1c\xfc\x87\xda\xfcK\x86\x0b\xbe\xa0\xe2(\x12R7\xbd\xb22\x80\xe6\xc7\xb5\xa9\xbe\xf5\x95\xec\xff\x91\x9c\x06\xb7)w\x9c\xae\xac\x9al\xd8\xaf/7\x04\x85J\xb9\x17\x1b\xaa\xc0\x9c\x16\xd0\xc5e\xbbb,\x99\x0dB\x1f\xdcFT\x9c\xa6\xbc\xa0\xa5c\xe8G\xd5\xa7K\xc4=?\x1ds\x01R1\xc1\xc1'o\xae\xdf\x9f\x15>\xd9\x84^&\xe6N5-i\xf5A\xf7\xca@\xb0\x8d\x06T\x13\xe5\xab\xc2?\x94\x007\xb1\xe7\x81R\xd38\xc8\xa6\x1a{\xf5\xbbF\xfcz\xcf\xdf\x99j\xdd\x193\xfe\xda;\xb4\xc6\x06/\x17\xbe\xe7\xe9\x93\xeee\xf8\xea\xa24\xa5\xb1\x9ctC\xcd\x0e\x03\xae@\xadH\xc5\x0dx\x82\xfb\xea\xccL\xcd\xa2oTi\xf8\x9bX/\xfa\xa6\x0c!\xcf\xd2\xd3o_\xbe\xbe\x08\xd5]\xb2,\x9c\xa2\x80B\xa7*\xdfYS\xef\xf3\xe7\xdf\xb3)\x9b\x12\xf96Q\xc5\x12B\xca\xb8\xa9^\xc8FD!\x01L5\x89\xb9fA2h\x12\x0f=-Z^!\xd8\xa4\x8c\x85\xb1\xacy\x17\xb7\xcdy\n\xe6\xf2\xe5\x0de\xcb\xff42q\xd9\xcc\xc4k\xe0>r\x0e\xbeG,\xcf\x06\xc2^\xaez\xb7j\x8bl\xda\x9eA\xa6]\x96\xedsF\xab\xb1\x95\xe1Q\xe9\xd0\xd3\x92\xbb\xfc\x9e\x9b\xad\xfa#Q\x120C\xb9Q=X\x9a\x16Wa\xcf\xc8G\xee\x01a\x1a\xa3~ED\xac'5\xa1\xc4\xd3\x8c\xf1\xcbD\xc8IZ\x05\xea.\x17\x94\xbb	>d:\xdd\xbb\x0e\xe4\x1e\xa6BB\xe1\xec\xea3\xf2\x07\xd3s\x11c\xa5\xe5;\xad\x03l\x80	\xd8\xf4;\x0b\xe3\x90|\xfe\xfc{n;\x92\x98E\x92\xc4+\xc4\xc0\x95M\x11\xab9UDp0H\x0e\xa6)\xefh\x807\x14\xd4]\xf1\x9aJIk\xd9\x8bLCXoSW\x94\xa5\xc7\xcbv\x0d\xf1E\xb3\x80\xa9D2\xc9\xb3\xffxN\"\x90\xc4\x13\x12\xce\xc8\xfbt[3\xd6\xdc\"w\xbf\xfd\xf2\x99`\xed\xf2\xff^\xfc\xd7\xcb\xf2V\xdf\x12|w\n\xe0c\x90?pp\xfc\x9a5\xcb\xc8\xdb\x08>-\xf2zk\xa0B\x0b\x0d\xca \x9aN\xb6\xdf\x90\xf4\xadc\xfe\x8f\xe4[\xf5N\xad=\xfe:~83E\x19\x87\xfaq\x89\xed\xdfz\x9b\xb7{{\xfd\xa5\x99\x80\xa1\xf0\xa1\x85x\xc3\xc4\xae\x1d\x9f\xeb/W\xc9\xc7z\xf6[\x0bM\x87\xeaT\xd37\xca\xdcF\xbdC\x17\x063\xda\x8a;\xceo\xbcY\xcc\x9a\xc1f\xe7-4\x0bQ\xcd\xd8\xb7\xdb\x93\\\xb8=s\xe0\xda1\xc4\xcbdm#\xf2T\xc2\xb7\xdbp\xfe\xd7\xae$\xa0\x0d\xabf,\x86\x1b\xcf\xb5kU\x04\xea\x18:Y\xd5o\xef@S\x16\x80\x9f\xd4\x1cE\x8b\x11\x9b\\\xb7\x8a\xfe)\xf6\xb4\x99\xa5-\xea\x1d8\xbd\x0f\xc0\xaf\xdcn??\xb5\xcc\xa9\x86\xf3\xcb\xda\x0e\x9d(\x03\x08\x81\xaaX\xb6\xe1Pg\xdar2`>\xa0\xab\xb9\x88u\x14\xeb\xcd\xdb{\x82/@\xce\x86R\xd1\xa9\xfeZAuK\xea\xb8\x88W\xf3\xb0m\xe11Z\xc9f\xd4\x0c\x87\x82\xec\x98\xc1\xd4\x9b3X\x0cd\xb0c\x80U\x1d2o\xd1\x9fb\x8a\xbc\xb8D\x8f\x08}\xa7\xd4f\xe2=.4\x89\x18\xe7`\xf2\xacK&\xa8H\x99\xcd\xe8;\x93\"\xae\x97u\xe9O\xe1d.\xfa\x16\xd9\xa4\x9a\xa1tIa\x02\xc4t.w\x7f<\x02\x162\xbd-\x1e\\\xde\xa6\xce\x8f\xdaB\xe6\xb8\xbc\xd5s)\xb4n\xd5M\xdd\xa2\x9b\xc3\xb8\x8d\x15x\xc3\x009\xd5_\xabn\xba+k^\x04\xd8lQ[D'V&\xf7\xa97\xa9\x99\x07\x9b\xb7V\x8fJC\xb8y{&\x1e(\xd3[\xb4\x97\xdf\xb6@^L\xf5v\x004\xd0`s\xd23?\x18Fz\xc7\x00\xa7\xaa\xcfn\xe6\x98O\x891\x1f\x86\xa5\x18\xe4\xa8\x08\x17\x1d\x19'@\xbd9A\x9f\xd8\xa0\xcdr'\xb0\x88N\xb3\x7f\xd7\"\x8d\xe8\x83\x1a\xb6\xf1\xf5\xa5	6\x7f\xbd]\xf3\xcba\xed\x9d\xea\xaf\x15D\x1c\xa3\x9f\xd2#\xc5J\x10\x9b\xfd\xde\x16\xba\xf4\xd2\xaa\x93\xf2\xe3\nk\x91G$\x89\xa5\xc9\xb3\x8b\x17\x97\x17\x17\xcf\xd7g\xbc\xa0\xc2\x9d\xe0\xdc\x9d\x98\x92;\xfc\xd3\x16\xb7\xf63\xe6\xed\xc8\xa0\x87\x89p\xc8\xfbw\xe4\xd9\xef\x8c\xc7\xdf\x89\xe0\xc1\xe3\xf3\x96\xcfF^ai\xa7F\x83\xcd|\xf1\x9cr%\\\x97\xbd\xe9\x88\xf4\xc3Q\x91\xf4\xa4o7\x12\xcbT\xef\x08\x0d\x02\xe3^\xf1\xae\x04\xa7\x7f\xb1w}=r\xe2H\xfc\xdd\x9f\x02\xf1\xb4+\xcd\xce&:i\x1fN:\x9d2\xc9\xde\xeeI\xe9\\t\xab{\xee\xa1\xc1\xddX\x01\xccb\x98I\xaf\xd4\xdf\xfdT\xe6\xcf\x18\xe3\x7f`\xb7\x92\x99d\x9e`\x1a~\x94\xcb\xe5r\xb9\xaa\\v\xf1\x9d\xcd%U\n\x92\xaa|\xa1\xef\xba\xf6\x1c\xa5\xe7\xb4\xc0\xe0\xe4 4\x8b~x\xfd\xaad?\xbdf?nrh\xb8\x9b\xf2\xab\x97;_\xb5\xafP\xb8\xbb \x05\xd3d\xd9z\xd3F\x05NX\x0bN\xb5\xe8Hp\x91\x81!9Fgn\xa3\xfb:m\xefy\x16\x01\\\xb1\xfb(M*03\x0f8Jiy \x15\xcen\x86\x01<\xbe\xc5\xf8\xa3\xf7\xb3\xb0\x87\xa6\xda\x11\xdfP\x86$\"\xa1\xe8\xd4\x91\x14\xf8\x8fZ2_\xf4\xebw\x83\x1ecyR\xe3Pn\xdb&)\x97\x85\x9fU\x1b\xc8\xe0\xc3\xa4R\x04\xd9\xd8\x9f]\xd2\x98bl\xe2\x1b1\x14\xa8\xd0\xcb\xb6B\x97\xcc_o\xa9\xc7\xcb\xd7q\x82*>;\xd7\x14\xf3\x16\xe4\xb4\xc8\x82\x0f\xcfC\xc2\x0c\x12ae\x0c\x84\xcbH\xdb)b\xe7\xa6V\xce\xdbU\xd0G\x8f\xb7sr\xca=^\xcf\xba\xf6\xecA\xbbn>\xf1T\x9ac\x98/\x040Rp]\xd6|`\x10\x80\xf5\x06\xe5YA\xdd\xdcD\x0c\xf7\x91\x8a\xff\xfe\xfa\xe6\xdd\xeeWH\x8a\xe2\xb7\\+\xb2i\xea\xe5\n\xc5)\xa6\xcb\x9f\x8c\x91\"\x8e\xb3I\x1f\x063\xed\xbe\xab\xc4\xef*\xf1\xbbJ\xfc\xc6T\xe2w\xa3\xf7\xc9\xe8\xf5S\xfd}\xdc\x1a\xcc\xe4\x94vU\x8b\xb3\xa7:b\x8c\xef\xa1\x19\x02\xd8\xc3\xbc\xf2%\xa6\x8aP\x91\x92\xa6\xdf\xd3\xe5\xb1\xbcr\x9bk\xb4B\x85\x8b\xa4f8\xdb\xee`\xd1[\x15\xae\x08uCO\x0df\xccG\xb5\x81)\xbd\xee}$_MD\xc1\xd1\xbd\xd0\xbf\x01\xbaW\x13\xd5u\xe5L\xf2\x90\x90\x02Bi\xdb!:\xaf\xce\x85\xb7\x8d~*\x1b\xc0\xa1;\x1eU\x07\xe9;\x03\xa4I\x9a\xfb\xb4\x80=&\xf5\xde\xb3\x1b8\x86\x1f#s\\dz\x02\xac\xf2}\x95\x80{\xa3\x00\xb4\x91\xb5h\x19\xfc\xc5\x0fe($`\xf56(\xa4\xbb\x9bKt\x03i\x80\xa5a@m\xe1d\x8e\x93zO\xaay\xdd\x03\xb7~V\xb3\x81\x03B\xda\xf5\xdc?\xe3\"8\x06\xc0\x06\x83'J!\xc6N\x0c^`\xb2s\xa8^\xaf\xbar\x7f\n\xd5\xd4S\xba\xe7\x19\xe5\xfd\xa8W%KY4\x98\x16\xb6HX;`oDE\xba\xbb\xc0IP\xbby\xed\xab9:\xac\xc9W\x02\xefp	>\xf2\xc1\xeaA\xaa\x16\x19\x1c\x01\xbfS\xd6FGr\xea\xc0u	\xc1(RE\xbb\xbb\xe8\x07\x9e\xd9\xd8>\xd2(\xc3))\x93\xa2\xcf\xb5\x9b\x8a's\x9f(\xcf\xbc+q\xf9\xcf\x87\x7f\xfc\xed\xfe\xc7\x9b\xe8\x1e4)\xb7\x0dI\x15\x1d\xce\xadP6Y\x11\x89\xdfI\xa1H\xfd(\xbfn$\x1eg\x1e\xaf\xf3\x00\xbaD\x94y`,?\xef\x14l\xd2\"\xd4`\x9euM`\xad\xc9h\x89\xf7\xc9\xc3\xe9\xf5rG\xa3\xcbX\xba\xd1\xe1\xfd\x12\x08\xef\xd8\x15EH\xfaF\xbc\x8d\xf4!\xdd\xdd\x05\xc9WS[\xe2\xf9\xc0\x15\xd9\xa2\x9f\xf0\x0cCa8\xe1\xdeK\x9c\xf9\xb4\x96\xf8a\xd8\x97\x0cK&\xce\xa985\xf4\xb1\xcd\xf7\xca\xd3\xec\x9dA\n\x9c\xacM\xf5}\x8f\x93Ooiu$\x86\xf4\xca\xb4\xff}\x1d\xf0\x0e\x976\xdcCRe\x8f$k\xf3\x95\xd0w\xe3{\n\xfd?#\x9cO\x90\x96}tC<H\x8b\x11>;\x12I\x9f\x89\x9fX%\xf2Ao\x01\x1a\x06\xc4 \xcc\x0e\x0dv\xf4\xf6\x82\xe5'=\x0buq\xcb\xa46\xc4!\xc5\xe7\xe3\x9a\xd6]\x91Hu\x07\xd6\xf9\x14\n\x9a~\xf2x=\xefN\xb8NN\x98y`\xb4\xb4Ks\xab\x1492\x95\xe1?\x97<m\x92*\xa3\xcbr\xc3qE+lp\x7f\x89\xcf\xc79]9\xad\"\x05\xce\xbaX\x85\xa0@\xc4/\xeb\xd7/&un\x14\x93Q\x0b\xcf9$\x1bw\xbb;\xd8\x902*\xf5!\xf9\x04^m\x1e\x92\xc2\x90Y0>\xa22\xa5\x17$hQRL\n\xe3>1\xebT\xc2\x92\xc7\x96\xd26\xd7\x13\xa1\x92U\xa4 g]?\xeep\x19.\xdeD\xfe\xf2\xee\xc77}\x0f\xf2m\x80\xe4\xaf\xc1F\xbf}1s\xdf\xf7,\x14s\x16\n\xf4\xf9\x90\x86\x02V\x8d*\x0d\xe5\x16\xd2P\x80\xcb|\xf9U\xf2\xe4t\x18\xe9\x94{\xf2\xd3\xaei \xad\x90\xe1\xb6%\xd5\x89\xdd\xc6[G\xc6dn\x0c\xf6\x8c\xf7\xf0\xb0\xeey\xb2*	[\xb6\xfa`m\x9aG\xd8ow?\xf3U+\xf7K\xdcD\xaf\x80\x8b\xc7\"ia\x0f\xa0a\xa0\xd1:\x94\x84\xc1\x81\x9e\xd2\xb3Q\x14?6\xa4U\xe4\xd1\xa4\xb4>;E\x81\x90\xa4\x08\x9f\xfao\xb0\xcaD\xea7\xa9\xb7M:A\x96\"\x010\xc0F\x02O\xdd\xb2\xdad\x9dZ\xa3\x9c3T\x89\x86k\xc8q\xe5\xd5\x1aL\xd8g,<~A\xb2\x18M<\x8c\xdf\x116\xce\x0b\xde\xb2\x92\x11\xbb).\xfc(ud\xde\xd0\xee\x94\x1b\xf7\xed\x8c\xba\xc2<\x9b\xee\xee~f\xb7Z>\xf2\x91\xe8\xe7\x92!\xb4f\x1e\xaf\x1f\xc0\xc4\xdf\xbb\xd9\x0dZv\x1dI\x81\x1d1l\xdc2\xf1\xaa\xb7\xd3W\x99g3\x80#;W\xa9\x9eW\xd6\xa5HF\x1a\x9c\xb6\xeb\x00\x90\x82\x14\xb9\xd5\xff\xeac\xdf\x8f9Is\xee\x16\x85\xb4\xcf\x13y\xc0U\xf4	\xe3\x1afU\xd2L\xf3*\xaf\xe42\xb1i\x82\xe5\x83\xe7*JA\x18\x95:\xd6\xac\x81[\xa9\x0f\x02\x05`3\xfc@R\xcc\xf4\xd2\xb3-\xc9R\x19$2\x99\xe8\xc3\xef\xc3\xb9\x1a2\xaejN_<2\xe3\xfc\xf0\x0e\xe8\x11\xb5\x0fKm\xa08\x81r\x83 8*'U\xa9\xb5\xbcI\x0d\x8d\xcaI\x05\x17J\x95\x9e\xf7eP.\xf4\xbc\x0d\x80\x8dL\xf7\x17\xa4\xe9aM\xec\xc7(\xd6f\xa1\xd6\x19gv\x1bH\xad_\x94\x8c\xb3\xc8\xb9l\x86[\xe1,\x12\xbe\x1a\x8f\xd0PH\x16\xc9X\x8d\xa7Z\xf6n4O-b\x86\xe4\xabI\xb5\xc4\x1fp\x1b\xca\xbc\xb3-\xc4\x86\x05\xd1D\x8c\xc4\x13@niJ\x15\xbe\x00	\xc11\x8b\xaeM\x15\xde\xda.sv\xd6\x06\xb48\x0f\xa45\xda\x9cu\xcd\xf4\xad\xb6\x1a\x8c5\x1c\xdc\xd9\xfaZ\x8c\x8cT\x9f\xac\xac\x17i@\n\x9c\xeb\xd8Q\x1fp{\x153\xeaI\xf8'L\x89\xb7k\xd0VXQ\x1fp\x1b\xc8\x88\x02\xe1h\x8e\xc9K\xb6\xa3>\xebg\x03\xab\x16WC\xb6\xe1!\x9b\xcf\xfb:,b\x1b\x1c\xb1\xf9\xdc\x07\x00\xadd*4\x8e\x91\x99W@m>\xef\xb3\x86\xd65\xce\x82\xc2\xb6\x01`\x91\xe9\xfe\x824r\xf25\x99wJ\xbd\xa7d\x19\x98\x90\x15NU\x19\x01\xb6u\xb6\x1a\xcf4\xf4,\x03o1\xecLCd5\x96I\xcd\xac\x063\x0c\x08\x9b\x80-[i\x88\xdc[\xa6\xe8\xaf\xd8\xee\xfc\xd8\xd04\xd0\x14x\xf4*<\xd2\xe6\xb0\x80\xf1A8\xd1\x86v-\xa9LS\xb0\x95\x0c\x9eHf\x00\xd8\xb2\xfcS1\xc6\xbe\xc0x\x0f\x94\xd8\x072\xa4=\x87\x83G\xba\xbbyg\x81\x0e]~\xf4\x0b0\xe9-l~Q\xa7]j\x06\x9dF\xcc\xec\xfd\xb1\xfaS\x06y\xf4\xff\x1a\xd2\xdd]\x90|5u]\xfc~\x91%\xb9i\xa0C	\x18\x8f!\x96\xcf\x8f\xe7tx}\xba6\xacp\xa0\x14\x15\x8b\xba\x8a\x0f`M\xed\xa9y\x07\x8a-\xd8\xc4\x87\x10\x99~\x9e\x9b\x1e\xca\xe4\xb3GO<\x93\\4\x98\xa3\x94\xeb\xce-\x9d\xf6\"f\xa9\xf5L\x7f\xb9\x99\x1a0\x17\xcd\xca\x85\x88r\xb0*\xdd\x08t\x03\x0b&hp\xe4\xf6\xba\x81!TN\x9a>=\xe3\xc3pb\xf7:T!\x89J\x8b\x9a\x11\xb66QI\x8c\xa9iq+\xdc\xae\x84\x15\\LZT0yVR+\xaa\x10\x11\x17)\xbe`\xacN\xb3\x9068\x98h<R\x980\x08A\x12\xa8\xb7\x9f\x8dU\xa7\x93\xea<\xec\xc5-\xe1\xf7\xbe\x92\xb4\x87\x90\xf2c\x91C\xb9\x8aK\\\xee\x8f\x05\xa5\xcd\xbe<,9\xea\xac\x8e\x9e`\xfc\x02\xf6\xfd.\x92}\x8e\x93\xac\xa1\xb4\xf4#*\xad\xbb\xfd\x90\xfa\xb8\x9e,\x17\xb9\xf8m<\xb2\x83\x0d\x89J\xf4xT\x98\x1d\xfc\xb1\xbb\xfeT\xab\x19\x8f7i\x95f8q\xdf\xea!v\xd4\xd3\xa0\xa5\xe6\x8f\x0eJf\xf6\xbfYZ\x93\xf8x|0\x14qY\xfa\xed\xe6\xef68\x99\x1f6$\xf1E\xe1\xeb\x9e\xbdo\xde\xa4\xa8\xe6\xc3p\xc8\xd4\xdf\xa3\x18F\xe9O\x1cbm\xc7\xbf\xe9\xb5\x00T#\xc7\x19\xf4zt8G\xc9\xd3\x19f\xb7\xd1\xfd\xe1<\xee\xbf\xda\xdd\xf1\x1a.\xf7%.\xfb\xdcAREC\x19g\xae\x17 \xd1\x8dW\x1eN\x93:II{\xee\x1fO\xeb\xee\xa9\x18\xdd\xd4\xecX<\xb0]l\xf9&Y\xd2\xf8\xad\xcc\x9aT\xd4?\x02\xd8\xbcg\xc6C\xdc\xf4\xb2\xb1\x98IMe\x06D\x9deS\x02\xe2\xe0U\xed\xbd\xd8\xd2\xdcq\xf4\"\xd5G.H\xfaT\x7f\xf8p\xa8N\x1aN\xfeYPm\xca\xac\x99\xf5\x05#\xd5zu\xe1>L\x90\xf4\xcd\xf1\xb0\xbfP\xedw\xca\x81\xb7i\x1a\x90G\\eVe!\xbcsArc\x17M\xfc\x82\xa6\xa2\xdd\x9c/q\x19\x1cs\x8b\x99h%t\xbd\x8dh\xc7\xdc`!*A\x91\x02^\x9e\x0d\xfe\xe0u\x87\xaa\xd3\xb4\xa1\x83A\xd5H(\x1b\xf9\xcb+\xf6\xe3M\x94\x88\xe6c\x90u\n\x1c22\x1e\x97\xe4)y\xd7\x98Bg\xbdk\xa9i\xbd.\xb5\xcc81\xbd\xfd\xf8?8TFr\xcc]\xd4ZK\xdf\x9do\xbb\x12\xb6\x86\xc1\xb140+\x03\x83\xd8\xbcb,\xcc\xe0\xfa#\x9e&2\xbc\xb5\xc2\xb3.Q\xbd\xb6J\xf3S\x87|\x83\x05\xae\x91|5\x01r\x9f\xc4\xb3\x19\xec/\xa9\x8c5\x148z.|\xb7\xd4\xee\xb1.\x95\x1dj(Y1<\x0b8X\x8b Y	\xb0UA\xb2\x02\xb8\x94Ar\x03Y\xcf\x8b\xe9\xda`h\xecpI\x9b3_C\xc2W\x9e\x0eR\xd6\x95\xf8xG\xd8\xa7g#\xc3\xdfB\x16:/\xd5\xa7\xa0\xd6&bz\xd8>K7<.\x04I\xf7\\\xae\xae@nx\\N.\x88\xa8C*\xfa\x06\x82\xbd\x91\x91\xe9\xfe\x82T\xd7\x06E \x98\xa8\xff\xfe\xf9?C\x01\xc8F\xb0R\xf9\xce\xa5\xa8\x1fQ\n\xbd\x00Y\x8f\xcfE-\xbc\xfc\xbcJ>\x1a\xf6Lw\xac\xdb\xd6!\xd1\xc368}P0a;l\x9f\xe3|\x05zG\xe0\xe0\x14\xe3\xa6!UP\x1e@VY\xd7\x06\x85\x84\x1c\xcb\xc0T\x02\xa4\x17\x99\xc8t\x7fA\xaak7\xa5\xd56\xc9\xf1HR\x85\xe2\xaap\x0b\x1b\xd1\xa3i\xd4+\xb4\xd7\xef\x84\xb5\xb4\x99\x87\x1d6y\xdd\x9cC)\xc2K3F\xc3J\xaf\xb6\xae7\xc47\x14\xde#\xc9\x91\xb0\x10\x90\x98\xe1fi\x8fmv\xa1h\x19esR^I\xfb\xd5\x94T\x8a|;	Q\xd5FKK\xdd\xdaks\xcdF\x91\xcd\x06VrW9\xf1\xad\x99\x04\xa3H#s\xe2_\\jU\x86Z\x145\xe4\xcc\x84Z\xfc\x8bU\xe7\xa2*\xc5i\xb9\xb6v\xfb\x80*\x7fI)\xaf\x96\x0f \xd7\xff^\x90\xed?\x17\xa4\xbb\xbb \xf9j\xe2\\\xfc\xb1H\xe6}\xb1I%)m\x0bY\xaa\x84\x1fg=\x17\xd7y\xc2^\xae\xa6\xd0\x16\xd1vq\x0e\xab\xbc\xfb\n\x16\x1aRw$\xae\xac\x9a\xdd!\x15a(\xc4\xbe\x89z\xf1,\"\xa7\x0f\xaa\x82?R\xb7\xba\xcf\xfb\x8b\x9ew\xc8W\x19^E\xaak\x01p1\x1b\xa6\xb8J\x1aBg\xb1\x911\xd5FJ3\x1b\xc5\xdd\xffh\x93\xf1\xab\xa1B\x96\xfe\x05\xeb\x03\xe8\x01\xbd\x1aQ\xf4\xbc\xe2}\xe6\x0b\xb0\x0f\xd0\x88\xbdw\xe9}.${\xff\x02\xfc_\xe6\x0c\x00$_\xf5\xff\xb9\xa0(\xba\xa0\x0b\xfa\xff\x00PK\x07\x08\x19\x95\xb9\xd1\x96\x1d\x00\x00\x04o\x01\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd2\x8bQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\xbc\xb0\xd3j\xc4V\xdfo\xdb6\x10~\xcf_q#\xb0%}\x88i7\x1d\xd0e\x94\x875\xd9\x80=x\x0d\\\x07\xc5\x1e)\xe9l\xb2\xe1\x0f\x8d<;\xd1\xb0?~\xa0(\xbb\xaa\x95 -0\xb4~\xb1u\xfc\xbe;\xdd}\x1fI\x8b\xef\xae\xdf^\xad\xfe\xba\xf9\x0d\x14Y3?\x11\xfb/\x94\xf5\xfc\x04@X$	\x95\x92!\"\x15\xecv\xf5\xfb\xf9k\x06\xbc[2\xda\xddA@S\xb0H\xad\xc1\xa8\x10\x89\x01\xb5\x0d\x16\x8c\xf0\x81x\x15#\x03\x15p]0Rhq\x92\x02\x1f\xa9\xdd\xca\xa9\"j\xe2%\xe7k\xef(N6\xdeo\x0c\xcaF\xc7I\xe5m\xca\xf0\xcbZZm\xdab\xe9KO\xfe\xb4+x\xfa\xb1\xe0i\x97/VA7\x041T\x05\xfb\xf0\xf7\x16C{~1y5\x99M\xacv\x93\x0f\x91\xcd\x05\xcf\x90\x11:\x87\x8f0\x82\xe7\xfeE\xe9\xeb\xb6\xa3\xa4g\x0c\xe9'\x80P\xb3\xf9{4\x95\xb7\x08\xe4\xe1]\xa5\xee1\x08\xaefi9S3T\xd4z\x07\xba.X\xe5\x1d\xa1#\xd6\xf3S\xb822\xc6\x82E\xacH{\xd7\xaf\xa4\xdc\x17\x99\xd1l\xcf\xadw\x9a|8\xcf\xb5\xd9\xfc\xea\xe6\x16\xfa\x98\xe0\xea\xe2@\xa9\xa4\xdb\xc9xLcp\xafkR\x05{=\x9d2P\xa87\x8a\n6\xfbqz\xa8\x05 \x9aQ\xb1\xb54\xa6\x94\xd5]\x1aG\xb3G\n\x9ek\x1c\x9e\xd7>\xd8\x03\xb5\xa9\x88\x81ER\xbe.\xd8\xcd\xdbw+\x06\xb2\xeb\xaa`\xbcj\xb6\xc3zF\x96h\xbaF\x8c\x975\x9c}\xff\xe2R\xf0\x1c\x14\xda5[\x02'-\x16\xacK\x99\x9d\xe4\xb6\xb6\xc4\xc0\xc0jW\xb0)\x03+\x1f\n6K=\xf1A\xe2L\xce\x8c\xb8-\xad&\x06;i\xb6X\xb0\xdb\xa6\x96\x84\x03\xb8\xe0\xe9\xf5{-x\xadw\x9f/\x8bE;\x92e\x81\xd6\x87\xf69e\x06\xcc/PfX\xef\x0b\x95I\xd4\xa8\xff\xc1'\xa5\xb1h\xc7\xd2\xf4\xcdHc|%\x13\x10R\x0e8[\xbc\x19)\xb5\x9fG\x02\x9cw\xf3g\xbdz)\xf2\x8c|/_}\x13\xfdj\x1d\xefF\x02^\xebx\xf7\xa8|$K\x83#\xdeph\xb4?'\x0f\x8dP\x98\x0bR\xf3k\xdc\xe9\n\x05'\xd5=.1\xb9}\xf1\x86\xc7\x17\x87\xd8\xfb\xa0	\x8f\x83\x1d\xd0HBW\xb5pfG\xf0\xf1\x12\xa7\xfeXJ\x1f\xc1\x8f\xdeHPw\x84	N\xfb\xa3\xac\x87\xa5\xd6\xc6\xdb9\xcf\xa7l\xe2\x93\xaeI\x88\xb1m\xba\x11v[\x9aT\xf0\xdb\x8dJ[9\xb7vl\x9bl\x91\\\xe3q\x8b|\x03_8\xa4\x91-\xfeD\xba\xf7\xe19g\x0c\x98\x9fe\x8c?\x1caX\xcb\xa17\x1e\xe0lQj\x1azc\xf5Hl\xf9\x00\x18\x82\x0f\xf1\xc0\\}\x1a\xf9_\x9d\xd0\xf5U\xea\xa7Ov\x87\xfb\xfbl\xe0\x83\x95\x0c\x1b$8S>\xd2e\xe3\x03=!?u\xb8\xe1\x9f\x85Oe\xcf\x8e\xd9+06V\x1e\xd7\xe3\xb9\xf3[\x7f\x05k\x1d<&\xd6\xde\x13\xf6\xc3\x17\xcd\xfc\x87\xca7\xed\xcf\xf0r:\xfb	\x16[C\xba1\xb2\x85\x7f\xe1W\xb8u\x9aZXa\xa5\x9c7~\xa31\xc2\x95\xb7\x8dt\xdd\xb21\xb0L\xd7t\x84%F\x0c;\xac'\xfd%\x9c\xea\xe7\x12\x82\xe7\x8d,\xb8\"k\xe6'\xff\x0d\x00PK\x07\x08,\xdd\xf4\xda,\x03\x00\x00\xc1	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfa;\x03O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00jquery-3.4.1.min.jsUT\x05\x00\x01i8E]\xb4\xfdyw\xdb6\x1a7\x0c\xff\xff~\n\x8b\x93a\x81\x08\x92\xa5ty\x9e\xa1\x8c\xea\xa4I\xdaI\xa7\x9d.I\x97\x19\x99\xe9\xa1E\xc8fM\x91*	y\xa9\xa9\xf9\xec\xef\xf9a#H\xd1ig\xee\xfbiO,\x92\xd8\x81\x0b\x17\xae\x1d\xa7OG'\xbf~\xb7\x17\xd5\xfd\xc9\xcd\x87\xd3\x8f\xa6\xf3\x93\xe6\x84\xac\xe9\xc9\x97oN>/\xf7E\x9a\xc8\xac,N\x92\"=)\xe5\x95\xa8N\xd6e!\xab\xecb/\xcb\xaa>iN~\xfdm/\xaa\xfbiY]\x9e\xe6\xd9Z\x14\xb58yz\xfa\xff\x1bm\xf6\xc5\x1a\x05\x89`\x92>\x04\xfbZ\x9c\xd4\xb2\xca\xd62X\x04\xe5\xc5\xafb-\x03\xce\xe5\xfdN\x94\x9b\x93m\x99\xees\x11\x86\x8f$L\xc5\xdd\xae\xacd\xbd\xec\xber1M\xcb\xf5~+\n\xb9\x94D\xb0\xd1\x8cFm\xab\xf4!\xdb\x90Q\x9b\x85\xca\xab\xaa\xbc=)\xc4\xed\xc9\xab\xaa*+\x12\x981W\xe2\xb7}V\x89\xfa$9\xb9\xcd\x8a\xb4\xbc=\xb9\xcd\xe4\xd5IrbK\x06tQ	\xb9\xaf\x8a\x13I\x04=D\xea/	\xf6E*6Y!\xd2`d\xc7\xa1\xcb/\xf5O$\xaf\xb2\x9a\xb9\x0e\xbd`\xa27\x0d7Iu\"\xf9*f\xaf\xf8\x0b\xd7MV\xf1o\xd4\xecL/\x85\xfc\xb6*e\x89\xba\xbf\xd9\xb0\x9a\xcbi\x8d	f\x97\\N\xd7e\xb1N$\xdbs9\xdd\xed\xeb+\x96q9\xcd\x8aT\xdc}\xb3a\x05\x7f8\xb0\x92\x17SY\xbe\x91UV\\\xb2\x1b^L\xaf\x92\xfa\x9b\xdb\xe2\xdb\xaa\xdc\x89J\xde\xb3\x84\xdf\xb4\xe99O\xa6\xeb$\xcf\x89n\x9a\xb2{T\xb1\xe5\xfel\xea)\x08\xec\xa7v\xf1\xb0n\xc5~{!\xaav\"\xc4\xb4(S\xf1\xf6~'\x0e\xecn\xa0\x9a\x93b\x9f\xe7#.\xc2Pp\xce\xc5T\xcf\xd8\x81\xad\xf9\x03j\x88F3VWk\xfc\x14e\xb1V\xefE\xf9\xb5Z\xfdh4;,l\x8d'\x17\x00/V\xd0\x07Lf\xc52VrR\xf0\xa2i^\xd1\xe9\xba\x12\x89\x14\xafr\x81\xe5'A\xbd\xae\xb2\x1d\x163\xdb\x90r*\xc5\x9d\xe4\x00\xcdMY\x91\xea$+N\xd6\x94d\\\xae\xaa\xb8i\xd4\xe4?\x97\x1a\xcaE\x18v\xdfIEi\x18\x96\xd3\xba\xf3\x8detQL\xafD\x92N\x93\xddN\x14\xe9\x8b\xab,OII\xa7\xbb\xa4\x12\x85\xfcg\x99\x8ai%\xb6\xe5\x8d\xb0)\x077\x8c\xdbv\x86\xd5\xd4p.\x96b\x1c\x04\xd1\xd1\x8e\x10M3\xb4\x06\xcbbU\xea%\x144n\x1a[,2\x80)\x0e\x98\x9f\x0d\x0f\xd4\xfe\x0e\xd8\xb5\xb7&\xd8\x9e\x06\xbe\xb19\xae\xa7\x9bb\x9a\x15\x19v\x94\xa4\x07\xb6\xe3\xa7\xefV\xe7\xf5\xf9\xfe\xf3W\x9f\x7f~~\xf7|\x16\x8f\x9b\xde\xfb\x93\xd3\xcbvER\x0c\x05\xadI>\x1a\x016rQ\\\xca\xab +\x00)b\xaa_Y\xc11f\xb3\xb1F[\"h\x18\x8e\xee\xd4\x0f	\x92\xaaJ\xee\x03\xce\xb1\x903\xce\xb9l\x1a\x0bbn\"d\x18\xce\xced\x18\xca\xc9\x1c\xab'\xe8\x01=\xe7\xd7\xd3\x9d\xdd4\xfcA\xa3\xa6h\xc3\xd6eQ\xcbj\xbf\x96e\x15]3\xdd\x85h\xc6d\xf9\x1c-\xb5X\xc3\xcdD\xad'\x13[\x98\x1e\xd8\xa5\x90\x1d\xcc\xd2[)?s$\xcefK\x14[\x891~\xccxc\x85\x0dV\">0\xec\xd772Y_w\xaa\xd43v=\xdd\x8a\xeaR\xa8\xaa\xa6^\xa7	en\xb2N\xe4tW\x89\x1b\xbdU9r2y`\"Y_\x0d\xf5\xf1z\x8a\x14U!\x13\xf4\xc0\xb6\xc9\xae\xcdV\xb8lH\x9f\xba\x9e\x91\xeb\xe96\xd9\x91.\x02\xeb\x00\x8a\x815&Q)\xa5\x07\xa6PS[\xf3c\x15\xd7\xd3d\xb7\xcb\xefu\xd5Iu\xa9\x10l\x8d\n6YU\xcb\xc7*\x10\xbf\x91\x19=\xb0<yo\x96\xc9\x9c\x1e\x98\xf8\xad\xcd\xe1 \xd1[	V\xf0\xb1\x18\x13\xb5L\xd1\x8c.\x86'`v\xc6\x8b0,\xce\xe4r\x85\xb2\xab\"\x8e\xa3U\x8c\xea\x8b\xf4\xb1\x1e\xb4\xab\xd24\xc7\x0b\xa8\x17>\xda\xb3\xba\xacd$\xa7\xf8a\xf5NM\x9b\x9c\xea\x87\x03\xbb\x9e\x8a;)\x8a\x94\x03\x98\xed\xb3\xd7\x1e\x86\x83Y/\x98Bv,\xe1n\x12W\xb3\xb8i\x1e\x0e\xac\xe6s\xb6o?\xdb\x0d\x97\xf3\xd1|\x01d\x17\\\x94e.\x12\x0f{$aHr\x9et*\xabMe\xe31eGH(i\x9a-Ih\xd3\x90\x84?\x1c(\xab9\xe7\xfb0$\x89\x9ahVO&tQ\x9f\xed\x17(\x9dm\x080\xda\x88\x13\xd1\xa9\x9e*\xcc+\xf5\xde\xad\xb8X\xc9\x98\x05\xbf\xfc\xa2v\xef/\xbf\x04#\xcee\x18&#\xce+\xf4.\x0c\xf1s=\xcd\xeao\xf3$+4\xf0\x93\n]\xc8\xb8\xda\xc4\xd3\xacV\xbf\xc0\xcetI\n\x9e\xa0\xc6\x92ga8\xeaf(\xe8r\x15GY\xd3\xf4\xab+\xe8\xb2\x88\x1e\x0e,\xe3\xa39Cqn\x97\x83\xe4\xacd\x15\xa5\xd1M\x99\xa5'3\xd3+\x95\xa5\xa2\x0e\x86\x92v\xfd\xc8\x83\xb8\xdb%EZF\x86\xcc\x08\xc6d3\xfe:\x91W\xd3\n\x9f\xb7\x84\xd2i%vy\xb2\x16\xe4\xf4\xfc\xe5\xe9%\x0b\x02\xca\xb2\xfa{\x91\xa4\xf78\xef\x04\x88\x94\x0e(\xf7	\x18l\xe7\xa2,\xbd\xfdL\xd1y\x7f\x86\x8e\xb7\x02+LoGd\x84\xb3d\xa5\xd7\xf6D\xe7\x8f1\xef\xee \xa1aHFD\xf2\n\xcfC\xe7\x0e)\xf8\x8d\xc1},\xf0\xa0=\xa0a(}\xfcE\xc3\xd0P\x18\x05\xe5\x9c\xe7\x14\xfd|\xb5\xdd\xc9\xfb\xc7\xfa\xb9\xf0\xa1CM\xefhn{>;\xb0\xcb\xbc\xbcH\xf2W7I\xee\x0d\x11\x08\n\x04\xc1\x83&\x1ap8L\xd5\xe3\x81\x1e!H\xe4\xc5f*X\xc5g 	pn\xd1\x07\xb4Zp{J-\xaa\xb3bQi \x1e\xcdq\x10\x99\xa9YU1\xab\x18~(\xbd\xa8Dr}\x10y-N\x1c-!\xfe\xb8\x84\x19\xcc\x8980Ye\xdb\xceJ\xf5(\x81 \x88\x08\xa8\x81\x16bv\x80\x16 \xf3k\xd1;\xc1\xda\x81\xe1\xd4\\\xc5\x8b>\xc1ERC\xe5a\xbcK{\xe0\x14,\x00u^\\\xb6XA,W\"\x8e\x04\x8d\xf6f\xe5\x98\xa0\x94\x15\x07\x96\x15\xc7m\xb2\xf6(\xc1n\xe7\\.'\xf3(\xb3\xc0!X\x81\xee\xa2\xa9^W1ej\x1d\xf8X\x9aY\xc7\x8a\xb0\xecx\x11\xc4*\x1b\x8fcE\xa2\xb9\xc93yx\xc6\xc4\x81]V\xc2\xdb\x0c\x860\xb4\x0dT\xa0\xb43>c\xa5\xab\x99%|T,\xb2\xb3r\x91\x8d\xc7t$\x89Xe1\xcb\xe8\x88\xf3$\x0c+u\x1e\xaaon\x87W\xbd\x03\xf4\x88\xf8\x9c\xb1\x84\xafb\x07Oh\xbcjGR\x9eU\x8br<\xa6\x06!f\x1cM\x961+Y\x81\xcd\x96\xe8\x163\xbap\xd0Tj\xfc\xf8\x87\x05L\xff.\xcd\xf1\xba\x8aY\x02\xbae\x9f\xa5\xd1\x9c\xd5\xfb\x1d\x18\xa6\xe8\xfe@\xd9\x00\xf1\xf8\xe6~{Q\xe6\n\xbdn\x8a\x95~\x9bfRT\x89,+\xccw\xff\x13e\x86\xaa\x08>\xd3G\xc9\xc9?\x15\xfd\x7f\xa2\xd9\x89\x93\xcfM\x13'\nNN^&R\x9c|/._\xdd\xed\x0c\x9a\xd1\x08\xcc4\x1c\xa8\xc3O\x92\xe0$\xa0-\xbb\xa4\xe0\xb8X9\xfc\x14\x8c\xe58\x88\x83\x98\xcb\xa9,\xbf*oE\xf5\"\xa9\x05\xa1\x07\xaa\xf8\xa8\xab\xf6\x904\xcc\x80`)\xbb`%\xcb\xd8\x15\xdb\xb0Kv\xcb\xf6,go\xd9\x0b\x96\xb0W\xec\x86\xd5l\xcd\xee\xd95\x0f\xea\xec\xf7\xdfs\x11\x8c\xe7O\x81Z\xd1Y\xb6\xe5E\xcb\x90\xbd\xe13\x05\x91;\xbe\x17\x84\xb2;\xfd\xf3O\xfd\xf3\\\xff\xbc\xe4\x83d\x12\x98\x1b\x89\xe3\x8b\x8ff\x94\xcd\x0e\xecW\xfep\xe8sb\x8a\x05\xfc\x0d\x8c\\\xb9c_Y\x86\xee\xef\xf6\xe1\x1b\xc7\xf7}\xdbk\xa4\xdd:3\xe6\x01YqV-\n\x8d\xb3\xc4\xaa\x88\xd1\x05j\xfacq\xffd~`\xdf\xf3`}%\xd6\xd7\"mj\x91\x8b\xb5\x14i\x93\xd4\xf7\xc5\xbaI\xf6\xb2\xdc\x94\xeb}\xad\x9evyr\xdf(\x96\xbf\xcc\xeb&\x15\x1bQ5iV'\x17\xb9H\x9b\xab,ME\xd1d\xf56\xd95yY\xee\x9a\xed>\x97\xd9.\x17M\xb9\x13ES\x89$-\x8b\xfc\xbe1Lv\xda\xd4\xebr'\xd2\x80}\xcd\x83\xd5\xf9\xf9\xdd\xb3\xd9\xf9\xb9<?\xaf\xce\xcf\x8b\xf3\xf3M\x1c\xb0\xd7< \xcb\xe8\xfc\xfc\xfc|\xda\xac\xce\xcfo'q\xb3zw>\x9b\x9c\x9f\xdf%\xb3\x98\x8e\x03\xf6\x13\x0f\xce\xcfW\xc1\xf8\xebq\xf0\x94\x04\xe3\xd7\xe3\x80\x92ed\xdeWO\xdf=iF\xff\x89\x97\x9c\x9a/\xcb\xe8\x03\xd2\xd6\xf8\x0e\xbf\x1f\xc4\xf4)\xfd\xa09\x0f\xfa	\xe7\x01R\xce\x83\xc6\xd4K\x1bS\xcb\xf9y\x1c\xb0'<\x88\xda\x06\xcf\xcf	!\xff}\xd5\xb4\xe9\xa7\x10\xba:?\x8f\xe3&\x18\xff4\x0e\xe8S\xdaL\x9f\xd2\xf3s4\xcd>\xe7\x00I\xbdu\xc8\xd7\xe3`\x1c\xb0\xe02\xa0\xec3\xff{\xf0N\xf5q\xac*~g\x9a\x8b\xa9m\x85>\xd5c\x18?1\x85\x7f\x19(\xfc\x94\xa9<O\x03\xca~\x1fJ&\xabO\xc7\xffA\x17\xbf\x1e\x07fJ\x02\xca~\xf0\xb3~=\x0e\x9aO\x03\xca~\xf6?>\xa1\xecG\xff\x1d\xf5\xbd\x1e\x07O\x02\xca\xbe\xe0\x0f\xaf_F\x9d\xb4\xbf\x98\xd9\x0d({\xf1\xd5\xf37o\xba\xa9\xe7\xe7\xd36\xfd\xed\xf3/\xba\xa9:\xa9Y=\x8d\x91\xfc\xfc\xed\xdb\xef\xbb\xe9\xc1\xf8'\xca\xbe}\xf3\xea\x87\x97\xdf\xf4\x13\x9eP\xf6\xe2\xef\xaf\xbf\xeau&\"\np\x15[\xd2\x80\xf1h\ny\x85\x7f\x13\xbc\xd0	Y\x83\x8bo\xca\xcd\x04hTO71\xb3%nD\xd1\x94i\xda\x10\xb2\x1aO\xe2\x86\x92\xf3\xf3\xf4)-,8\x91ed\x12\xcc\xfb\xf9y:\xa6\x0d5\xaff\xf5\x83,\xa0\x0c\xc4z\xb7c\n\xd8\xbf\x1f\x07\xf4\x89\xc9R\x08\x91\xd6/\xca\x02\"\x8dnV]\x9d^\xbb\xa8\xed\x95\xf8\xad\xb9\x94M\xaeG\xd4\x0e\xb0;\x06\xb2\x8c&\xe7\xe7)]\xaa\xae{\x1d#K\xbez7\x89\x9b'T\xb7\x7f`\xff\xe2\xa7\x7f\x7f\xfb\xf5WON3\xf6\x1d?E\x07\xb3b\xb7\x97\x06\xaf4\xe8WR\x89\xa4\xb9\xd8KY\x16\x14\xf9\xbe\xe4\xa7\xef\xae\xceS<\xfe\x03\x02\x86w\x0f\xf1\xf8\xfc\xe1\xbc~z\xbe*\x12\x99\xdd\x88\x93\xf3\xdbS\xf6o]\xdb_\xc8\n\x88`L\x1br~;\xa6\xcd\xf9\xd4~\xa0ON\x99\x10\xfct5\xfeO|\xca\xa4\xe8\xc0\xda\xf9\xf9\xf99Y\x9d\x9f\xa7\xc9d\x13?\xcc\xd9'\x075\x8a%v7\xe0\xb8\x99\xaa\x11`O\x14\xa2\x83[\x9d@\x89\x07\xb3\xbb`,'\x9f|\xfc\xf1\x87\x9f\x18\xe4yR\x8dx\xd54\xc5RF\xd5\xd9l\xa9\xcf\xbc\xe9\xa6*\xb7/\xae\x92\xeaE\x99\nR\x8dU\x01\x1a\x0d&~\xfa\xe9|\xd6|\xfc\xf1\xb3\xbf}\xc2\xe6\xb3g\x1f\x86U\xf3\xf1'\x1f>\x03\x7f[	~JV\xc0vw\xf3\xcd\xf9\xdd\xff\xb3\x89\x9bw\x93\xe5yJ\x9bw\x93'\x06\x0f\x9a\x94\xc9\xf9\xfe\xf3\xcf?\xff\x1c\xf3rz\xc9\xb2n\xff\x1d-&\x97\xc1\xf9\x0c\x82\x14\xb1\x0c\xce\xf7\x9b\xcd&\x0d\"\xa1\xc5\x88d\xc6&s:\x0e\xce\xcf\x83\xb1\x98\xaeM\xd7\x9fKb\x0f\x92\xc9\x9c:	\"\x99\x7fB\xc7\xc1I\x10\xe9\xec\x07Vz\x0d\xd2\x87\xb7\x84\x1eX\"\xf8\x85 m7l'F\x90\xe0\x88\xa9=3\xc20\xd8d\"Ok\x01\x86\x92kI\xe1?\x93\xad\xe8\x9d\xeb\xec!\xcd\xaa(h\x85g\x01+\x00\xe0A..E\x91\x06\x07\xba\x90\xd5\xfd\xc3\xdf\xad@\x81\x7f\xa3\x89\xcd\xedTmL\x94\xa8)\xeb\xbe\xc9\x95\xffn\xc6\x19\xb7\xb2\xcau\"\xd7W \xc0\xff\xce\x1fT\xb5\x91\xa5I\x97\xed\xb80\xbd_\x99V\x053\xadJJ\x0fC\xdc\x85;\x96AB,n\xaf\xb2\\\xe0T6t\xecx\x1c\xd3\x85\xcd\xc1q,\x1fZ\x99`-4\xe1\xcc*M\xd0(v\x9f\xd5\x8a\x8cY\xb3\x0dhy1-o\x0bQ\xbd\xb4\xa4\xca\x0ebC7\x9c\xe8o\xa0C\x954t\x15;\xfa\xde\xc9he\xd3\x8cd\xd3\xccG\x9c\xef\xc2\xf0o\xfag\xae^[z\x01l\x0cX]\"\x96\xbd\xc6\x9aFD[\x90\xca/\xc2\xf0-\x11\x94	.\x9a\xe6\x05{E\x95\xe4}n\xea%{\xfe\xef\xa9\xb8\x13kL\x11\xb8\xa2\x8c\xefW\xf3X\xe5\xf9\x1b\xe7|\xa7\x9eF$\xe1\x02BV#\xad\xfd\xec\xfeuJ2J;\x1dI\xa6Y\xca9\xcf\xdcGM\xfd&`J\x14\xc1\x9cm\xc8FI!6\x03U\x85\xe1=\x11,Qd\xf6\xfb\xebA\x87\xf6\xabg\xb1m\xc7\x82X\xc1\xfc.\xd6\x9f\xdd\xbfM.\x01\xb8\x18\x19S=T\x83\xfb0\xa6a\x98vs\xbe\xc8\x93\xbaF\xde0\x14\x8f\xa4\xfcak.'F\xc3\x8a\x03x\x8c\xe9ou\x02\xf9\xc6Jbw\xc6aHF7M3\xba\x99JQK\xf4+\x0c\x89Z\x88V$<z|\xcf\xe9\x95[s	\xe8b\xe0wwa\xf8\x83\xab\xeb\x81@\xe5\xd2\x91\x84\x07Y\x1aP\xba\xacy\xedX\xd3J\xb0LP`\x99~FV\xf3k\n\x01}\xce\xaf\xd07\x03\xf7fW\x94\x93	\xcdWe\xcc\x83\xbf\x04\xe3\x1a\xc3\x19\xdf	\x82/t\xb1\xe6\xf9\xf4\xd72+H\xc0\xc0#p!l\xa7\xc2\xf0^\x10\xe1\x89\xd8i\xd3\x88\x03\x10\xc3\xd1tn\xa6J\x1a\xfcF\x11\xbbe\xf5<\xcf\xc9Z1\xb4n\xd7?'\x12J\xa4\xc3&+\x92<\xbf\x7f\x80X\xeb\x1a+\xa6\xc5\xf6\xdd\xd1\xd0\xc3\xe1`\xda\xb8$\xd2\x0d\xff3\x16<\x99\x07\xd4l\xdcv7\x83MP\xdb\x18|\xa8=I\\*\xb6z\xcbA\x1b\xceS\xad)\xfd\xf4b\xbaN\xd6W\xe2+\x85$\xc20\x15\xb9\x90\xe2D\xac\xaai}\x95m$\xa11\x13f\xfdy\xe1\xe1\x0f`\x1aW\xa5X]\xc7\x1cR\xa56}-Z\xf9\xe8\x8b\xbe\xb2\xc4\xa1h\x8de\x0d&\x1f\x01\xd6\xdb\xf92_\xe7n\xc2\xa4\xb7\x10a\xe8\xbfu4\x1f\x922\xc9\xc1\xd2z\xbd\xdd\x08_t!,O\xd8\x04\x94U\xbc\xe8\x82J5\x99\xd0\x8bi\"e\xf5\xf7\xa4Hs\xb1*VU\x1cs\xd9\xd6\xb6\xeb\xd4&\xc3P\xa0\x960\x9c\xbb\x13\x07\xba)\xfd.\x1d\xc2\xc4R\xd7\xe5\xbeZ\x8b\xd7P\xa5M\xa4\xff\x86\xfd]\xd9MZ\xe1\xad\xa0\x1ar\x0b^Lq4\xbd\xc9.\xf2\xac\xb8\x04\x92+<\xaekb\xe5V'b9\x8f&\xf3\xb6\x97)\xa6\xf3\xa1\x07\x0b\xed\xa2\x05\x8a\x8az\xdf!\x89\x0e\x83\xfaDk\xde\\^	O\xb2o\xbf\xb6\x8b\xfd\xd8\xf67\xdd$m\xbb\xd0\xbbh\xa2M\xbd\xf9\xed\xf9\x90v\xf9\x07\xe3\xd8\x94\xd5V\xe9\x7f\x96\xfeN\x0d\xc3\xd1\xbcC\x19,\x83<\xb9\x10\xb9\xce\xe9={e:\x158\x92\x02}\x8b\x8e^\xb3\xfa\xa5\x97\xde4\xfe\x97\x11\xe7#\x88\x97\xb1\x05\x86J{\xad\x87a\xb7fo\xdc7\x82$n\x9es\x8f\xf6)\xdd\xd7\x92\x8fK\xe6'\xb5`\xc9*\x9e@P\xe3(\x84\x92\xb2\x8cW]\xac\x98M&T\xac\n^\xad\xb2\x18\xe8]q\xf4#\"\xf1\x83gJ\x0f\xf8\xdfu\xe9\xbe\xb3\xe9\xc3pHK=|\x8a\x85\xa18@\xa2  qJy-\xa6Fl\x04Up\x86\xf7\xac\xfe\xf9\xeb\xaf\xf8 <%[Q\xef\x92\xb5\xf8\xe1\xfb\xd7\xac\xe0\xa4G\x974\x8d\xa0N\x9eb\x1a6\xc06\xfa\x97\xc1\xe4M\x03}\x8b\x83\xcc\xa6	\xc0R\x04\xf4\xc0\xde\xa2\xedZHK\xe5\x1c\xf7\x00\xda\x10>L\xa0\x98f@\xb3\x83P\x01\xc5Qy\xfb\xbd\xeawK\x91\x0f\xe4\x05\xaf\x8e:\xcc^\xf1QF^P\xb6\xd54\x0f)\xa0\xb5\x17\x9bd\x9f\xcb\x1f3qK\xd1}Y\xeeF\x1c\x9a#RL\x934}u#\n\xf9UVKQ\x88jy\xfc\x89\x04\xfb\"/\x934`\xa5`\xa39\x8d\n`\xb5d}\xa5\n\x86a\xe7\x95\x04e\xd1f\xa7\x94\xa5H\xd5'R\xcd\xd7>\x8c9\xf0\x13\xd3\xb5\xa5\x1ax\x90\x05l\xd4?\xbf]r@\x0f\xa8q\x082\x1e\xad\xdb\xd7s\xdb\x13\xe4E\xb9Ei\x12\x04\x94\x9a\xe6\x8e@\x8d@\xd0`\xe0\xfc\xb8UG\xe7\xf0\x7fh\xd8x\xf1H\xba)	Z\xf1\x91.&\x9d.\n\n\xc2\xef\x9a\x8dz\x15\x02\xfa\x9bf\xe8+\xb9\xeew\x13\x8d-\xc9\xc5t\x93\xe5RT\xd3\xd7/\x8f\xa1\x91\x0bG\x0cH\xc1\x8aVo;\xb8>\xc7\xe4\x14\x10\xd0\xe1\xc0\xd0F\x91v[\x00\xee\xc86\x83\xa6'\xd2\xeb=:\x19\x86\xaf\xdc\xf1\xd7Kj\xf5\xee'\xc5rU@\xb1y8\xd0\xe8\xbd\xa3*\xfe\xd4\xa84>\x18\xea^\x17\xee\x80\xbd\xc3\xf0\xf8\x9b\x9e\x00[\xb3\xd2\xdf\xdc$\xf9\xde\x9c7\xff\xf7\xa6D+O\x07'\x06\xc6!\x8a\x0c&\x05/\x1f\xe9\xa0\xda\xea\xb6c\x96p_\x95\xf1\"\xebTi@\x0b\xbcQ\xcb\xfc\x95<\xd3|\xdf\xff\xd6\x84!9\xd5\x8a\xd9\xf9x\xfb\xfc\x0b>\xbcs{\\\xab1\xe1\xf9\x83\xa9j\x19\x9b\xa5\x1c\xe6w\x04\x8d\x14\xe7\xb1\x94\xc7$\xb5\xb0:\xd2Av\x98yZ\x98G+\xc7\x1a\x04OAq\x08\xfa`I\xacR)\x7f((\x86\xa2\x83\xc0\x15\x0fX8\xa0\xa9,Q^:xQ\xb2D\x9e>\x82E\xc2\xf0\xbf\xde_\xdd\xe2\xaf\xcc\xea<\x9aE)jk\x0c\xfc\x06\x7f\x88\x9a\xbb\x16\xb9\xf5\xa7\x10\xe0Ez\xf8\xec\x18\x91\x15\x85\xa8pB\xf2\xe0,9\xc9R\xfeA0\xbe\x1e\x07\x1f|zv\x9a|z\xa6\x05o\xed\xe7	\x04\xec\x1f\x9cl\xeb$\xcf\xcb\xdbu\xb2\x93\xfbJ\xf0\x0f>\xf8\xf4\xac\xdc\x19Y\x03\x98\"\x91\xaao\xa7\xfa\xe3\xa7g\xa7\xba\x9eO\x03&\x8e\x17:Xu\xab{\xc7?\xf8 vh=\x0co\xf4\xca\x04\x90\xc8\xc7\xdc\xc9=?\x80\xdc\xfd<\x80\x90v\xb0R\xdb\x93\xb6\xaa\xa6\xb1U\xb5b\xffe\xa4\xb6_\xa3\x05\xa1\x8f\xd5\x95\xa5\xff\xe1jZ&C\xb5\xfd\x87?R.2z\x91\x812m\xd2`\xc9\xe4/\xaa\xb9\xf1\xd3\x81\xceO\xff2\x1dCL\xa9\x0e\xda\xde\xf2\x8a\xdez^Ub\xc3?\xf8\xe0\xc4\xd1\x9d\x1f\xd8\xa7\xee\x02\x0f\xa6\xeb\xd5;\xf5\x96\xcfX\x1a\x1e1y\x9a\xce\xa7\x0b\xd9\xe3\xd5\x01\xf2\x01\x0b\xb4rGM\x92\x0f}\x92\xf6\xb2\x83\xfa\x0bX\xf0\xf2\x91IY!\x9d\xa7C\xb0\x81\x14#\xa3vz\x9b\x80\xb2gJF1\xb00\xa2P\xc3\x1d\xa8\xc9%\xb1 \xb2\xb3\x12Pv\xb4o\xdc\x8c\x8df\x8f7\xd3V\xf0g\xdb\x19\xaa\xe6)\x8b\xee\x02\xcal\x0f\xd9\xf4i\x84\xb5\xa7@\x00[\x88\x1cDmGg\x91\xc1\x9a'6\xa9i\x92\xe9\xad\xb8\xb8\xce\xe4\xd7\xdd\xbcH\xd8\x96\xbf\x0f|-\x87r\xd6\xbd\x8f\xc0.=\xe8K1+\xeb\xb2(\xd4\xc6S\xf9\xf9\xda\x18:0\x90j\xac}[\xd5#\xecs5\xb2\xda\x8cl\xc4\x03\xf6\x04P}\xc3o\xdc\x84y\x82\xf8\x1b#\xbbi@\x12BT4\x90\xa7\xf6\xf3H;\x1f\xc9t]n\xc1\xf0Y\xda\xff\xdb\xb2\xce\x80\xb0`\xa1*\x9b\xc6\xcbV\xc8$+j\xda;\xf1\x00\xf8\x05\xff\x9bc\xa1\xc1\xf5/[\x9b`\xb3\x13\"H\x07dW`a\xcf\x13\x9c\xbfU\xd3\x8c\xc8\xa8\xd2\xb2\xd2\x96}\xc0\xd7\xc25\xbdl\x1fI\x05\xf9\xd7#]\x0f\xc3\xf9'\xe1\xa3\xa9\xcaz\xa9\x7ftf\x1b\"\x8d\xacAr\xbf\x93 \"\xa4G\x83\x8cf\xa6\xdb\xa3\xf9\x81\xbd\xe4\xb27\x1b\xd0\x08\xfb\xea`\xa8\xa5\xd9La\x87\x82\x8f\x1e\xed\xd3d$\x1fK\xb2\xb3T4\x0d\x99\x87d\x98\xf5\xe3\x9c\x13\xd9\xe7\x08%]>>	\x92Fs\xda4\xa3TY\xc9\xbd\x14`\x89\xa09x\xb4\x1f\x9a\x8d/\x96\x18\xde\x0b\xb0\xfa\x9d\xd68\xe7[\x88\x7f\xb7LP\x98\xa5\xe0\xc3\x8b\xa6\x91\x8f\xe5\x92t9\x8f\xf6\xcbo\xc9\x9e	:\xc1\x8f\xa4\xd1,\xfa(,Pz>\xb4>\x8f\xce\xab3fiW\x8d\x95\x9dE\x84\xc5\x88\x88A He82\xca\x9afT\xda\xbaP\xf1\x0b\xd7\xe9\xe5<\xca\xf0R\x0eu\x10\x853\xce\xb9+k\xa4`\x8b\x82\x0b#d\x85\xa8\xca\x03\x9fd\xba/\xb4\x10\xb1@.9\x9c\xab\xf6s\xe9\x1c\xc9\xaa\x82aA\x0ds\xa6j<\xb6`P-w:\x8d\xa9\x94\xc8d\xdb\xa2\xcb\xb5}\x9eG\xb3\x03e/\x0e\xac\x16\x16\xd9\xf1!:\x15\n\x10\xc1 &\xd4\x7f$\xf5\x8b8\xd4y\x04\xe2C \xe8\xa9)\x8e\x90o\x18\xbe\xea\x89\xd0\xeb\xa6\x19\xd5V\xc2L\x8f\x85\xea\x14\xf2e\xbdk\x1cf\x94\x8al-\x9af\x00\x9b6M\x8br\x8c\x8a\xa5\xc5A\x0e\x9d\xd8U\x1b\x10H\x9b\x94\xd9\x99\xd2	\xbd\xd0\xf3\xb1\x12\xb1=\x9f\xd4\\Z\xec38\x99\x7f0)\xf7\xc6\xd8\xbb\x16J\xa6\xd0\xab\xe2\xfd\x85\x0d\xfe\xe8\x08d{F:\xb1\x96\xbe\xfe\xaa'\xcb\xcf\xc9z9\xe9R\xe1<6ze\xf9\x08\x0b[\xad\xe5\xe5\xb2\x8a|\xd1G\xd3\x8c^-{r\x0dI#R\xf5\x94\x15\x80x\xa8\x1d\xc04\xd4;\xb1\xce6\x99H\x97\x95f\xe4\"L\xa9\x9aFQ\xaf\x93\x9d\xe0\xc7\xd2\x84\x9e	\x9e\xd6s\xe8\"0\xd5\xec\x94\xe8\x9bj\x06o\xee\x0b\x99\xdc\x9d\xa8\x9c\xecd_Tb]^\x16\xd9\xef\"=\x11w\xbbJ\xd4uV\x16\xd1I0V\xbc\x82\x98\xee\x8b\xec\xb7\xbdxSV\x83\xe2.\xb0\x11\x1a\xaf\xa8M\x9f\xf3Q:M\x85\x14k\xf9r\x0fC\xe2D\x8a\x9a\xed\xb9\xc1\x9fo$\xa8\x990t\x8a_\xd0iH /)\xcb-\x8b%\xb9\xb6\xaf\xa3\xc0\x8e0z\x0bC\x02\xc9\xbb1I\xb3;\xbf\x82@\xd2\x98+\x13\xc8\xdd\xd9\xdc\xc1\xe7^	\xf5a\x89WBfw)\xe4[q7<\x80 p\x88\xd1\xc2?F\x92\xa9\x1d\x0c&/k\x1a\x1c\xdbY\xd3\xcc\xd5\xabJ8\xb6QT~\x1c\xca\xf6\xa1pVN\x9d\x8f\xca\x8eTp1Uv\x0e\x8a\x82]\x88\x05>\xf8\"\xfbb\xccK\xb0iV\x8d\xf8!\x9al\x9a\x8f|5\xa1\xa6 ~\x04\xdf\xa1\xf3\xb5\xf3\xa6\x18zU\x87t\x8chq`\xe4\x02\xd3P\x1bLU\xf3\x07O\x91\x13}<cZ\xe7\xf2m-\xf6i\x19\xe5\x82)l\x18}\xc1\xda\xed\x01kd\xf0\xf8\xf8\xadD\xae,#\xa2\x87\xe0\xd3 :\xd6\x8e\xab\x01\xc2;\x86\x05'\x03\xe9\x07\x16\x8c]\xb1J\xdcd\xe5\xbe6\x1a\x8bN\xd9\xff<\x96\xe9p`\xbbJ|\xae\x04_\xd1\x83\xb2\xb0\xf1W\xd6\xce\xd2j\x1es\xfc\xe9\x89\x8b\x98X}\x18s\x82\xbfM#V\x1f\xa9\xbf\x1f\xc3U\xc53k5Y\xc1\x9a) |\x06 D\x11\x0eu \x1e\x80\xa3)kM\x18>\xa2\x07c\xbc\xf3\xde\xbetp\x0c\x0b\ny\xa5\x1b\x98\xc7\xae\xa6\x0f\xe9\xd2\xf4\xce\xeeh\"V\xb3\x18\x1d\xff(\xe6c\x82>/\xd1e<~\x127\xcd\x9cF\xcf\x9e\x92\x00V5\xba2\x94\x0d\xca4\xb5o\x14e?\xd6e\xff\x9fx,V\xff\xefQ\x86\x08-\x86a\xbf\xc5\x83\xb5T\x1a\xda:#\xb1\xfa$\x0eC\xcc\x8e\x85\xb5/\xa6j\x0e\xf4\x01\xa5\xeaXb'Fj@K\xe4\xe4\xdd)\x8f\x8a0\xfcYg/p\xc0I~E\n\xe8>\xf5Ka}\xc9H@\x03f\xb5o\x13I'\xf6\x19\xf9\xd0\x10\xc7\x1f7\x87\x12#~\x16\xf3\xc2\xfb\xe2\xaf\xd6\x87\x94\x1e\x0elc@\x086\\G\x03<\x923v\xd7\xce\x8c\xd8\x88\x87Z\xfa\xd6\x8a|G\x1d\xb9\x93\x13\x04\xb7*\xaf0l\x9f\xbbU\x1b\xb9\xab\x92\x16\x0d\xf4k\xb7\x12\x00>7\xe7\xb2i0S-\x9b\x13\x90w\xce@N\x8c\x03md\x04+)L\xea\x8e\x086\xd0/\xa9\xd7`\x00\xaf9\xc1|\x18z/M3$\x9b\xea\x9esa8(\xe4\x0f`\xc1\x0f\x06\xf4\xc0\xba{WIC\xddL\xf9\x9d\xd4\x0bbh\x02\xd0b-r36\xde\xe0\xfd\xc0 E\xe0\x8e\x88\x1c\x03\xaf\x07\xfa\xd3\x12\xe7H\x16\xd9\x1cK9R\xaf\xef\xcck\x16\x863\xcc\xb8\x83\xb4\x8cF\xc1\xd36q2?\xeb\xa6=i\xd3\x8cA,\x99d\x16\x1auS\xff1Y&\xf33X\x13\x8f[\xad\xfc\xe7\xc0\x89\xca\x82\x89v*mt	\x05\xf4\n\xe3\xdb\xaag\xcc\xd6=\x9e\xab\xda\xc7\xc1$P\xd0\xdb\xc36WL0\xc9.\xd9\x8d>\xdb\xee\xb9B.#\xce\xaf\\M\x1fR\xb6\xe5\x01,\xec\xfc\xef\x93\x8f`K\x1c\x18\xfbAt\xc3\xf1\x9c8\xf0.\xf5\xfc\xdcx,\x9c]\xa3\xd1\xc8\xd7\x82\x1e\xa2A\xcb5\xe6\x99\x0b\xf1\xfb\x11\xe7\xdbe\xe0\x9dx\xc11~g\xeb.\x97\xb2\xe1w\x8fo\x16\xb6\xe3\xa3\x02\x1e{,\x85_\x11\xccF\xd4	}o\xc9\x89\x9c>$\x8e\xedHx\xb2\xcacp\xabw\xcb\xe4\xf1\xed\xb7\x890\xf2\xc4Q\x04\xe6@\x1f\xcd\x17{\x9e\xf3\x00\xf6\x98\x98\xa7\xab0\x1c\xed\xc3\xb03\x1cC\x82\x8cf0\x8b\xd9\xf3\xd5v\xb9\xf6N\xfch=\xc5\xf4\xab\xe7\x98m\xc3pG\x1fRNjN*N2NJN\x12\xbe\xa6\xab\xeb\xb8iH\xb2\xba\x8e\xe1\xd5DW\x89!\xc3^\xbf\xc4\xf7\xd2\x7f\xd7\x19\xaeb\x98V\xd1\xd5\x0c\\\xce\x9b0\xac`\xd9\x84\x9fg1Kx\x1d\x86k\xcf\xdclU\xc7n:\xc6\xe3:\x0c\x930\xc4\xb44\x0dIy\xcdg\xb4i\xf6\xd3]\xb9#\x94\x1a\x1a\xa8\x9d\x890\x1c\x8f\xd30L\x00%\xf4![]\xc5|\xf5\x86\xd5,\x8d\x17\xda\xfd\xc4Q.\xbb0T\xd5\xf9#\x13\xff\x97F\xc6\x94\xc2>5\xa2\x88?3\x8a\xffr\xbd\xcd0\xd5 t\xef\x93\xff\xa1\xe7\x98\x9a4\xa6L\xcfV\xc7\xdb\x86\xa4\x13~\x83\xfd|\xd94\xe9_/9\x9f\xc1\x8b\x94\xa7\xa7\x97\x87\xc3\xc0\xa1\xcbJ\x83\xfdY\xc2/\xa6;E\xa8\xd5+\x117\xcd\x05d\xa0\x9a\x16\xaaW=H\xf6I\x88`_\x185\xbaHOt\x05\x9a\xca\xb7{\x1d#[&\xa4\xa4\xd1\xfc,1(gI$_	&X\x10\xb02f~[=\xe7\x01\xd2k\x9a.\xdfck \xdeka\xf0-\x11\x0cV\x06\xd4\xd9\x17\xa8\xb7C\xd7\xa7\xde\xf6\x9a\x086\x03GN\xa3\x04t\xa1\x1aX\x1d=\x14\xa5\x8c:=hm\x9eX\x06\xae\xa5\xe6\x1b\"\xfa\xc6Rn2jLFo\x04\x1d\x03H^[\x91@\xc5Vj\x89Ew4\xc9d\x027\xeer\x95\xc4\x9aDI\xf4p\x92\x98g\xb4;\x18\xd61\xb9\x02\xa4\x0bV\x93\xcaH\x1cXF\x99\xfa\xa8^G\x99\x86ih\xdd\xae\x92\xba3F9xvvYv\xe18\xf5\x03e\x96Q\x7f\xa4\x16\x88\xf8z\x04\xf1\xf1\n\xe0\x88\xeb\xf09M\x03\x06\xa6=\xdd\xa4\xeak\x9e\x14\x97\x9df\xda\x11\xffh\xc8?E\x15<\x06\xb1\xaa\xfcI0.(\x83\xe8\xa8\xdb\xad.\xe8u:\x89\xe5\x92\x8b\xb4\xc4\x91 9\xd8sUS\x97P!\xc1\xdd6\x8f\x90\x80\x0e\xf4\xd3\xf4ws\x04(\xb9g\xa79\xeay\x87\xbbA\x17\xea\x94>h\xc0V\\_{N\xd2\xbe\xf5\x97\x85\xba\xd1\x1c3%\x93\xaa\xef\xe5\xad\x06\x01\xdb\xb3r\xadB`\x84a\xfb\x8c}xe*\xd0\x8aj}\xaa+bAL\xb3\xf4\xc0\xaa\xb2\xec\xd6grC\xa2\x97\x1c\x98\xf2\xb0y,\xfd\xc54Y\x83\xd73\x02j\x88\xa0^\xa0\xc9\xcfQ\xa8i\xdag\x02\xe2r4\x02,\xdc\xef\x04\x04M\xd0\x1d5\xcd\x7f\xc4T&\x17\xca\x8c\x0d\xbe\x8f\x05$\x00it)\xc8hN\x99U\\\xa8\xf7\x19eF\xa9\xd5\xe9\x8c5e\x18F\xdef\xe4\x9e\xa5X\x18\x82,155M\xa0\xb5P^\x92U\xec\x1d\x98}\x1a\x9c\x1co\xc5\xc2\xd0\x7fs\x15\xa8A1cUn?\x1e\x98\x807i\xa7\xca?\xc5\xf9C&\xee\x8e\xd9\xb3OZ\x92\xc3<\xcc\x0eL\xf7h\xa0\xb7#w&LU\xeb\x90 0D\x80\x10\xd5\xd0\xc2\x7fi\xf837\xa7\xf0\x82\x85\nn(\xf3w\x03\x99\xb5)\xde\xff\xe12y\x06}\x16h|\x1b\xbf\x03\x83D\xe5\xb8\x8d\xa3\x15\x7f\x8c2\x0c\xc3\x005\xb4\xf5\x87\xa1r\x00\x87\x12\xe0\xc8|\x18\xe9\x01\x05gb\xcb\xf4\xe5\x8160\xc0\x8d\x87\xc4\xec$\xadf\xb1\xc2q\xbdd\xcf\x01b%'s\xe4\x11\xbf\xf5s\xb4\xb8\x7fU\x9c\xcd\x96\xc5XF\x85\xcay#\x8a\xe3\xda<W\xbbEq&\x17\xc5\x98?\xa3\xa2o{ \x0e\x94\x95i\xfa\xbe\xe2\xf3?(\x9e\x1f\x0d\x85\x15m\xf3\x15\xb7}\x95g\xf0?)\x16\xb33>\x99T\x0b\xdb\x97\xca\xeeL\xd5\x97\xcb?YY\xb1\x18\x8f\xab39\\\xcb\xe1@\x1d\x94\x17\xf2\xaa\xa5\x83\xa6\xe27\xf6P%iVF\xa3\x99\xc6!\x17\xe5\x1d\x9e7\x99\n\xd3\xc2vI]\xdf\x96U\x8ao\xd96\xb9\xc4\xc7\x03u\x15\xacD\xccSXG,\xac\xdd\xe1C\xbd\xbf\xd8f\x10D\xb1J\xd4B\x1e\xe7\xbf\xd2\xf9\xcd\xec\x9elaU\xddZ?\xde!\xd5\x0dP\xf2\x19\xeb8`\x04\xc1B\x9e\x15\x0b9\x1e\xd3j\xac\"\x0eh\xe1\xaf\x1dm\xd5\xd6t!H\xcd\x9c\xdd\xca\x1e\xf2\xfa\xacb\xb9\x11\x1b\xb25\xcf\x9bf\xcf6@n\xbe\x10\x8es\xbef;\xee\xa9D\x8c\xe0\xd1c\xe3\xf4*\x18W\x10.V\xfb\xd8\xd2\xfb-\"j\x9a\x8d\xc1D'\xb5a\xebL'G\xf3\xf72|\xa0}w1\xf8\xb1\x816\x06\x1a	C[\xbf\xc5|3_\xb8\xf9\xbe\xfeA\\\x0b\xd6	\xa6\xe5MC\x84c.D\x8fm\xf2\xde\x91\x81\xe5a\x98\xbb\xda\x8e\x19A\xaa\x1bm\x1a\xb1\xb0\xde\x18\xa4\xe2\xd9j\x0d\x8a\xae\xea\xf0Z\xdc\xf3UI x\xaa \x14\x03\xdf\x81\xec<\xa1\xf8v<@3\xd2\xb9g\xca{\x0b\xaf\n\x83VN\xe6g\x96\xf9?Z65\xd5<\xeb\x92\xd0\x90\x89\xc3Q&[Uq\xaf1\xef\x1c\x892\xe0+[\xe1\xc9[a\xa9[\x96\xb50\x8b\x88\x1e\x8aL\x9e\xb1}\x0b\xba\xb9\xa2BG\\\xba\xa8\x1aj\xdek\xcc\x08\x01\x1b^\x90R\xd5\xd34\xc4\xf8\x84\x97j\x9e\xa5~\xa9\xa9\x1f\xa4\xc2u\xe1\x85 )\xbb\x82\xf0\x82\xdd\xb3\x96@\xb9	\xc3\xd1\xcd\xea\x1a\xb2\xd7\x1b\xfeB\x90\x1bJ\xd9}\x18\x8e\xee\xf5\xb7{|C~\xca\xdeG\xaaC\xf8\x80\xa1\xec\xf1'\xe7\xce\xb9\x7f\x0d7!\xdb\x053[n\xf8Z'`\xf3.\xaa\xb3L\x85_P\x9a@\xf8\xfd\xfb\"\xa8\x03\xb9j\x9a\xe0)\xe4\x93v\xdb(k\xc6Bq\x06\x1b>J\x9b\x06\x01\x88\xae\x96\xeb\xe8\xad k\xb0\xcb\xaa\x87l\xc7/\x97\xf7\x00\xd9e\x1a\xe5Ms\xa3\xe2\x81\xc8h\x03\xc8\xb9\x0c\xc3K\xb2a;\x9d\xf3\x86>d\xfc\xad ;\xb6\xa7\xec\x86dl\x15\xeb\x84\xb2\x0f\x03p_!	\xcf\xe0\xaf\x12\x86d\xb7\xda\xaf\xca\x18\xbc\xc7\xc6<%\x94B6\x01\xf6}C\xee\x9b&\xb5\xf2\x12\xc5\x17\x95|7X\xdfN\xd7\x97\xe9u\xdc\xc0?&\xa1\x8b{u\xa2\xb2\x1dJf\xac\xa2\x87?(\x0en!\xe3\xf7K\xf0x	\x8dj\xd3Ihy\x14w\x94a\xafP#D\xd8\xa9\x01\x83XX\xee\xac\xa2'g\xb6~\x1a\xed(\xbb_\x9a\x1eH\xb6c\x15\x8d\xac\xcf\x92d\xbb\x8e5\xfa\xab\x0e>\xce\x98\xb1\xd46U\xb1\x92_L\xadVc\xa5\x04\xc9\xa0\x07 :)\x9b\xc6K\nN\x02l	(\xbd\xb1-\x06\xbd\x0c\x15Y\x9d\x1dX\x02)6\xcb\x873M\xe6g\xdf\x92\x0c!\x8bL\xbe5_\xf5A\x11=\xad\xf8\xa8\x04\xd9\xd24\x90Z\xdeb[!\xf6\x02m\x01mo\xb2G\xb9ypp\x99\xa9\xbd\xca\xaaC\xbc\xa8\xcf*\x1b\x02Gv\x87Z\x9b\xa1\xd25_]\x08r+\xc8\x9a2I\xe3\x16\xe3\xa1\x80\x16\x94{\xd9\xcd4\xab\x06\xd4W\xa3\xca\xa6\x10\xf0\xd8\xe0%\xe3q\xed\xc7\x00\xe8\xb4[\xd8v}\xa1\x08\xf0\xc0\xfc\xac\x0eC\xdd\x0d\xf5\x88\x93\xd4\xb043V\xc3\x15TG\x9c#\x0f\xea\xc4\x8c\x82\x13\x9cubUO\x9e\xe9*\x97\xc1\xd3 \n\x82\x03\xa5G\xaeP\x92\xd5gE\x18\xbej\xab\xac\x11\xef\x82\x15g\xd0\xbd\xabC\xc64\xe5\xbe\xa2yJ\x0fk\xe3\x08\xe54\x8c\xaa\x87\xf6e+\xbcX_v\xb6\xea\x96@\xe9\x08N\x94g\xf0V\xb0+\xc8\xb3ey-\x8a\xecw_\xcb\xeb\xac\x85XWtzg\xc5\xfe\xd9\x86\xe4\xe6\x089\x91\xcbY\x94\xdb\xe9\xa1\x8b\x04\x92\x03\xec\xc5=\x1a\xb7:2+\xec\xd3\xeb\xa2\x02y\x00U\x93\x8a\xff\xa2\x1d\"\x13\x10\xbe\x04\xc2\xe7\x84'\xa66\x9cov\xa75M\xe2\x8c\xac\x80$09\x88LD*\xfe\xbb\xab\x01`\xca\x9d+\x183\x88\xc2\xacR\xc107\x91\xaa\xd4[\x95\x13\xa8\x01X\xdb\xa8S\xeah\x93bhg(\xfa\xf9\xc5\xaa\x8c\xbd\xae\x02\x8f\xe9\x01\xe0	\x96JM\xf3\xc7\x8d\x97\xcc\x00iT=\xd2(\xa6vT\x988:n\x86\xad0,J\x96\xadz\x8cFwD\xb0\x9a\xba\xb9?\xb0\x0d\x16\x14\xe6IY>\xbc\x9e8\xdc\xb6\xec\x0e\xeb\x8a5*\xf1'\xe1\xff\xf4\xd6u\x94\xd0\x07\xad\xce\x81\xd5\x05f\xd9\x9d@\xc6 Fc\xd3WB	\xc6\xd4n[\x9a\x99NhT\xda\xa7\x05I\xf8?\x89`\xe4\x86\x97l\xcbgg\xe4\x9egV\xf2\xc3\xee\xf8\xec\xcc\xda\xc7\xb1\xae\x89\x85\xa5\x02\x80{\x8c\xd4\x9e\xcfX\xce\x83\x99\x92\xca\x87\xe1*f\x1b\xf4{\xc7oY\x8a\x03\xf4.\x0c\x8du\xf3\xdb\xe7_\x10\x9c\x82\x19eW\xfc\xcdXa\x1f\xcew\xcby\xd4\x896\xd54\xd39\xbb\xe4\xa9\xe9\x80\"\xb9\xb30$\xb7\xdcZC5MF\x17\xf9\x88\xf3\xcb04\xf1fJ\x9eBn\xbf\xc8\xc7cuZ\xdd\x85a	\xb9\xfe\x8cA\xde\xd45\n\xd1\xb5\x90\xb7\xa0<\n>ze\x0d\x06j~\xb3J\x8c\xc1{MJ\x94|\xc1\nJ\x1f\x8c\xe9vI\x8d\x08\x1b\xbdy\xc3\xaf\xe8a\x1b\x86\x84\x94|T\xa3\xb50\xdcO&L@\x94n\xb2\xabct?\xe69\x04\xf9\xe8\xef^u\xc95w\xaf\x9b\xab\xc9\x9am0\xb5\n\xbe\xf4\xb1;;\xdb\x1b\xe1u>\x99\xd0\xb5\x12\xbeo\xd4_\x82\x1f\xfe\x9b6PAH\xaf\x0dN\xc1\x0d=\xd8\xa3\xadb\x1b\xca\x10KL@b\xbc1\xd3\x18\x86\xf3\xb3\xfd\xf8\xde\xbdu,7H\xe5P\x95\x19\x1b\xbb\xe5;\xca\xd6\x07\xb6\x85\x94\xb3\xa2\x11\xac\xfd\x8c\x0c\xa3\xac\xb8\xb0\xd9\x93\x03\xbblM\x07\x8e@\xe5\xc8\x1f\x9c\x0fD\xf5\x81\xf8\x84\xad9\xbc\xb7\xaf\x88\xe0\xb9k\x06&y\xbec8\x98\x82\xb5\x19\x81\x9a\xa5gg\xa4\xe4k\x10\xdakO\xb9\xeb\xfcu\xc30x\xfd\x12\xd8\x9f$\xbc\x84\xae\xd9p\xf90\xd7\xf0})_\x85\xa1w\xf6\x94\xd0\xb5#cl\x1c\xbe%'\x06\x82_\xbf$\xce\xec\xd5\xc7TF\xf8)\xa9U\xb2X\x1e\xe5\xa4X \\\x91\xec\x98\xd1)\x07t\x83\x17J\x8b\x0f5\x7fg\x07w\xc8\xf8\x17S?Z\x86\x91\xb1\xd0\xe5,*M&\x03F\x90\x8a\xa3\x9f\x18b\x163o 5O:g(\x0ek\xe0}\x98i\x18\x8a\xbc\xe2\xfb\xf7\x8e\xc8\xba/\x97\x96\xe4\xd1n\xcc\x9d\xd14\x8d4\xfe\xd8\xa5%\xc226\xa7lD\x84\x93\xe0\xabc\xbal\xbd\xe4-\xac*\n\xb5\xb0\x9a!\x03T$o\x9a\x0d\x11lM)\xa9\x94\x85\x15+\x18\x02\x00\xd8\xde<\xd6\x0b\x84\xfc\xf2\x0d\x89\xf8\xb5u\xce\x0d\xa85#2\xd6\xbb\x01\xa4\x9f\xd7\xec\xd8\x18\x89\x8fF9{K(\xebZt>\xe2\xb65\x7f\x8f}\xec{<\x95\x07\x0c\xeb\x0d\xbc\x0c\xdb\xd7\xffE\x1b\xd3\x07,\xf8\x0b\xc0\xd97\x11\xea\x89\xa9 S\xc5\x99\xd94\x1b#\xb4j\xf0\xa9\xb9\x12\xd9\xe5\x95ln\xb3T^\x05\x9e\x00\\\xa1\x1d\xac\xdd\xa8\xb0k\xd3\x93|I\xa6e_\xc7\x12\xaf\xe5<z\x86\x91\xf8&nG&\xdb\x83\xe3RR\xbeS\xe5\xad\xe1\x8d\xa4k\xab\xaf\xf6C\x80\xe8q,\xf8\x83A\xeb\xacn\xd4\xa6\xe4\xe0 \xc3\xf0\x8f\xe5\x82\xedDX\xa7IT\xf8\xe8\x92\x99(\xb8\xddY\x0b\xac\xe4\xdau\xeb\xfb\xa3\x0e\xe1\x0c\xad\x16\xfe\xdckQ\xf1J\xc6\xcb\x9ep\xf1\x7f\xb2\x11\xa4\xac\x16\x07\xf2\x82.\xae\xd5\x96\xe7W\x08\x86v\xb7\xab\xf8\x95\xc3\xb0\xb5\xf9\xb4\n\xa2@\xc7\x90\xdcU\x8e(\xbd\xf6N\x08n_\xf8\x95yx\x83\xa8\xa0\xd7J\xb1\xc3\xaf\xac\x05\x1dC\xa0\xca\x9f\xbf\xfe\xeae\xb9\xe6W\xfa\x91]\xb7\xf6\x9eW\xee\x11\xed*\xf3Eg\x1d{e>(#\xcd\xb7|\x98\xd9\x01\x17\xc9\x9d}eaP \xe8\xf2\x95\x04\xfb\xf87\x173\x02\x98\xfdXZ\xa5@=\x0b\xc3k\xe5bY\x83\x8c7\xfc\x85	\xa1\xe7\xce\xc1\xea\xc0\xdet:\xd1\xb2\xfe0j\x1c\xb0\xcc\xeb\x8a\xac\xc2P\xe8\xc0\xa0\xc6.\xd1\xf3d<\xb0\x7f\xda\xa9V\x98\xb7\x83\xe8\xdb\xf0\xc4\xcf;&\xc6\xad\x10\xfb\xfdvA]\xb89\xa0\xbf/\xf9\xe9\xbb3\xb2J&\xbf\xc7\xabw\xe7\xa7\xe7\xb3O#\x15\xceL\x9eW\xe7\xc5\xf9&~JW\xdd\xf7\xf3\xd3\xe5\xa7d\x19\x9d\x9d\x9f\x9e\xcf?m\x10\x05\xa9\xed\xd5\xafPk\xe2`7\xf3\xb4E,\xd2\xeb)\x02\x1av\x0c\x87\xda\xae\x8fF\x9d\x18\xbc#\xce\xab\x03\xfc\x87\xed*\x0d\x95v\xd5\x83W.L\x11k{\xe4\xac\x89\x8a\xf7\x15\x9d@6\xe6\xc2A\x9a*\xae\x0d\xefE\n& \x8d\xb0\xef\x8f\x80\x9b\\\xcd\x9c\x15U\x01%-\x0f\xa2\xa2\x94$\x18\x0b\xed\xcc\x855\xb7\x14\xb8\xd6\xda\xb5N\x17KT^\x1c\x19q\x93\n\xb6\xfd\xab\n\xce\xacQ7\x0b\x11\xcc\x0cH\x0e\x0c\xe8\xa4\x0b`\x07\x84\"\xf6b\xef\x92\x07l\xf2cu\x08\x83J\xdd\x8f*\x9c\xa97\xe0\x9d\xa3	\x15\xf4\x91 \xcb\xd8.f\xe6\\\x03zCH>[\xc8\xb3J\x89\xaa\xb3\x0di7;\xc9\x10\xd5\x16MY\x84\n\x119U\xe4|\xc1{Q\x9c!\x07\xf3k\xd2\xf3B\x04S\x95\xb4\xa2\xb4\xf9Y\xb5\xf4\xd1\x12)hT8;=\x7f\xecf\xd5z\xed\xfcj\xc2K\x83N\x83\x1b\xbb\x8aK+\xff\xeb\x823\x14\xcc\x86t\xa2\xa3\x91\xc9i\xe7\xb65\x94\x0b\xc3\x7f:*\x0e\x13\x1a\xb9^\x18\x00:\x98\x08\x91\xbf\xb1\xaft4\xb1\xf3\xfa)9[\x9d\xdf\x9e\xff\x14\x8f?\xa5\xabw\x9f\xc6O\x1b\x17a\x8c>9]\x10\x17\x02}\x18\x80Y\x86e\x1eu\x96\xd5\x11\xd4\xbf\x0d\xf4\xd1P\xbc\x15\x0f\xce\xd4	\xb9\x9a\xc5a\x18|\xaa\x9f-u:\x99\xc7a\xf8\xe1\x99\x93\x8e-W8n\x10Gf\x9f\xe7q\xf4\x95f\xc4U \xde\x11d\xdfah\x0d\x9eA\xc4\xc9\xe9\xaf\xca_n\xa9\")P\xb3\xd04:\x8aC-]\x1a\xba\\\xd9PL\x92#\x12s-\x93b\x0d\xe6\xe1z\x89M\x1aI\xe6\x07$g\xd7 Pk\x017EU\x92\x99\x08\xbb\x06\xe3\x1c\xfb\xf8D\xaf\xd4\xaa2c\x99j\xcc\xa3\xfaa\x97%m\x83\xf0K\xbaU+\x0d\xf7\x91\xa5y \x90\xfc\x9a\xa1(K\xc4J\xc9\x82\x1d\xf8\"\x9b9`H\xc6_y^\xb8\xf0\xfa&\xd0	\xe0D\xd7\xf5\xceb\x9e1o\xe3\xf29e^\x05\xe6DP\x98\xc6\x15\x10\xdd\x02\xea\x8dF\x88Y\xbflO\xcd)b]\xde/\xcd\xaf\x82DrM\xa3\xeb\xa9\x0b\xd2\x0b \xc2\xc6=POn\x05Xc\xbf\xf1k\xf2J\x83\xe9\xdf5\x90\x82\xf6-d\xdd\xc0R\x9b,\xa3\x1f\n\x99\xe5\xcd\xf3<\xa7\xf4\x94}\xc3\x1f\x94UY%\nh\xcap\xf8#/\x9e\xa1\x9c\xc2/\x8au/J\x80\xe8W\xd2\x87\xfe\xd1>\xef\x1e\xedvJ\xc5\xa1\x83\x03a\xf2r\x84\x02\xf9\xb5\x1d\x90/01\x15\xe0\xfb#\xb8\x0d\x85\x05\x9f-\xc4Y\xb1\x10G\xf8\x0d\x05\x99\x84o\x8b\x85\xee\x19\xa27\xaf\xf3\xb2\x16~\xf0w_`\xc7g\x06\xfd\x9a%\xb22\x9ecL\xaci\x14@\xfe\xc8\xa1\x0d\x05|N\xd5\xd0\"\xd2U\x15/`\xf3\x01\x82c\xd1s\x9c\xc2nw\x80r6\x9fC\x86\x07\xb3\xd3D\x9b\xa2\x00\x81\xf6=\xd5\x1f9\xb7TTe\xfa`dH\x85\x15\x86\x0c#\xda\xf9\x99eS\xbb\xf8\xba\xa4Q	\xdc\x89\xb6;\x0be\xaa\x11\xcbc\xa4\xb44\xe78\x90&3\x90N]\xc4f\xac\x82\xb08\x05\x08+2\xc8D\xe1.\x93\xdd\xe3\x14\x97f\xc1\xabZ\x12\x80\xb7\xb8\x81\x0f\xac\xc5\xc1\x88z\xc4\x924\x8d\x86(\x99\xfei\xd2\x19Y\xe7B\x84K\x01)\xa3\x02;JqX$i\xfaY\xff\"\x05\xbf\xd2$M\x89a$\x96\xbd\x10\xfdQ\xef\xdd\x02+\xc4\xce\x07\x17\xe0\xf8a\xc0\xf6\xc3Z\xc6\xb4\x83w@o\x9c\xbbZ)\x08t\xf4\xca\xb1\xc8\xec\xe7\xa1\xc5y\x0b\xbf\xda\xb6\xb2\x80\xba\xdcj\xd7G\xc3V\x0bG\xc5T\x84\xed\xa2o\xbbaz\x86\xdd\xdf1\xffE#\xc0\x11\x03\xfdQy\xfb\xc6\xc1\xa6\xee\xe7y>T\xfd\xdbG\xaa\x7f_\xf6GZ\xf8\xe31\xfb\xc3P\x83FM\x7fb\xaaz\xed\xa9\xa2\xb5n|p]\xde\xc0\x93\xb0]\x17\\\x87@=nYi\x91\x1c\x1e\x1e,\xefK\x14\xe8\xa1E\xd4\xc7\x99\x87\xad\xfaM\x01\xebv\xb7<\xfa\x12\x11\xb03\x81\x14\xdb]\x9eH\x81\xb0\xfb@\xed6\x1b$}\xee\x04W\x91\xa6Zkd\x80yK\x0e+\xe93P>\x9c4\x07p,\xf7.\x04\xc9\xda\xabH\x025\xed\xb0j\xaf\x8c\xe4m\xf21\xfa \xb9\xa0 \x0e\x8e\xb0\x0e,\xd9P\x99\xd9l\xa0\xa9(\x9b\x9fy\xc7k\x18\x92o\xd4\x1d<\x1d4PP\xf6wCC\xa8\xc8(\x95\xb8\x11\x95\x92*\xb0\x1e\xf6(\xa8%\xf8\xbe\xe7\xa7\xabw\x1d\x16l\xec\xdfS\xf3\xb5\xb7T^T\xbc\xd7\xf8\xac\xdd\xf7\xbc\xaf?\xf5\xd4\xdb\x0bx\x80\x8a0\xdc\x12\xc4\xa9\xdfU\xe56\xab\x05\xb5(UE\xbb* H\x98n\x92,\xc7i\xe0\xf2\xca+Q\xb4\x19\x95\xa0(\x92Fn\xa7\x89	8v\x9a\xd9\xac\xa8\x17m\xaf8\xcaE\x0f\x87\xeb\xe9\x8b$\xcf/\x92\xf5\xb5\xe7\xf7i\x04\xc4\x02w=\xf0\xa35\xa8\x96\x10!\xea[\xa2\x0c\x9a3\x8e\xb8\xe4{%j\xf5\xb8$\x1c\xb2\x05n\xb9\xc0)\xcc\n\x903\x96&8\xb0JS,P\xeb\xf6\xb5\xfd\x939[\xb7\xfd\xd1<M\xc2\x93\xa6\xa9\xa6\xb8\x8f\x01\xeas>\x9a-\xf6\xe6|X\xe4|2\xa7\x0f\x92\xef\xad\xe0\xd6\xc8 \xc6\xe3\xfc\xcc\x9e\xeaTY\x9f\xd7\xab\xdc\xaaA\xe5j\x163iL\xee\xa7\xb5,w\xdf\x14\x9f'y-T\xc4uG\x0cH>\x82\x8b\xe2t+\xb6e\x05\xb5\xbf\xfa`.\xf9\x08CRs	\xfd?|c\xd8\x86?t\x8e)\x07!5\xa0:\x0cGY\xa7\xee\xc9\x9c\xed\xad\x8a\xb25\x80=QX\xc4N\xad\xfb\xacgsK$]V\x06\xb6\xc3p3\xbdJj\x02\x11\xb7Q\xf4I\xaa\xef\xad\xb0\xbb\xc1.\x1f\xf4\xd0D\x02\xf8a\xdaK\x0f\xc4\xdd\xa5\xa2v\x1a:\xb6\xb6\xdb\xe1\xc0t\xb4\xc5\x81q\x98^\xb9\xc2\xbd\xde\x01l\xac\xfc\x07F\x03\xd8\xab\xe6\xba\x07\"Y\x8d\xedJk+\x8f. \x8f.\xce8\xecy&\x93\x83m\xbbO,\x9a\x19\x14\xa0\x8f\xda\xda\xa0\xc5\x8bfnq\x8f\xec7;3\x0f\xe5\xaa\xad\xde\x88\x00\x07\xc6\x96p\x05}5\x97pC\xea\xe4\x1eX\xd2Q}`y\xb9\xbe>N\xb1\x15A#\xd64\x80\x0f\x0ey\xa9\xae\x10E\x06\xab\x1b\xc1\xa67\xab\xc4O\x99\xec\xdf3bF\x92(\xd8[	\x06n\x0b\x1b\xcd\xa81\x97\xd6\xe9\x88F2n\x01\x8aeM\xe3-)\xean\xebu\x95n\xa6\xb6\xd1\xfe\x9dJ\xa6\xc3H\x1e\xecoy8X\xc2e\xe3_\\\xf3RlD\xd5)c\xac\xf8K\xbeZ\x05E)\xb3\xcd}\x00\n\xa1\xbc\x84\xdfr\xc0<\x1cD\x02\xbd\xcb\x02\xfa\xc8\xd7g1[\x05\x95\xa8\xcb\xfc\x06bh\xa0\xc9^\x05\xc0\x0f'\xc3\xb5t\x93f\xccV\x94\x06\xba\xd6_\xc5Z\x06,\x00\xce\xfd_+\x9d\xa3R\xd4\x83Jc\x96\xf1`'\x8a\x14\xf4	K\xf8C-\x139\xb4\x08\xb0;\xc9o\x93\xfbz \xad\x9eb\x90\xdev\xd5g\xc2\xd1:\x05*\xacj\xd0\xd6\xd0\x1eO\x89:5\x8c\xd9\x07(\xb7l\xd7\xe9\x05\x16'k/W\xb2\xabz=\xb5K\xd9\x8a\x97*\x87\x98\xca\xa1\xad\xcf\xb7\x100\xad>\x8a\xc1\x1b\x9a\xa7E\xbd\x02\x92\x8d}\x19\x152\x0b\xc49\xb4\x87R\x0f\xf6\x16\xea\xbc\xf3NF\xf7\xa8\xb8\x03\x0d9\x04R=@\x939.\xab\xa9YO3Ex\xc7R\xd0\xa8Z\x01\xd7\x8f\x03\xec\xad \xd6\x8d\x15\xeaz\x9a\xb6I\xc4\xc1d\xda\xf8F3\xd88\x92	=0\xcc];[\x9e\x91\xda\x9e\xcfZr '\xc6\xea\xc3\xcd\xbaM2q{\xb5t\x0dA;m\x93\xcc\xb37\xb0'\xaeT\xbc%\xc9\xce\xf6Zy\x87K\xaf\xcc\x14A\xbe\x0b\xfdX\xd9v\xce\xbb\x93\x12\xdc\x82\xb9\x97\xf2\xed\x95\xb6\xd7?\xa9E\xbe\x99\xa89\xd9\xa3\x11Dj\x821\x00\xf9\xb3\x97\x02\xeax\xadW\xa2`\xea\xd4\xa9\x97\xf6\xfa\"\x96\x93=+\xd9\xd7\xac\xa6\xe6\xf15\xab)\x8d\xc8~<f\xef\xcf\xe4\xbe\x96f\xf1\xb0&\x14eqW\xd7\xd7\x8a\xbe3tL\xc5\xc1\xc63R\xc3V\xc0,\xad\xca\xad\xa7\xe2p`\x92\xd7\xcb\x0e$+\xb2\x8axDO\x0b\xc3Sq\xb7\x16\xca\xa9\xe0\xefey\x1d\x86\x8f\xa5\x00\x9a\xa75\xc4\x8bo\xabd-(\xdb\x9f\xf1l\xac8\xf4\x11\xe7\xaf\x07:X\x1a8C\xdfl\xd7\x16\xd9R\x12\x1a\x11\xaf\x95K\x81H\n\xebk\xdd<\xf1\x1b\xe1\x8fd\x03\xf6~\x01\x0d\xdc\xdbl+\xca\xbd\x8a\xc8}8\xbcg{\n\xfa\x00\xa5\xf0\xea\xc3X\xf1\xae9\x991\xc1\xb6\xa4\xa2\xcb*\xfa\x9a\x89\xce\x943\xa8\xd4\xfb9%]\xca\xe8k\x95\xf8\xec(Q\xddo\xf6\x9a\xd2\xee\xfe0\xd08\x84x\xcc-\x9dKw8 \x80\xba\xf2\xf8\xaa\xf9\x83;=\xde\x8bP$|!!\xf3\xff8^$\x1a\x91p\x15\x08\x95U \xe41J\xd70l!\xab\x03+W\x1fND\xbcz\x16\xdbh]\xf6\xcb\x87\xfe\x97\x99\xca\x81\xe3\x98\xd9)\xc3\x0be\xaav\"\x91\x1bg\x1fe\xc0_\xb3\xd8\xe3k\xdc\xf8\xeac\xbc\x82\xa86F\xaa\x17\x0d\x1f\xa8\xddB\xbcp\x070\xcc\x9b\xdc\xc4\xd6\x14F+B\xef\xa5\x1a\x1b\xa7>\xb0\xdb\x0e*2\xc7j\xd1b\x14C\x0c!:6\xab\xb8!\xbb\x80\xd5\xcc\x0d\x93.#\xc2\xa8\xb70G(K\xda\xd1\xc9c\x0c\x86\xa3\x04T\xbc\x1a\x10\x84\xff|~\xd6o\xd4\xdeb\xd9\xb6\x11	6\x99\x14\xbd\xcd\xabXE\xec\x8f\x0d)\xce8\xb6\x15x\xa3\xd2\x1cr\x00p\xbb\xd5\xdd\xbeb\xa3\x822w\x8e*\x0c\xa8\x0eRBq\x8d \xba\xa3\xce\x9aX\x9dr\xad5C\xa9\xde\x1d+ '\x13\xfa\x93\xca\xcd\xd0\x8c\xab\xdd\xc9,=\xbcjY\xc0'\x10\xa6\xe2\x92\xb8\xe6u!EU$y\xf3}R\\\x8a\xe6{\xcc\x9c(\xd6\xa2\xd1\x81Y\x1a\xa0\xdf\xe6\x87\xef_S\x85\x83\x9f\x9c.\x1eC/\xed<+ \x7f\x01^\xbb.s\x11\x86\xeeqz\x9bTE\x18\x8a0|bd\x8cS\x04\xba\xa3\xfd,\xf6\x06b\xd7\xd2\x89k	>wb\xba\x15u\x9d\\\n\x04P\x00F\x81\xbf%\xbb\xd6\xf2\xe5W6\xa7\xd7\x1f\xfa\xd0\xc15.\xa1\xe5g\xe9AM\xcb\xe7\x1d\xd8i\xcf\xc0\xcf\x08}xe\"\xb5\xf7\xe2\x14\xbf\xfc\xe6k\x13e\xe5\xab2IE\x1a\xb0\xcf({1\x9cWG4\xfe\x8c\xda\xbe\x12}5\xab\xeex\xa7\xbff\xe5>\xd7+-\xe8\xca\x10A\x1e\xad!\xe8C\x7f\xc8p\xb3\xb2\x9b\xb1%[\xdd\xd5\x89s\xa6\x1a\xfa)\xc9dd\x9e;\xb8\x8dh{\x80\xe5db\xfa\xa7rB)\xa1\xee^\x84Q\xa3{\xc1\xbd]\x90\x90\x87\xe1\xec\xac\x93\xbfi>\xf7\x8f4\xf2\x8a\xc1\x02\xf7pp\x83VC\xe2zd\xb8\x1bq\xbbC\xd8~@?f8I\xef\xdf`\x0b4\x8d\x9a-\xd0\x97\xa3nJ\x18\x8e^\xb9\x18Q\xc6\xd8e\x9a\x96o\xd6U\x99\xe7\xcb\xce\xa1bZ\xa4\x11y5\x10a\xfa\x91\x95;\xceh\x97Ms\xf5\xbfx\x0b\xe5\xdf7\xaaq\xd6\xa0\x7f\x02\xe7\xea\x12\x8c\x96:\xe1\xb70\x05\x80D\xbd\x86n\x07,?+\xe8/\xaa\xc2\x9a\x15\xab:f\xa3\x19\xa8.s\xe1]\xb61\"\x10\x08\x94\xc2\x90\xa8\x028\xfd\xd4E\xa3\xb0\xd7\x86\xd9W\xb2$\x8eD\xa9\xecM\x024\"9\x97\xecXcg@,\xf7\x84\xdd\x85\x8a\xc2h\xb4N\xce\xa3B\x12XT\xb3\x82%\xcb*\xaaL\x03\xf8R3\x9b\xe4yQdK\x11\xe5\x8e\x9e\xa2\xd1~\x89L3hU\xa3\xf2\xc0~\xe7\xa7\xef&\xdbzr\xca~\xe0\xa7\x13m%@}\xe9\xd3\xcf]	\xf8T\x96?\xecv\xd6T\xa6\x95;\xfd\xe8\x0b\xa9\x9c	\xef\xef,\xd8\xd6\x13/n\xce\x0f\xecgm\x94\xf0\x857\xfeG4\xdcM\xd3\x0d\x87\xd84\xa3q\xab\xf4\xf2\xae\xd6\xfe\x97B\x1c\xb8|W\xdfd\xca\xaf\xed\xd3\xf8_\xd3}\x96\x8e\xc7\x07\xf5\xcb\xe7\xec_\xfe\xa5\xcb*\xc8\xd1\x90\xc4\\\xdd\xa4k\xeb\xe8\xc5Qy8\xb0/\x94\x05\x9a\xf1xD_\x96\xbd\x12\\F\xe6jt-+\xb5w\x99\x1b5\x94\xad\x99\x19\xfbc	\xcd\xd8&\xbb\xdcW\x90\x00@\x1bF!\x1f\x81\xff\xa9/#\xef(v\xd5	\xa9\xef\xdb0z\xa2#\xf1\x99\xa4\xd9\xeaG\"i\xcc\x8b\xf6\x9aF\xa3\xbbDJE\xbbWTf\xfd\xeb\xa3\xbdu\xd7\xd0\x0ek\x87e\xa7\xe1\xa87\xf20\xec}\xd0=8\xb0d\xbd\x16u\x1d\x0d;6z\xd57\xcd#\xd2X\x97\xa5X:\x15\x0bz\x18)&\x0bX\xc6\xd4\xc9\xdc\xce,\x96E\x84\x93\xa8/c\xeah\xe4z\x03Xt\xb6\xb6\xe2\x8c\\}\x92>\x14\xf0\x0d\xed^\xd2\x0bBV\xc9\x9c\x7fD_8\xc6K3D\x18\\\xc98\x92\x1dy\xa5\xd52\x19B\x01\xc6\xda\xe6\x92\x14\xf0\x8dE\x1c\x1fLc\x98h}\xeb\xafw\xf5,\x84\xac\xef\x07;C\x0d\xba\x8bW:#\x03\x1bs\x95\xd4/\x13\x99\xfcy\x98o\xc7\x1e\x86\xa3~\x7f\xe0\xb9\xafP\xf0w\xcag\xe1_\xecK\xf3\xfb\x0fc\xbf\xf0\xa0\x8d\x17\x9e\x9e\x1f\x9a\xf3\x95}\x8e\xe9\x13u_\xda\xea\xf9\xe4\xdf\xb1\x8fi\x84\xe8\x18{i\xe3\x057\x1fGw\xa2@\xb5Y\xf1 Md2\xf1#\xe8\xfc\x9b\x05\x93'a\xd0w\xfa\xefo\x0fR\xf4m\xf6`\xe2\xac\xf8\xba\x82\x07\xb2\xda\xab#\x90d\xbc\x80\xd3\xef\x06\xd2Z\x9c|\x10\xab\x06\xc5>\xcf\x91\x9a\xe9\xe0U\x08e9\xce\xc6A\xb0\x1cg\x91\x89\xf4\x9a\xd1\xe5\x97o\xbe\xf9'\xd425<\xf6\xa2\xcc\xe3\x15\x0f_z\xf0\xaa}\x99,\xabg\x91Mqh	\x86\xa1U3\xd9\xbe\x9c\x9aD\x02\x13\xd8\xef\xbc\xb7\x03K\xbb+\xdd\x89\"\xf1\xe5ToH3\xe3v\x8f\xf4\x80\x03\xfb\xe4KC:\x99\xb8\x8b\xbf\xbc\xaf\xd6\xef\xfa\xb5\xfe\xf2h\xb5\xdfu\xaaU\xd4\x88\xa7\xc6\xef6\x02\xcd\xb3\x89nb\xc2\xe1k\xad.Kx\x19\x86\xa5g~\xda\x85\x18\xb5{\xbb\x1a\x9b\x8c\x7f	\xf6\x18\xa6\xff\x80\xa6\xd2AS\x18\x8e\xbe\xd3),0\x93\x08\xc8\xa8\x03\x05\x14<\xe9\xee\\\x90\xf8\x89b\x07\xd0\x10\xa9\xd4\x15\xde\x9avv!\x1e\x0cdb\xcbV\xfcGb\xf5N\x1f#\xce\x98\xd0^\x90\xab*\xa6t\xf1\xdd\xb4\x1eh\x17\xf6(\x96\x01\xcf\xccCK\xb0\x18\xc1\x89\xc1\x85\x8a\xabu\xd3E\x1f4xa\xe4\x8a\x84\x88~\xe9_\x90o\xa6\x13\xd3Uz\xa8U\xd0\xfe\xb6'\xd2N\x18\x88\x8a\xa54\xa1.G\xdaG^\xf4>/\xfe\xb07\xd0EBC\xab\x0d\x86\x8ey;\x1d\xd3d4{\x0c\"\xdd\x89\xf1HK\x06\xa8\x90\xaa\x9bR\x90e7\xd2o{\xb1\x17\xc3'*\xa6\xa2\xb5\x93\xe20N\n6w\x88\xc4\xa5\n!\x08\xa4\x86\x0fl\x03V \xceE\xd54\xdd\x93\xa0\xa0\xcb\x8aw\xf6\x80oSSP\x1a\x193Sx\x8fU8\x10\x0e,\x15\xc7\x9d\x82~\xc9\xb4oB\x96^#j\xf7^o\x16\xef\x96)H\x11\xad\x1eJ\xf1\xd9\xbf\xa8l\x90\xfb\xa8=H\x17\xb0v\xb6\xe2o ,E\xab\xb6e\xe0\x0f\x0c_\x11\x82\xb6\xb8\xb1W\xb51t\xfd\xb2\x94\x99C\x05\x1cq\xb9cN-\xe8&\x13\xdc\x8f\x19\x8cA\x15%\xeeU\xa9\xc2P\xedQ\x15vB	  \xe9\xf4\xfa\x19\x0d\xcab\xcc\xb4\xab\x1c\x81\xc5\x89v\x01\x80\x91\xbdi.\xd8\x83\xaa=z\\Z\xde\x97\xdex\xd8ge\xdb\nX\x81 B\x87cl\xd4[!G\x86	\xfe\xcct\xcd\x1e.N%\xae\xd5\xc7 \xf31\xb3L`\x9e\xfb\xb0~\x06q\x95\xaa\xdb\xdao\x81\x9ci\x0f<\xb5\xb7\xa3A@\x07T\x08\x07\x15\xc8\xa2\x10\xee\xa2\x0b\x01\xfa;e\xed\xe2\xfaK:\xb2\xf6}\xed\xba\x99\x02\xd8\xa1\xa9\xe8\x8d\xfa\x0f\xb6^\xaf\x12\xb5\xf7\xd8:\x17I\xf5\xdd{\xeb1\x00\xa3\xa1\x1d\xfe\xd0Cb>\x9f^\x9b\xb3\xac+P*\x15)\xcc\x92\x8e]\x95\xa7c\xa6\x0f\x93I\xd54Y\x87\x0b.\x19<\x8b\x0f\x8b\xa3\x85\x83\xd4Zr\xc1\x849\x8f\x95\xb3\x8e\xd9\x8b\xc6Mr2\xa1\xa40\xd8\x00\xe1\x9b\x98\xe8@+,\xf8\n\x0d\xef@\xfd\xe313o\n\x08\xeb\x963\xab\xb5\x17\xa4\x11\xc3I'\x0b\x92\xea\x9a\xd8I\xbc\x04	\x95>=\x9f6\xf4<\x1d\x93e\xb4\x12\xafb\x95p\x9e\x8e\x1bzj\xee|\xc3\x95\xb0~p\xc7w\xf6\xce^\xca\x1bJ\x82\xb1\x84\xad\xb2b\xec\xfe\x1a?uW\xf1V\x82\xaf\x82\xb7\xe5.`\xc1\xf7p\x0d	X\xf0Y)e\xb9\x0dX\xf0\x95\xd8\xc8 \xc6U\xadG\x8c}\xe7:\xd5v)=\xb3\xdf^\x04f@\x02K\xc0l\x95\xdb]Y\x0b\x84\xd88,2Ez}_\x96\xca`%\x0c\xc9\xffPm\xd3t*!\x89\x8a\xac\xdek\xdeLi-\xf8\x10[\x13\x14\xd0\xea\xe1\x04\x17@\xb8\x82Nky\x9f#\x82F\xbd\xcb\x93{\x04\x9cR\x1ch\xe7k\x18\x96\xe0|\xc2\xd0\x95\xbe\x9e\xae\x15\"\nL9\xe8\xe7\xf7\xdd\x16[]\x8eR\xde@&\xed|m%M\xe0-o\x9aY\x951k\x1f\xb9\xc4m=.+p\xb7\xbd\xcfU\x1d \x90\x0ex\xb9Q\x91\x05\xaf\xcc\xe3\x90s1\xd4\x89g3V\xf3\xaa\x8d\x1c\xe1\x96\xb3\x9a\xae\xf7\x15\xf1\xa3\xc7\xfb+m\xe8;e\x86\xb0\xe7\x80\xe2\x1c\xd4y\xa1\xc2\xca\xc2\x02\xbc\xae\xf5-\xfbpd	\x10\xbf\x11w0\xac=\xeb\xcd0\xecek\x9a`w\x07\x8c\x94\x87\xe1x\x8f\x0d$\xb4\x15\xb1\x9dZI\x95-\xe4:\x0c\xd7\xab\x0fcd\xa4\x0f\xfbS\xfe\x8c\xe5\x88o\x82ol\xcd\xc7\xfb\xa6\x99{\xbb\xf4ZO\x0c\xf0\x07[\x8fs\xca\xc8|R\xd2\xa7d>!\x88\xcfFO\xf7M3\xfd\x98\xd23\x04\xd7#	\x9fQ\xb6>\xe5\xe5b\xfd\x94?cG\x85\xcd\xf5\xb4\x96&\x03	\xb0\xe6\xe3u\xd3\xa0Y\xd8u\x16\xaby\xbc\\\x8f	~\xc7s\xfa\xb4X=\x8b\xa31\xfeB\x83@\x94)\x85\xe49\x83\x05HRI\xbef\xd5T\x14)\xc2\xbd\xb1L	E\xd6B\x01F\xff\xa6I\xe7x\xd2\xf3\xf8^a\xd03\\\x81j\xd0\xdeb}\xb6Y\xac\x11t\xa3\xe2\x02aH\xf4\x18\xac\xe7\xb5\x0f\xc5L.\x89\x03\x7f\x8c%G\x1c\x12\x8d\xd4*\x0f\x92\x9bFQdHm\x1a\xd2\xab\x04\xd6\x06\xc6=\xab\x97\x82H\xc1\xca\x02J\xd7K\xf6<\xe1\xa5A\xa9,\x01[U\xd1\xeeNe5\xcf\x9c\x8f\x0b#{\xbe\x86L\x0b\x02\xb5\x92'\xd3\x8b2\xbd\xef\xdc\x90\x92\xf4\xbc\xebjJ\xd9\xde\xec\xc4\xd2\xeb?+=\xcb8s\xea+\xab8\xb0\x00n\x02\xf6\xea\xfa\xdf\xe0\x02z\x19\xc0*\x9a\xe6{\x98nF:\x8f\xb9+O\x0dF\x7fa\x9al\xf7\xe6J\x8b\xdd\xb0Xk>sK\x81\xe9\x1bq\x14\x04\xef\xbeZ\xc7\xdd\x89R)\xeeH\xe8Y3\xd7W\xe5\xed\xc0\x1e\xdc\x98CV\x91\xc9WY*\x06\xf6\xa9\xc9\x03\xadryy\xe9[\xa18\xac\x1d\xe0\xc6v\x91\xf8\x9e\xb8Kc}\x8a\x86\x891dG\x03\x84\x0e\xd3!\xb5iey\xad\x7fmA\xfb\xaa\xcb\x1e\xdc\xd1\xb6\x13Z2`c05**\x13<\x8cX*\xf8i\xd7K\xa9c\x11\xf7\x94\x9ef\xec\n\xc5\x9f4\xef\xb6e\xba\xcf\xc5\x93\xe6\xfc\x94,\xa3_\x93\x9b\xa4\x11\xebmB\xebu\x95\xed\xe4i\xc6.\x05\x7f(w\xe8c\xb4\x9a\xb3\xc0\xde2\xb5\xdd\xe72\xdb\xe5\x82\x7f`\x9f>\x80Oe{\xfdP\x0c\x15|\x92\xeaB*\x16\xbdN7\x8f1[\x97y\xb4z\xe6\x12\xcf\xd6e~Y\x95\xfb\x9d\xce\xe6\xde\xbc\x12\xb2\xea\x14\x90\x00dS\xa9z\xf4\xb3\xa6\xd1\xea\xc3\xb6n\x93.+\x93\xbd\xfat\xa0\xcc/\xc6E1Z\xcd\x10\x833\x08b\x0f\x7f\x18\xee\xda\xd8JY\xbc\xf5\xf8\xadwFx\xdf^q\xb6|\xe4VD0'O\x03\x1a\x0d\xd7\xd4\xbf`hy\xfc\xc9\xd5\x80\xab\xc6,\xc1\xabe\x7f\xb0\x13\x95t\xe9\x8cA\x85\x92T\x17\xad\xa4\xf9\xbe\x8f\x15\xf9\xcc\x0b\xd5\xe2\x02\x8a\xe8\xed)VE\xcc\x82\xcb\xbc\xbcHr\xa8\xd7\x02\x06\x1f\x14\x8d\xe6d?\x8d\xd2\xc3\xa5\x98\x96;\xa9V\x91\xeb\xe7\xac,\xd8\xa5\x98\xaa\xd5\xc0'\xb9)K\x89\x07\xbb\xda\xea9Q\xd0\x86G\x05A\xaa\xc4\x95zM\x15\x0b\xb7\x15\xecN\xb0\x0b\xc0x\x13\xfeey~;^\x9c\xb6\x0bu\xfbX\x84%\xef\x12ui\x10\x9e\xb5\xb1\xfd\xbcJ.\xb1Z*\xc2\xf1*f)\x9f\xb1\xabv\x1a\xd2\xb3\xabE\xaa}\x16T\x04\xa64\xa6M3\x83\xc8\x83\xf65\x1f%\xa5v\xb6w\xac\x95\x88,We\x1c\x95\xad\xc2\xe3\xc2x_\x97\x14Q\x0c\x92\xa6\xd9t\x10\xb2\xed\x9f\x81\x16x\xc0\xde\xe8k\x94Hj\x0e\xf3\x12F\x9b\x1aJ\xe9q\xac\xfb=\xbf\x04\xdam\x9aK1\xb5`\xcd\x12\xcfo\x18\xb7\xb1\x8f\xaf\xa7Wr\x9b\x7f[	c\x98[\xd2\xf1\x1e'\xec\x9a\xef\xe1\xac\xa7\x0f\xff5\xc43\x90\xd9\xd8P\xca\x8bv\x80\x89oZ\xcc\xd4\x15\xec\x9e\xed\xb3\x1f\xa8\x94\x07\x81\x893d\x0c\xdd\xcc\x08\xdf\x8a;\x89\xe2\x98\x89\xc5\xa6W\x02\xeb`zQ\xf2\xdd*5\xf1$\xaa0\xec\x18\x15\x960\xb1\xc9\\\xb8$o\x9as^\xa2f\x96p\x04\xe7\xebL1N,\x8d\xe3\x02\x153\xeb^\xa8\xab\xe0\xe9\xc3\xdak3Y\xad\xd1\xe6\x95]-\x1b9\x11\x82\xa8\xc2F\xa6\xb0D\xcc\xe6\xb0\x15\xfc\xd5\xa3\x90\xd5i\xfd\xd5#\x0bL\xee\xda*\xfa\x17\xb6\xd1\xe1\xfb\xda\x14\xd6\x0f(\xbb\xeb\xdf\xbdn\xa2r\x06\xcc=\x0dd2\xb7\xb8a\x12\xb6\xdd\x0b\xdf\xee\x04e\xf7:\xb4\xe7\x8b\xbc,\x04\xdf\xe2Z\xd9\xb2\xd07\xaa\x8cf\xb4\xf7\xe6\xe0cj\x9aC\x85-\xc0\x05gX\xdb\xa4\x12\xc9\xa7wg\xa7\xee9`\xf7\xd3\xa2T\x0d\xbc\xd0\xc5\xf8h\xb4\x15\x8f\xd6m`Yy\x91+d\xf0\x16\xc7\xd8\xb5\xb8?e/\xccy\xb8-\xf7\xb5hve\x06\xe5\x7f\x03\xa6G\xdc\xc9\xad(\xf6MZ%\x97MZ\x95;\xda\xac\xf3l}}\xca^\xa92\xabw\xd3\xf8)\x05\x878%\xd31m\xa8\x87N\xae\x85\xa3\xd7G\xb3\x16q\xbe\xf1>{w{\xff\xd3\xe0S\x03\x12\xf0\xb8\xb5i\xd0\xab\xb5\x17\xd4\xbf\xea\x86z\xf5\xc4\xd9\x07\xf8\x1e\x93@\x05\x8a\x051(=\xc5\xe0s\x0f\xb7\xd9(\xd5	\xab\xbb\xfaWs\n\x19'k\xe8`\x8f\x18s\x90_\x15.8+\x98\x95\x95\x83\xf9Q\xd5C\xb0\x08W\xbb:f\xa5GKe\x1b\xe3S\x03\x03\x1e\xa5\xf6\xcd\x96\x10\xe8C\x82f\xab\x88L\x02\xc4\xfa\xa6IO\xba\n:\x95U.\xafy\xf5z`\"\x8dg4\xe3o\x84\xdb\xc3\xa3\xccJ\x12\x9d\xc7\x0dr\x95\xd0	\xf3\x8c\x91\x8c\x1fSc'\xd7\x84N\xcb\x0d\x02\xc00k\x88\xd73\xeb9\xd0\xe9\xe5>Ky\xa2~T\x04=<\xf0k\xf53\x1eC\xb2|$\x0b\xbe\x9e\n\x18H(\xe1\x83\x11\x14e\xacRr\xe1v\x91^b\x16\xd5\xf2\x94KbNN\x961\x84\xe0\xf5\xcb\xe3\xdb\x03\xb6\x9f\xbaB;\x1a\xcd\xd9\x95\xba\xfc\xa5\x1bD\xd6\xdc\x9c\xe3$\xa6\xaa\xd5L\xf1o\x88\x97\x91\xd5o\xab\xec\xf2RT\xc6;+\xd3qVl\xa8\x10Jl\x8b*\xacA\x92\xaf\xb2X{\xb4@\x02y\x99H\x13\xb4\x05\\o\xb9\x83f5\xb9TA\x8fI\x8bC\xab\x013\xa4\xefZ\x998\x02\xd11\xc9K\xdb3f\xfaA(\xc3\xed\xdbN\xb8c\x92\x11\xe1d\xd9)\xae.\xc0\x86&\x18\xd9\xbd\xc0\x19\xb0\xec\x7f\xbd\xdd\x8a4K\xa4\xe8t\x8d\xc1\xacT-\xc4K\x8d\x0d\x08\xcc\xbf\x94\xfaW\x9f1m\xa4\x14\xd2\xe9\xa9Q\x11\xdb9\x91z\xea\x88S\x0eU\xd0\x83\\O\x95\x85D\xab\xdb\xa6\xcc\xaa\x1b\x8c\xd3(LN\xdf\xd7?P\xe8\x9e\xec\xd1JZ3\x1a\x86}\x00\xb8\x16\xb0\x92Qc\xe1\x0f\x9a\xb2\xc2\xa5?\x1do\x04	o\xe0\xa3HQ\x9a\x92a;fb9\xdaiV\xa0q\x03\xbf\x11\x03N\x8aa%%/\xa8\xfd\xc22^\xba\xa8\x15Jd\xfd\x88\x97b\x86N\xb3\xc2\xee\x90\xa2\xb7C\xc0X\xde\xe8\xce\xd7\xe0-\xdb7\x15\xf0\x93$\xfc\xc64\x89\xd4\xf6m`\xc3\x0e\x11\xbf\xd7a\xd8[)u\x83\xbf\x0e_\xbc4\xb3\xa6X?\xa8\x02\xed.\xf7L\xe1\xcd\x12 X0'\x82\x13\x88\x1e\x03\xea+\x91A<\x19X1g>\"D\xa5\xfc\x12\xb7D\xbc2\x94\x96@0,H+@g\xb1+N\xea\xd53s\x83\x91\x89\x883\xb5!q(K\xc3\x90lx\x7f\xd7\xa5j\xd7\xb1\x94\x93l\xb9\xe9\xec\xbdh3\xbd\xc8\x8a\x14\x8f\xb4iR\xf6h\xd95w`\xfa\x00\xa8\x8cRVV\xd9%\nF\x97Z=Y9\x0cR0\xacT\xa4\x17\xccD\xde.\xab(c~t\x8c\x08\xb1;\x1e\x0b\x9da\x15\xaf\xac\xc5PW&\xdc\xcf\x14\xb2\xaa\x922\xb2\xe3{M\n\x13\xf3\x08\x7f\x0d7\xba\x17\xe5\xbe@,\xde\x0dv\xe0~\x17\x86#8I\x9a7\xe3o\xca*v\xc5\x12\xe0\x84#\xcb\xa40<\xfeFR\x96\xc0\xe3\x06	\x98g\xfc\xda\x9a\xd6\xb8\xaf\xd4\x8c\xdf\x02l\xf7\x03\xd7\xd3A)\xcb\xda\x10\x97\xbbn\x7f\xc7c6ck\x1a\x19*u\xdd\xa2l\xbd9Wi\x0c\x8b\xa4\x83\xd5\xa8E\xc7\xe6R\x7f\xb4K[\xadr\x18\x1a\xd4\xa0\xf7l\x18z;\x88\xd2\x87\x1cjA\"\xff$\xd0f\x1b\xd2\x83[\xf9_\xc1-}x\x14\xf4\xd4\xe2rR\xbd\x1ft\xd5\xd5!\xb8\xe8U\xdd\xdf\xd5\xbb!\xe9\xfc|J\x83\xb1\x85\xa0\xf3\xf3)YF\xd3\xa7\xe7\x10\xc7C-H\xf0\x84;\x93\xa0\x85\x1e\nj\xba\xe6;\xc8rGY\x18^\x8e8_O-\xec7\x0d\xa4\xa5ja\xd5w<4M\x1d\x86\xf6*\xc7\xf5\xd4A0m\x9a*\x0cq\xcc\xac\x1d\xfa\x03\xa9\xf2\xf4)DR\xb8\xee\xb5\xfd\x0e\xa8v@R\xc2\xe5\xc9/\xd3\x83\x9a\xc9\x84m\x8c8\x0c\xbe]\xfa\xc9*\xf5\xd6\x94.\x920\x1c\xd9Q)\xc0\x95\"\xa9\xd2\xf2\xb6p\xbb\xc2~\xb0\xa5\xae\x98\x87;\xafM\x95\xea`\"\x82\xa5m\xa2U%b\xfb\x19\xeb\x07P}\xe9IV\x9c\xec\xa9]P\xa7\xa0K\xc7\x80\n\x05\xa8\xa3\x19]\xf4\x8dP -\xf6\xd4y\x81n\xe4D\xa3\xf8\x00`o\xd1\xed0\x91b%\xaa\x0e\x926\xd9\x1dh\xb0\xbdR\xaah\xc5\xae\xc3\xcff>(\xcb91\x87\x17h\x84\xc0\xb6\x06\x94IW\xb5B\xf7\x1a\xb8\xd6G\x88\xd5\xa5\x1a\x1d\x00\xf8V^3\xc9\xe7\x0by\xa4\x0fWqU\xf6\xb0\x83v)\xe6\xf2\xd5\xda\xad\xe7[u!\x84R\x7f\xb1\xd1\x1at\xc6K3\xe2\xa6Q\x08\xac\xf3\xcd \x1ft\x1b;6q\xfd3x\xc7PN:\x03\xcbA(Y\xe6\x92d\xb0s\x00w\xa9`5\xab=\x12\xe7\x8d,w;\xe8\xe3\xe8C\x0d\xed\x01D\xae\xa6c\xd9T\xe4b\xcb\x8a\xb6\x1e\xc4$\xb6\xcd\xad\n\xaf\xc2!\xe2\xa9\xad\xb9\x9eVnc\x18(,\xdb\xad\xa2\xfa\xd4f\xb0\xec\xaf\xfb\x80\xedQ\x9bf\xbf\xb9\xf8\x95\x97\xac\x9e\xe2\x0c\xe2\xa5\xfai\xcd\xc6p!\xd1\x11\x11Z\xba\xedk\xa8Q]\x11l\xce\xcdH\xa89\xce\xcdp\xf7\xd0\x03*GPRC\xf5\xb8\xcf%W\x02\xf1\xfa\x98\x12\xac\x8fiY\xeax\xf4\xf5tW\xd6\xd2._\x18v\xdf\xfd\xd5B\x0cV\xdd\x922\xf5\xc2@\xabA\xfd\xba\x07\xf5\xca\x13Vvq\x83\x8a\x1e\xaf\xaf\x19\x01\xa4\xed\xc30\xf7T7#\x12(N\xd4\xbf7a\x8eh0\xfan\x06c\xac\x9a\xc3f\xec*\xab\x179\xcf;\xbe\xe9\xf8h\x03s\xf9\xd5\xdaZ\x1d\xb9\xd44#\xacFn=)Rj\xe2\xd3\xa2\xcf\xd0\xa0)\x88*\xce\xf6\x8bb<\xa6\x8etMV\x19'p\xe3(\xe26`\xa5\xbd07A\x00\xe9\xaaC5(gO\xa2#\xad\x18\xe3\x1d\x92S\x13\x99\xc9|7\xd7\xd8\xe6\x8e\xf2\xa2\x0c5\xc1\xae\xc1^C`\xc3]\x84\xa1q\x8f}\x00\xd0Gy\xbb\x0e\xe5\xc1-i\xae7\xeb\xfeL\xfea)\xebq\xb9\x07\x05_+2\x1b{\xa3]U	\xe3\xa8ak\xd3#\xb6\x80I\xf6 \x8a\xfdVXC\xd3\xbe\xe1\xa92\x00U\xe1b\\\xfd\xad\x11\x156@V$\xb9B\xe9\x8e\xe9\x19J;D\x7f\xb6\xf8q\xcaJ\xc6}\xc3\xd7\xc7\xc6g\xd8\xd9?\x18\xd2m\x95I\xfb\xac\x99)\xa1\x14\x12pT\x1d\x8eA\xb2r\xf6\xc3\xf1RD8\x06\xccL\xc2\x9e\x9f\x19\x029z\xc8\xcb$\xc5ER\x9f\xed/\x8c\xd9.S\x1b#zP\xd4c\xa7jpA\x1aE\xe3\xb6\x00\xd3\xd0\xce )\xa9N\x04\n\xf2QU\x10\x86\xcf\x89dV\x86\x16\x86/\x05^UR\x00\xf6\x8b\xe1\xd2t\xc3_\xfc\x7f\xd3\ne\xb8N\xc6)\x17\x8e\xdbp\xf8\xe1\xbf\x1c\x8a94\xedhh\xd3\xa8\xf4D\x1d\xd4\x17bSVb_\xe8\x89\xf5\xb1\\\xb7\x07\x16Q\x0b\x83\xed\xe0<\xd8\x81!h\xda\xba_\xa6\xba\x9fJ\x06\xe7\xca\xd1\x03\xeeR\xebP*\x1e\x9f\x07\xa1\x15}\xb0:\xc3\x0eQ\x1f\x86\x83\x9f\x95e\xd0\xc1r\xe3\x9d\xaal(\xd9\xab\xac\xeeD\xaf\xd2p\xe5<\x89:\xb0\xc6\xa4\xf2N5\xcc\xe3\xc0>\xb3\xe1\x9e\x00=\x06i*46\xcdjs\xb6|\xabe\x0e\"\xe5.\x98\xa5\xfb\xd44\x0eg\x1e'\x9a\xa3K\xf8\xf3\xb6\xbc\x16\xd1\x1b\xdb\xa4Z}\x07\x06a\x88{\x88\xed\x9b\xc3\xeaK\xf7\xa5=\x01\"\xfbMW\xd4%\x15D\xf7]gQ\xd1\xe8Ej\xa8	\xd1}7\x8aI\x8c\x1d\xd3\xa1\xd9C\xa5=\xb5\xd6O\xf8\x9d\xcal+\xde\xc8d\xbb\x83[\xaah_\x9b\xe6e\"a\x98pK\x8c\x18\xa8\xdd\xfb\x08%q,Z\x81%\x8b\x0bR\x16\x99dv<\xe3\x98\xaa!\nI\x7f\x7f\x0f\xa1\xa33\xbc\xc9\xb6{5LH\xd9\xba\x04C\xd4\xf7\xe5\xe5\xc7\xa0\xb1x\x0c\x0e\xae\x05\x03\xa5d\x92]+\x00\xb3>Yr`=\xaa\xe4\xbfi\xf8x\xe0\xefk\xfa\x88\xfc\xd1m\x0f\xcd\xd2\x7f\xd3\x89\xf7\xcc\xf2\x1f\xf5f\xa8\xa8\x01\x91\x81\xde\xba\xf8$\x0fI.\xff!\xeeq\xee\\\xa8cA\x05;[#X]n\x0f\xa1\xf5\x15<\xfb\xd2\xb7\xe5\x1eQ\x97U\xba\xacrS*\x152\xc9r<\xa9\x9d\xfb\xedUR\xabB[!\x13\x93e\x97\\\x8a\x9f\xed\xc3\xbf\xf0\xa0L@M\xeaM&n\xf1\x1b\xac\xaf\x92*\xc0\xd3\xbaLU\x15\xf8\xf0\xc2<_\xeb\xcc\xd7\xe2\xde~1Wv\xb9'\xdd\xb1<\x13\x85\xfc\xb9}T\xcd\x95\x9bM-\xf4W\xfd\xa8\xbe\x1a\xed\xc5\xeb\xd4{\x01i\x87\xd7z]	Q\xfc\xdc>\xaa\x12\x1awx\xf3 K\xa3@R\xa9\xed\xf7\xdb\xabl\x88}s4\xa7=\x85l(^\x95?\x0c\xdf\x9aCI\xa3F\xba4N\xc2S;\x11\xcb\xf61\x12S7\x17\xae\xbc;hd\x18\xbe\xe8\xd55\x0f%\xe2\x1f\x87r\xf9a\xf4Q(\x97\xcf\xa2Yd\njh\xb0Bw\xc0O\x1b\xa5K\xa9H\"\xad)\xc9\x8a\x80]\xe4\xfb\xca\xbc\x96{\x19x\x81\x8e\x80\xfe\x9d\xf0\xde\n\xe5D\xcc\xfb\xc4\x85S\xdc\xbc4V!\x82\xfd\xf31\x12\xe18\xaf>\xe8-\xf5\xaf\x96K\xfaQ\xc5\x94b\n\x98\xa3\x8a\x02\xf5\\\xde\x88*`\xea1\x17\xc9\x8d\xb0\x9f\xf72\xb0\x10`\xb2\x9b7]\xc0\xbc\x98\"6\xa9?\xe2lx\xc4\x9d\xeee\xcc\n\x1f\xa3\xcc\x90\xc9\xc7\x90\xc1\x8a\xfe9\xa1L\x04\x1c\xf3\xe7\x00FI\x9a\xb9!\xcc<+Fc;\x0f\x9eQC\x0f\xaf\x1c\x03\xc8$\xaf,\xd37\xac\xa8a\xa6L\x06/\xae#sb\x1f\x87\x19\xd5\x98[\x98\xe7n\x11\xb5\xb5\xde\x81\x95\x85\xf8\xd3\xd9\xd9\x1c\x056\x9bh0z\xb2v\xa8\x11\xc7\xa8>\x0c\xbd\xa9\xb1\xa4Hg\xbe\xd85\x11\x8eG4\x07\xafRVU-\x7f\xbdlgh\x1cL\x83\xb1\x97\x14y\x93W9\xa6\x8c\xb9Y\xd4xuP%hn\xe5\xc9 \x1c\x12\x14\xcb\xa2\xdaE('\\\n\xe4\xb4}^dL%\xf3\x80\x17\x99\x9d\x05\xcf,[\xdd\x03\x02\xb3l\xa3\xd2\xd3\xea;\x0d\x06oLx\xc1GUh\x1d\xe7\x02V\xc0q\xc5Y6\xfd\n\xd3\x0e\xb2\x1cA[\xdc\\T\xcd\xba\xcc\x1b\xb1\xbd\x10isU5\xd9\xf6\xb2Q\xd4|\x93g\xc5u\x03\\\xde\xec\x92*\xd9R\xf2>\x8b'\x15\xf1\x95\x9e\x9f~zz\x99\xb1\xdf\xd0\x80\xd6\xfe7g\xca\x84\xac9Cm\xa7\x19\xfbJ\xf0S\xa3\xc0>\xaf\x9f\xc2P\xf8\x1d\x8f\x1b~^?\xb5z\xed)\xac\xa7\xfe.\xf8\xe9\xbb\xf3\xfa\xe9\xd9\x08j\xe3\xd5\x8b\x97\xcf\xdf>?_5\x93	m\xf0!>\x8f\xf1\xfc\xe9y\xfd\xf4\x89\xef\x96\xf5MW?\x0c\xfb\x9c@1T`M\x9e\x93\xe38\x82\xd23\xa8`\x81\xac\x90\x0f\xee\xacS\x1b\x83\x8e\x04\xca\x9a&\xa0\xab\x19.Ok\xd5\x90\xdf\nO\x1fj\xf6\x10\xd1X\xfb\xb1\x0b\x19\xc7\xc1i06\xb4\xafW\xd3\xf7^M\xca\x99\xeb\x14\xe2\n\xb3\x97\x8d@\xd9\xdc\x19\xf41b\xbex$\xb4I\xf8\x98F\x96\xf6\xf7l\x0f\x90/\xa0~K_\x8b\x8e?\xa1\x15\xac@\xdb\x05\x80\x9ew\xee\x9aPl@W\x9eNJ\xcfe\x04\xc2d\xa3\xfe\x83\xba\"\xe7\xa5\xd5U)\xa9\x8a\xda\x05Fz\x9a\x98\xcd\xc3\x12\x93\x05\x12\x91\xdcD\x05\x85E\x14\x14\xa7}\xa3(\x0b\xc9J\x19\xcc2\x86<\x10\x94,|?2\x15i\xacu\x10\xd3v\x95V\x8fs`5e\xc6\xbf\x082.\xeff\xba\xd7\x82\x18\xc9\x12}\xa8\xf8\xa5A\x8d\xab\xd8\x86_\x03.r\x1a\x06hYZ?\x96\x1d\xdfL\xe6,\xe5J\x9by\xc5\xb7$U\xfa\x85\xab\xa6\x99\x9fm\x06\xfcA\xc1\x9f\xf8\x86\x1ea\xf8\x959\x9f\xd3\x96\x97\xea\xedc\xab\x99\xe6\xc5T\xfc\x06\x9fY\x88\xb5\xd1 \xb7\n\x19\x83I\x95\x85\x11\x81\x0b\xeek\xb0\xc4jI\xe9Auh\xa3\xbc\x03\x88\xe0\xb7\x02\xf7/\xe3V\x8c\xae\x11\xebh\xce\n8\xbe\xf8[\x00  <\xab#3h0\xa9\\R\x04\xef*\x8dh\xab\xc6M%\xf0\x19\xda\x11-\xccv\xd6>\xdf\nw\xab\x89\xb3,\xdds\xc1\xd6#\xceq\x07\xb92}\x85E\n\xd9\xc3S\x1d\x1e\xe85x!\x1dQ1a7Hp\xd5A\x9fdb\xa3\xaf\xd61\xdb\xb3\xb5\x1a]\xad\xa0'\xe7\xc9\xca\xba\xbbM\xe6\xfd\x01\xea\xde%\xec{A\x19\xcc\x8e\xd6g\xb5\xb28\xde\xc3\xf0(f\xd6\xech\xef\x9b\x1d\x8d\x1c\x80\xef;\xb6x\xc0\x0b\xed\x91\x9b3\x88\xf2\xc9~ZW\xeb0\x0c\xb4\x8d'\xc4\x81~e]\xa7\xce\xe5\xf5\xf4\x17q\x93\xe4?T9n\xda\x9f\x16\xe5\xd7\xaaT\x18\xb6	\xbaB\xf6P |V\x84<\x08\xa6\xd1\xec{\xe8D%\x07\xb8w\xfb\x82\xec}\x9b.\xe7\xba\xfew\xdcrN\x01\xbd\xadl\xd63K\xfc\xc9\xd8\xb9\xb4\xf6{PZ\xcb\xa5\x17B\x12\x91\x96Y\x89\x8bY\x15JSwE\x961]\x940\x13n\x9a\xf9\xc8\x0f\x1f\x0f\x15\n\xfcg\n\xa5y\xbb\x8155\xb4\xfa-#\x8d3K\xf9\"T\xfa:\x14d\xf1\x96\xb8\x93\xd7 2\x05\x8f\xa8\xc8\x9e\x9a\xc2\xf72\xf5\xed\xea<\n\xc2C\xc8v*~\x15,8{2\xff\xf4\xec\xf4\xc9\xb3O\xa1ZU\xa0\xf7\x18\xd5\xd1\"D\xe5\x04\xd01\x93b\x1b\x98\xbbim\xe2\x88\xf4\xed\xaa\xf4\x9c\x08'O0Qk\xdb\x0fM\xd3^8\x81\x80\xb8\n\x80\x95\xe5\xdc\x1a~r\xb0\xca'%^\xbd\xedc\xe3'\xd7\xbc\xc4=\x8d\x00\xdc\xcaZ\xa1Z\xa1\x1b\xce\x8a\x9c\xef\x9d=z\x17\xech\x18Z\xd1]m\xd8\x81\xbd=iayb.\xa96uA\x86m\xae\x1a\x06q\xa0\xdeay`e.Z&Uw^\xd5|H\x88\xc9\x0b5\xa4\x12\xf7\x0c\xaaa0ez\xe9\x0f\xb0\xec\x0f\xeckA\xd4\xc8\x12\xb8\x97j\x9b\x18uH\xad\xdd\xb2\xcf\xce\xcc$y\xf0bjQ\xa0\x94\xb0\xd1&\x0c\xbb(H\xdd\x06\xe5\x00\xb2\x03\x1f\x16\xe0\x8d>\xad\xaf\xf1R \xefx)\xf8];\xa8\xcf6\xe4\x0bxC\xe2X\x94\xbcX}\xe7\x84/\xfa\x93=\xfd\xda\xa8\xeb\xf6\x0b|g\x97=\xc2\xac\xc0\x0d\x8f]\xc5#\x0e$iNJ\xba\xf0\x1b0\x14\xe0\xa1X}\xe9\x1a\xc5\x96Z}\xd9\xcfB\x8fIxp\xe9=\x8e\xd4L\xedO\x8e>\xf4\x9cX\xdf\x9b\x91\x0e\\dm*\x1b\xf0\xdb5)\xad\xe8n\xa9\xef`Q9\xad\x95>\xbc\xcd\x08=\xa2c\xe7F'\xd3\xdfO\xbdo\x7f\xebgC4Hd\xf1-];~\xbc}\xad%\x02^+\xb3\xccv\xe0\xae\xe7\xaf\xcd\x04\xb92\x1e\xcb\xfb\x7f\xd2\xc5o\xec\xc4w\xadVu?w\x95\xf8\x9f\xbac	8\x7f\x82\x9af>\xf0\xedo\xfdO\x96\xdah\xfb\xb5\xc0\xf5\xff\xb5\xa8\xe4gJ\xce\x0d,\xe9\x91	\xe0$\xac\x08\xfc\xbf\x9e6\xb4\xd09\x1az\x1f\x8e\x1a\x86\xb2\x0b*\x90d\xd3\xb98\xe3ON\xcb\xff\xd2\\\xe7\xae\x1c4}\x14F\xd5\xa2\x110\xd8\xee\x8c\xd4\xc2M\xdc\xee\xa3T\xe1\xfdKvH\xef\x90\x14\xb0\xcdS\xfc\xb7\x07\xabA\xe00\x1fz2t\\\xb9\x81\x0bs\x811\xd8d\x13\xb1\x07AODd\x84\xd1\x08\xf4q\xbc:\x96\xf8\xb2$\xa4\x1a \x0e\xd4\xa1s\xf41o|\x1b\xd6\x00\xfae\xe86\xbb\xf7\xb6tB\x1b`[t\x19\x0b\xd3\x11\xd9\x9a//\xb2\xcd\xb1I+d\xbf\xbf\x99\xb3\x0b\x1a\xa7\xd1\xa5X9\xfb|\xf1>\xfb\xfc\x98>\xc0\xe3\xb8C%\xe0\xe0\x86\x810V\xce9^\xa0c\xc4\x0c\xa60\xca\xf2\xc7\x17\x0c$3\xa5\xcc\xeb7G\xa5|\xe6\x9b\x17\xcb0\xec 6\x8d\\\x88x/\x062\xc4J7\xca\xadQ\x80\xe3\x06\xa7?\x85\x00Z\xbd\x9d\xb7\xbd\x16\xad\x1d\xbf\x91\x13\x9d\xcd\x06F\x86=\xa6B\x1e;\x1a\xd2\xa0$s+\xce\x01F\xb8\x9e\xacM\x8f\xeam\x19\x05\xfa)\xb0h\x0b\x9f\xccc\xc0\xfc\x9d\x1c\x05Zef\xbf>W\xbb9P\x9b:\xb0\x13\x80\xd0\xf6\x81y\xc6dtEn	\xc4\x1b\x9b\x02\x92E\x1fT\xbd#\x1d\xea\xf6\nWh \xbc\x9f5S\x9d\xcc\xd5\xa9^\x9e\xf1L\x91\xaf\x92\x97\x08/\xe0\xb9\xac+2O\x91x\xd7\xa4\xc2\x81\xbfJbD\xc3\xdb\x1b\xbe\xb0`\x12$8i\x89\xd1G\xe3\xa3?\xe9;8[\xa7\xe6\xe5hwG!@\xf9k<\xb6\x8e\xcd\x9f\x0b>t\x07B\x87\x8bq\xb4V&n]\xebX\xa7r'\nQ\xe1\xbc\xe3/\x00\x94\x97B\xbe(\xb7\xbb\xbd\x14\xe9\x1b\x88\\\x14\xc8}\xd6\xe9N%\x8c\x85X\x13P\xe5Z\xddJN~\x11\x83\x94\xb0\xf5\xf45-\x13\xe5X\xfa9\xea\x06\xff\x13\x80@$	,\xff\x84\xb4\x81\xa3\x14\x8d\xa8\x82o\x17@\x85M\xa3\x88f\x15l\xcc\xf3S\x855\xfa\xfdt\x97\xdd\x89\xfc\xb3\xf2Nu\xb8&4\x0c\x9f\x98\x0d\x9f\xd00\xfc\xcc<#@\xb7\xb2\x93\xbe\xcdR\x15H\xa2\x9en\xb3\xe2'\xf5R\xe2%\xb9\xd3/\xedw\xef\xab-\xc7\x13\x86\x9e\xde\x9a\x9c\xea\x97W~\x99\x8cy\xa5\x10\x11\xc2\x11\x82\xc92\x19\x07A\x94\xb4,\xd4\xef\x1dI\xd3C'\x18\x95:\x8bG\xed\xed|\xc4\x06\x81\xe2\xd2\x1a\xdet\xcfq\xba0\x82\x12\x9b\xf1p8\x8clS\x08\xf4n\x1eO\xe0\x15\x01+\x17\x18.\xa9u\x81\xcb1||x\xb03w9F\xc9E]\xe6{)\x16\xb9\xd8\xc8h2\xc7\x7f\xbb\xbb\x85\x1ao\xf4\xc9lw\xb7\xd8&\xd5eVLd\xb9\x8b\x90\xb2KR\xc4\xca\x8bf\x8b\x8b\xb2JE\x15\xcd\x02\xb6\x7f\xb4z{e\xe7\xc2\xb8\xadF\xca\x11uqQ\xdeM\xea\xecw\xd4\xa3k\x99\\\x94w\x0b\x08\xd47yy\x1b\xd5*\xc2\x9ei9J\xf6\xb2\xb4\x8d\xf9=\xf0\xfb\xf9\xd7\x85\xea\xdf_\x03\x96u\x1dg\xea.\xd1\xb47\x02\x1b\xfe\xe2x\x07\xec\xe9\xa2\xe0\xc1\xfc\xaf\xc6P\xa7\xdc\xb1\x84\xcf\x9f\xe10R\xc1\xf9\xd1\x17\xc4\x0f\xa0n\xb8\x15\xc2\x0b\xf0\xe0\x93\xd9_\x03V\xf2\x0f?1Y\xd5g0i\xee\x8b\x9a\xcd\xb6\x9c\x9d|\x1e\xd8\xd9\x0fXf\x9b\xdaC\n\\\x0b\xa9\x80\xec\xf4C\xca\xb2.\x8f[Cj\x85\xb3\xc1\x93OI\x8fL\xd77\x0e\x97\xfb\"%*B\xd3\xe7y\x99 \x9d\x1ezr<>\xec\xf6\xc4\xf6\x8f$,L\xf7!\x931\x13\x80\x80$\xf0\x14,\xd2\x17y\xb6\xe3\x81\xb9\xf3\x02\x8b	\xa0\xe8\xba\x0d\x0d\x17\x81\xcb\x11x\xafJy\x1d\xa9\x85\xe8\xd6\x03\x1f\xe6\xc1\xb2m\xf4\x9b{\xf6pQ\xde\xbdQ\xf0\xf4\xbd\xc83\x88p}\xf2\xcb\xe0A\xc4\xca\xaa\x0e\xac\x8bE\x1e\xc9W\x9a|\xf6\xce\xd3G\xb2\x158\x8cu\x83_;\x00y$or\x80\x86\xb0\xccs\xdd\xd7\xc7z\x98\xc1_\xe1@4\x9c\xfe \xf8*\xf8I\\\\g2`\xc1\xd7\xe5\xef\x01\xc2\x0e\x061\xfby\xc03M\xad\x92\x9e*\xf6c\xd7\xdf\xfe\x0b\xd1\x9e\x18\xca\x93\x1c\xe8\xb7^\xc1\xe2\xefG\xb1\x12\x8ed\x80\x16A@\x14\xfb\xb3X\x8aH%\x0d\x9d9\x90\x0ev\xa2%\x8e\xadly\x8eh\x02?\x88\xe3hp\xf0\xd6\x14\xfc\x07x\xad\x8e%\xa2\xc8\xfd\xecb\x12\x89\x83\xa2\xcf\x84\x86\xd0\x7fA\x96O\xe0\x88\xde(Y<Y\x8e&\xeb\x95Hb:\x1d\xd3S\xf6\x1d\x92'\x93S\xf6\xa5\xe0\x0fv#E\xdeF\xba\xc9\xea\xec\"\xcb3y\x1f\x05WY\x9a\x8a\"`\x16\xf9\x187\xf8\x03\xfb\x87\xe0\x0f\xb9\x90RTov\xc9\x1ah\x08\xd7|o\xcaB\xfe$\xb0{\xa3\xe0\xa3\xd9,\xf0\x1c\x8e\xff\xdd=\xf0\xb8\x8d\xe2\xd0\x86\xbe\xad\x96j\xe7m\x93;2c\xb8\xeflB\x8a\xa6\x99Q:&\x95\x8a\x1f\x818\x104\x92\xed\xae\xb5q\xd2\xacd\x195'<P\xc8\x02`\x0f5\xec\x8c\xe90\x9f3\xd0\xbc\xd0\xe7\x91j\x19h\\\x18Dv\x9f\x04N2<[\x80\xbcY$g\x1f-\x921\x7fF\x03\x8d\xb6l$\x84\xfd\xd8E\xf4(\xc6\x95X%*\xf8g\x06\x91\xda\x92\xb8\xdal\xe6\x89\xcb\x1c\x18\xa4\x1ft\x0b\xd9\xdaG\xc7\x05L\x17u~\xc4iV\xb7\xf3\xea\xc6T\x8c\xf3\xf7\xd7\xdd~E\xdd\xcb\xfd\xf8O\xd5\x1c\xd5\x7f.\x9f]3\x84p\x9a\x9d)\xe7\xb5\xfd\x98{\xcb\xa7\x1e\xd7\"\xcb\x89X\x05\x1a\x1d\x07cy\x0c\xf5\xd6\x84qN\xe3I9\xd9O\xea	\x82n`\xd5\xd9\xde\xc3\xce\xb2\xa3\xac\xe4\x8a \x82\xfcnt?=\xc2[\x08\xc8\\ \x04K{.v\x02\xb1\xb8\x02\x01$\xf2\x15\x82\x04e,\xe1\x86 \xab(\xab\xf9\x9f\xeb1\x00\xaa%\x9fz7%'\x8b\x84\x078w\x03#\x0f~\xa4\xafa\x985\x8d\xce\x08\x03\xd5\xa6\x19yg\x0e\x88\xb2 +\xf2LG\xa2p+cv\xa3\x19\x80\n\x90\x8f\xb3X\x19d|/\xd6\xb2vw\x96\xa9\x98^\x7fz&\x10\xfa\x04\xb8\x0b\xdc\x1fI8\\\xae\xa9r|\xea\xf4	\xab3\xb6[\xafiH6\xb4\x9f\x18\x82\xd8%t\x8c=\xdb.daJa\xc3:\x9c\x0d\xc36\xdf\x12u\x9a\x15\x99\x9f\xcf\x93C\xaf\xebZ\x85\xfd\x8a\x1eJ`\x1dy\x1fu)AkMg\x03\x1b\xe8U\x0dLf\xc7\xea\xabK\xa3\x8be0\x0f\xa2B\xd9\xf9\xb9\xb04\xd1CRd[e\xd1\xf3Z\x8aJ=(\xbbg\x98\x9a\xac\xcb|\xbfm_7Y\x9e\x7fc\xba1\x9a\xb1M.\xee\xbe\xa8\xca[\xfb\xfc\xe6\xaa\xca\x8ak\xf5\xd6b\xc5\xd1\x8c]VY\xfa\xbc\x12\x89}~\xa1j\xed\xbe\xbd*\xd2\xee\x877\x88\nc?}\xaf\x1bAE\xdf\x97\xb7^\xde\xef\xcb[\x97\x11@\xf3w\xd7\xa8\x9d.<\x02\x16\xf4\xc3\xee*\xd1\xb6<\xb7YZ\xde\xaa\xa7\xdf_\xc3\xcaY=\x95\xe5V\x1b\xae\x9a\xc3.\x82\x82\x0fG~{\xee\x9aERs.\xc2\xf0\xc3\xce=\x8ca\xf8\xff\xf6\xde\x0ds\xe3\xc5\x19b5\xffQq~\xfc;\xb3\x93$\x1c(,\x17\x04\xda[q\\_\x08\x15\xc5\x05,\x8d\x85\x01\x15\x1a\xc8{\xad\xbdp\x11\xed6\x0c\xc3\xe0R\xc8 +N\x12\x1b\xcc\x10<T\x06GY\x05\xc3\n\x07\xd0e\x16\xe5p\x9eh\x85\"P\x0e\x18\xc1\x08\xb0	\xc9\xdc\x89U`\xc7e\xea\xf2RRp\x13\x06(\x03\x1e	\n\x15\xdb(\xa0\xca(|\x04\x9c^h\xccnSF\x1c\xd2z\x8c\xa9\x18#^hv\x1c\x18\xa9n\x03#\xd1c\xd2\xaei\x14\x13\x88\x83\x11r\xce6\xa2dKI\xc2r\x96`4<\xc8\x8a+Qe\xf0\x86\xc7D\xd4\xbd\x89\xc0aX\xf0\xc4\xb8\xf4\x16\x08;\x00\xe5\xc32\x9f\xd6-{I\x80w\xd5\xe4\xf0\x02\xae\xa0\xd8+\x03\x00\xd0_Q\xb3\xd3N\xdae\xf5\xd7\x91\xbc\x7f!ig\xd5\xfc\xc5B\xe8m\xcb'\xba\x98\x85\x0eu\xab\xa8=\xd56\xc9M<C\xdc\x06{\xf2\x0f\xd0\xdb\x19\xff\x87\x00w\xac\x03\x12\x15MS,I\xe9\xa3\xb5\x0c\xd6N:)\xab?\x07\x06B\xa4\x85e\xd94\xb3(\xa3Q\xe6	cV\x81\xbe1?`\x86\xd2\xe8\xdc5\xb6\xa7\x0f\x1eP\xeec~\x84\xa1\xcc\xad\xfa\xd6L~\xf4/3EG\xe8\x9d\xda8f\xc3\x98]%}\x86\x15\xcf\x8a\xcb6\x0b\xa1\x9a[Z\xaaSs\x8f\xd5\xdbc\x82\xbe\xf4.\xf1r\xb8\xd7\xe59\xd0\xbee}G:a\x8e\xdc\x92\x8f\xee\xa7\x1d\x1a\x1c'XK;b\xda\x1ds\x86XN\xe5\x7fs\x16g8{\x8b\xa50}b	\xcbh\xe4\xa2\xde&*Z$\xa9'|\x90\xb8\xd8\x1f\x13\x17{\x8f\xb8\xf0\x97z\xb5\x8f\xe9\xc4\xb4b\x8f.\xe0\x81\x8c*\xea\x83\xd5P\xfe{\x04*\x06\xa0C\x90\xf9\x84(t\xf4&\xba\xda>VvCv\x01\xf7\x94\xb2\x7f\xc3|D\xb2\xda*\x88,Dxl0\xff]\x90\xfb\xe91\xf7\xc3\x06\x8e3CCx\xa3P@o\xe8G0\xd5-\xb4\x0c\x82\x04\x84\x13\x13\x05\x07\x0fm\x99hv\x18\x80\x89\xf7Wr\xa0\xe6Po\x85\x93F\xca\x10\x04\xcc\xd0\x9cx4\xe2\x06C\xb8z\xed(J\xdd\xdb\xee\xd9\xb8\x8c\xf9\x83\xd6\xab\x0d\xab\x0e\xf9L\xdf\xf37p\x0d \xec4\x8d\xf7\xe7	B\x06\x89x!\xcf>R\xea\x80b\x95\x81t\x951\xeaG\xbc\xb9\xa6\xa9Vr\x02\x9f\xd1\xca\xbf\xbb\xfdp\xf0\xc9\xf0\xcc\xc6\x9fk;\x07l\xc8\xff-h?\x10q\x1f\x11>.\xc4\xefl%V\xc2\x1a'\xd1\x0cI?\\\xb8\xd6t\xb4\x14\xaeu\x02Z$g\xd9\"\x19\x8fi\xb9\x92\xab$\x8e\x1d\xac\xe1\x0d\xb0[9\x8c[\x1e:z@E\xfe\xfb\x01\xeb\xd4\xfd\x87\xa60T-L\x0e\xc4\xda\x85\xc6\x89\xc1\xd3\xed\xed\xad\x10\x05/$}\xcc\xca\xbd\x90\x0c\xd8\xb2\x878,G\xa6\x04l\xf0v\xb2\xfe\x08\xbb\xaa\xdc\xf1\xc2\xda\xd2\xd5Yq\xc93`\x7f\xfd\xdc\x06\xf7Q\x19\xca\x1d \xa6\xe6\xe6\xb5\x06mc5i\xb7&\xca=\x82\x11\x9a\xea\x8a\x94W\xfaq_d\x92\x97\xbd\x83\xb5h\x0f\xd6\x03[\xef;\xaa-\xac\x8d\xe0\x9a\n\xdd\xe9\xa57W\xeb\x96;\x07*\xc2\xe0\\\x1d\xfa\xcahU\xfd2\xae\xfbm\xfa\x81U\xfb\":\x92\x00 b\xe0\xfb\x1b\xf3'`\x9a\xee5)j\xee\xff-q\x9b\x9f\x9d3\x13\x9c]=\xc7\xf6\x16\x81~\xc1\xa7\x82\xcd\xd8|8\xcdh\x86u\xad\xa6<\xe6\x97\xd8Y\x9d\xb4\xb3O\x9f\xcaq\xfb\xd6\xad\xaf\x96b\x17\x86\x9d\xe6\xf1\xa95\xa0R\x80\xe0\xeaW\x0f*\\r\x81\x1d\xb6,\xa6\xf5\x1fL\xaa\x0d}a\xaeM9\xc0%/\xf3\x18\x07\xde\xf1g#\xfe\x04\xf3\x07\xbb4}\x8e\xc1\x88d\xec\x1aC\xe7-TG\x9dbJ\x879\x1c\x99\xcf+D\xeb(w\xb1\x0d\x1bc2\xeb\x83\xc0\xa4-\xbbY\x11\xfa\xdf\xecW\xf5]\x05\xfc(w\x0c\x11\x12\xc3P3\x7f \xe6\x96\n!\xf7\xfd\xdb\xae\xa7\x9b;5\x91\xae\xf6\xa3/\xb8\xa7}\xb0\xe3#\x0f\xcf\xbe\xaf\xdf\x90n\xa9d\xda\xef\xbb\xd2\xa8\xdeF\x0e\x81t\x06\xa0\xd2\xc6Bm7u\xd0\x19\xda\xe0-\xf6\xb87\xfb\xe63\x8e\x1ce\xa3\xde\x99\xfe\xdelc\x8f\xa1\x8d\x8e\xca\x9a\x0cu\xca\xbaV\x00\xf0\xf9\x03\xf8\x9d\xc4\xdb\xd3\x9em\xd2\x81\xd5\xb7\x90P\x1d\xa7M?\x9ehb\xa2\xac\x89x\xaa\x1e\xbf}MO\x9fy\xfem\x81*\x1b\xc0n\x7fs\xd7\x810\x05}\xcc-\x06\xc4\x85\xd8\xdb\x95d\x99d\xa5d\x89d\xb5\x84(n\x19\xe98\x8bM}U\xde6\x88{H\x9f\x9c\xb2\xbd\xe4\xa7m\xa8\xeb'^\x8c&8\xd8<d\xf0TS\x16\xc8\xaf\x10*1\x15\x05n^\xaa\x10H\xb9\x96\xcf-\x93\xfay\x95l\xc5\xf2\x91\xef$\x974\xea\\\xb2\x93\x9b\xfe*[\xfc\x9b$W'\xda\xddTfk\xdc\xf2\xd6\xf2\xe98\xed\xed\xf4u*\xb09@-XC\xe9\x03e\x95\xe4\xad\xafT[\xcdFv\xec_\x95\xcd\xd5\x83&\x9e#\xa1=\xdc\xa5\x16\xd2-\xaa\xb3\x8f\x16\xd5\x98?\x9b\xe0f\x12{\x1e\x8fI\xc1+\x01S\xa5\x98g+'\xd1\x1a\x171w\x8e\x92\x98\xa6lj\xf8[\x9e\x19=\x13\xc4Cm?vF\x9c\xd0\xd2\x14\xb0\xbf#\xa9\x9cJ\x1co\xa2\xd2\xcc\xe4*\xa6\xd3uY\xac\x13\xd9I\n\x9e\x061\xb8;\x04'\xcd\xecy\\\x9e%\xd6JI\x9b\xea\x19\x9bI&q\x15\xbe\xe9[\xd5v!E\x14\x7fo2\x123\x1d)\xb6\x891\xac\xb3\x87\xaf\n8`\xaf\xd3\"t\xaao\xe5\xf4\xe7\xde\xa8\xaf\xf6jW\x1c\xa0q\xf0\x12\xb3\x0dIL\x0fF\xf3\x85\x1d\xb1\xe0\x95l\x1a,,\x93\xbe\xa4.\xd7\xc7*\xee\xf6\x1b\xe7\xee\xa0\x99\x08\x88\xa1\xe7\x13\"O\xdb\x8f\x10\xfc\x98n\xe7zz\xea\xbe\xe1\x9c\xfd\xbe\xaa\xe2i\xb5/H\xe1\xc8\x92\xda\xbb\xf6\x0f!\xb6s\x18\xc9\xc7\x94\x15g\xf30\xcc\x962\"Y\xd3\x1cg\x9a\xb3Yl|\xd7\xfd\x00\xddy\x0c\xf3|z`\xb8\xc6\xd8\x86\xc9\xd6n\xd5%\x82\x84\xef\xea\xce%\xcf\x82\xb2r'\xbdo\xa3\x19{0\x8e3\xaf\x14\x02\x81pC\xa3\x92\xc8\x1e\xac\xee4?0I\x99u\"3|q&\xeaH\xb8\x8f\xdf\xe8\xf3.\xc2\x8e7\x93\x19\xb9\xe9\xb6\xd3\x17I7\x93L\xcf\x1eB\\\x9a\xb8\x81x\xf7\x10\x94\x03\x14nH0R\xb2\x1c\xa7\xba2!1\x8f\xd6\x88N\x8f@\xe9\"L\x82\x1e\x89\x9b{\xb7Z\xe6\x86\x01h]\xe0\xa8\xd6\xc1\x88-\xa9-\x96\xae\x80^\xdeh\xb6\xf0\x80J\x91&\n\xae\x12\\\x7f-\xcf\nEo\xdb2+\xa9\x97~\xee\x9a\x17K\xf2\xe7\x17\x96\x89\x98\xd2\xa86\xf7\xe2\xb5\xf9\x85\xbd;\xf9\x00\xe3\xe2\x1c\x8b\xbe\xd3\xbd\x18\x0dL\x9bU\xd2\xa9\x0c\x05\xc4\x00\xeaN\x98\x0c\x97\x1a\xf1\x1fIAc\x86\xc8\x97E\xcc\xba\x14x	\xb6.\xe3\xb8\x94\xd2d\xe0\xb8\x97\x91\xb2\xc2\xdc\xe6\x05l\xc4K\x1b\xa6\x04\x19\xfa\xd2\x8cJ\x89/4?\xa3$\x18\xd4\xf5\x01\xd1\x8au\x02\xe25\xba:\xaa\x98\x95Tw\x12\xda#\xddh\x113\x89\xa7\xcc\x98iJ4\x9c\x1d\xc8zp\xf1\xa93]UZ\x8e\x0eV\xc1^T\xc8)g%\xb3\xc5\x1d\x8e\xda\x92B9-b\xe0\xdd+\x02r\x85]L\xfe\xa9J\x80r\x0cg\xbb\xfaQ\x91\x9e \x0dc\xce\xc9\xeeZ\xd9@\xad\xd9N\xb2\x9c\xb2-1e\x15\x86\xa1a\xe8\xbf\xea.\x95\xc8\x97\xb7\x97\xe9\x9a\x1c\xf6\xdd\xdc\xa9k\xbe\xa6e!lw\xec]t\xe6\x96]\xf3\x15\x97\x12;|i\xbei\xec\xe9N\xba\xad\x1f!m\xcf,\xce\x80\xd87\xca\x99\x1aedJ\xaa\x17\xdc\xb2\x96\x1f\xae\xa7\xee\xc8m\x03W\xa5\x92=\xd8\x03$z\x08\x9e\x06\xd1j\x00\x14\x0d\x9f\xd2ns\xb5\xbb\xed\x8c\xe5\x82\x14\x86\xa6b\xad\xc4\x81\xa9=\x1a\x1f\x98\xa9\xbe\x87\x19T\x14\x06s\x15\x81>\x9e\"x\xa8\xd8H`\x0e\xe7\x17\x06[;m\xa2\x05\x12\xd8\xd9V1\xf3O\xb9\"\xe6\xdd\xd7\xa6Y\xf5s\xb8\xeb?\xc08\xb6\xc7V\xb4z\x94\xd5m\xad\xbb\xd9\xc6*\xe6`\xaa\xdb4V\x88\x867\xb6S\x93\xc4Rp\xc6WV\xfa\xcb.;\xc2\xe3ZI\xa0l`8\x81\x9b+@O\xc1\xa8\xc6Z\x00\x17z\xc9 ]U\xb4\xb9\x123\xfa \xadJ\x05\x94N\xf7\x85\xfa\x8a\xb0[\x89{\xe13Vc\x87\xba\xebH\x98\xff\xe2\x1f\xb1m\x99\xa6\xa9	$\x03\xed\x97\xf1\x98\xed,\x08zE\x86\xbe\xb5\x85&\x13fo\xec0}4K\xd64~\x1f\xd0\x14\x00R:d\x16\xb3\xda\x057SG\xbf\xc1**I\x99\x86\x07\x9a\xf8T\xb29\x86\xeb\xa8\xc8\xe5\x12z]\x11D\x81\x9e@UN?C\x02\xd24\xa3\x1b/\x00\xc0\x0d0\x1a4\x9bY\xb1\x17\x8bKx\xbe\xa7\xc0E7a\x88\xa4\xa6i\x85\x0b\x15E\\M\xb2\xe7\x03\xd7\x81\xd1\xa69\xfa\x9aj\xa3|\xb5v\x9b\xfe\x1d^\x90\xaeO\xadA\x0b_]\xb9g\xd6>\xfe\xec=\xff+ff\xd5s\xd57{'\x03\x10[\xdeB\x8d\xf9\n\xa3u\x17\xe2\x9d\xac\x9d<\xc5KG\xb9\xe5\x9a\xe7\x11\xd9\xe8X\xd3\xa3\x99\xa7\x9b\xb0\xd57M\xce\x86\x8a3]\x08\xfe4\xc4\xd3\xe1\xad\x9b\xc6\xbcM\xb4*\x9ds\xbe\xd6\xbc\xe4\x88\xe7\x837Gl \xef\x83C\x0c\x81\xde`\xa7\xd0\xa0\x0fEW\xb6+<?P3\x07\xb8br\xcd]\n\xcbMdz\xb4\x06)\xc8\x9aR\xd6\x96\xeb\xf6\x88\"F\xa2\x9d\xe10$\xed\x14sg\x0f0\x04\xcd^\xbe\xb6\xbcr\xdaro?\xfb)s?\xe5_~\xca\xb3\x18@\xbe\xe7\xa39K)\x06}\xb3\xb4-g\xc5	\"\xcb]\"\xfa\xa2\xb2M\xa0\xd1\x8d\xe7$\xe7\xb0\x02{0\x83\x8b0)PP\xdb\x02|tI\xd9e\x18z\x8bz<\xa7\x0e,/\x9b\xc6,$\xf3#\x89Y\xdc\xc3R\xef&\x89\x8aac\xa8\x0d\xba\xe7;I.\x97\xd8 \xd1\x8cUlG\x99\x82\xf2\x1b\x0c\x06\xbb\x07\x169I%\xd1\x11\xb2WW=\xd8/\xe6\x97\xcf(=\xc4-\x96\xed\x1d\x01r\xd9\xe5\x1c,f\x164\xea&(\x92O\x18c\xd2z'D\xda\"2_0	\xbb\xe1c\x0f\xdb\xf6\xd2l\xd0\xc74z\xb0\xe7nT4\xcd\xa8\x08C\x89\xdb\x8f\xa1/\x16-\x9d+,%\xad\xd3q= \xaen\x0fC\xe9\xdd\xb6\xbd\xb9\x83\x99\xd6\xb2r41\x9fE\xad\x96\xcch\xde\xda\xd40$\xed\x0bfR\xd5\xa0\xc6S\xfb\x95x\x9fW\xed\xe78\x1a\xce\xe2H|\xab\xb1\xab4\x12\x0eC\x15_\xca\xbc\xa9\xcb-\xd4w\xaeP3\xab\xa6e\x9e\xf2\xca\x11!\xac}\xf4O\x89-Q\x19i\x18\xaa\xdfV\x10F\x99k\xa7w7\x92i\x11b\xc3CW\xda\xbcIR\xf1\xb6\xec@A\xc7\xdd\x1b\xf5Zw\xb2ZP\x85\x8f\x9c\xe2\x9b\xcd\xec\xf5\x0b\x005\xb0\x94\x8a\x9a\x11\xc4\xe9\xd1%$\xc1\xa8\xef\xc0L\xdaQ\xc4VM\xcfd\xbc\x1fe\x0f\xcc\x117\xb0\x05\xbb\x18\xd8P$\xfcH\xb8\x9aj\xe1\x9ew\xe5\x9a\xe6\xab\xe8\x02\x9c\x9f\x1f1o\x93\x15Y}\xa5\x84c \x05\xca\x1d\xac\xdf\x1c\xe8$\x08\xee\x9a\xd5\xb0/\xc5Q\x05	I\xa9gmi$\xa1\xeb+\x92\x18\xa1\xa6\xfaNL:KL\xec\x90vh\x19\x13.\xf04?\x12\n*\\_\xee\xac\xb5\xa8~c\xb8!\xd0\xf6\xc6*$\x1c\xc4B\x7fP\xaa;\xa2p\xd8ZOr\x1b\x92.3\xf2Q\xdd\xad\xac\xbd\xd9\x8a\x0d^\xa1\x81N\x08\x8ex\x17\xc6\xf3\x00\x1a\xe3\xce\x85R\x0c\xe0\x8c\x885U\xdd\x89\xa9l\x9c\xc4\xa0\xf6\x08C\xfcU]\x0f\xc3\x84\xe0\xc5\xb0\x14@rJEZ\x1dg\xdc\x1b\xaaB\xd2\xb6\x90\xca\xef\x1cs\x17r2Y\xd0\x02u\x83~5\x8e8VX\x9a\x85\xa1JR#\xd5T\x05Q\x1f\x00]\xaa3`\x81\x04P|a\xe3RJ6\xa7t1\x12aX6Mocd\xa0|\x98^\xf9v\xf9\x12+\xa5R\xb3\x9b\x80\x9a\x83\x0b\x9c\xde\xa5\x8fO)\x93\xfeT1\xdc\xef\x9f\x98i\x0dbVy\xaf\x8al\xc4}W\xed4\x97\xb8;\xd6\xccA\xa4\xcd\xc5\xa4\x05I\x84M1\x8b\x8b\xd6Y\xa2\xd6\x16Q:\xcd\xb4\xea\xdf\x16\x13\xa8\xe3G\xb4b%\x819\xcdVB\xcf\xa9\xf1\x1b\xc2\xc5\xf2\xc2L%\x8c\x84\xc0\xa2\xae\x84?\x95\xa8%\xb3\xd3(\xd44b\xb1\x04\x9f-\xc4Y\xb9\x10p\"]	\xc8~QNw\xb6\xf3\xe2\xe1&g\x1bm\xf2\xd9K\xef\x8c\xee\xdbP\x93L\xd3\x8b\x0c\xe7\xb2\xe8j\xc0=D\xb1)p\x87\xb0\xf9\xe5\x8f\xdc7j\xe4\xe8M3t\xe9L6l\xc0\xadw\xb7\xc5b\x1bIT\x00Pf\xee\x16\xf5t\x93u\x9e\xa5\xe2ey[D\x1bih\\\xca\xd4\xc7\x1fv\xea\x93\xea\xbf\xf9\xf4\xd6\\\x87#\x89\x1d&e\xc0\xbb\xaf\x8b\xd6\xe0H\xd7qP\xdf\xbf\xd9K/A\xd5\xa4\x13LEm\x9a\xa9\xee\xe0\xe9A\xd5D\x1d\xfbWt\xaeb\xed\x8c\xb2\xea\x0cOC#_\xc5\x96\xb1]_\xf3\xbeB\x0b\xceR\x1e\x86P0\xd1\x11\xd5BxcA\x0f2\x1c\"86)%\xc6\x9b\x00:\x85\xa6i7\xe8d\xc2\xe6ta\x8b`\x93*\xddD\xb9\x83a\xb0\x93\x07{\xac\xb6\xb7\xe6\x18\xac\xfaf\x82 \n\xc3\x92+\xe1\x00\xa2:u$\xd3|\xfe\xa1\x97\xec\x8f,\x83\x89m&\xb1\xd7 (\xb7\x05\xd1\x8bn6\x853m*\x0e\xa7\x9a?\xd40\x8e\xffd6c\x9b\xa4\x96\xd1\xb3\xd9\xac\x15\xf4\x7f4\x9b\x99#7\x15 \x8a]]\x15ku	\x95\"0\xc2\xb0Cf@\xa3\xdc^\x05\xc8<\x0c/\xd8\x90H\xaf#Q\x07\x18,\xe4Q\xf7_h\x9bn\x9bK9\xbc\x1cX)\x8f\x8d\x96M\xfc<\x96\x0c\xa4\xe9p*A\xd7\x82\xff(W\xa9\xa4\x97\xa0\xfbK\x1d\xa3\x8f\x07\xf6\x12\xa6\xc0\xde\x99\xf1M\xc1\x95\xe1Q)\xa7*P\"\xbb\x87\x8c\xe4\x8d\xaa_\xa4<\x91&t\x8bH\x19yO/\xa9.\xcd\x03\x19\xb4\x8d\xe9+?\xd8\xfdT=\xfch3\xf0\xb65\xa5X\xb9\x92\xec\x12F\x16\xe2nW\xa9{}\xff\xae\"\xc4,:d\x12\xbe\xb7\xe7\x83\xef\xbd\xf7\x8b%?\x90\xe5Q\xb5\xb7	\x84\xfd\xbc[\x8d[\xff\xc13\xc5\xba\x15\xa3\x90u\xe8tX\xf3\xb1~u-\x02ZA\x07\x0en\xd8\xd1\x95\xda|\xae\x0c\xc3g\xf81\xe2:/\xb0|K\xa4w\xc2\x15,\xaf!=\xdb\x99&\"b.\x97\xe8\xf8\xc27\x0d\xc1\x81\x86\x890\xba\xee\x9e?\x9f2I\xf3B\xa9\x031[z`y%M$z\xdfc\xa7P\x11\xb4`@\xb5T\xdf:s\x82e\x88\xb2\xd6\x02-\xf3M\xf1*\x9e9\x0b4I\xe9\xb2\x8aH\xef\xea\x17\xc9\x8aq\x00\xa3:]\x89\xb1\xe7\xcb,\xd7\xac\xab\xb0\xf7\xef\xa2\x02#\x07P\x9b\x15\x17\x01`\xa0&\xd1\\\x83\xae\xe8\\;\xfcHG\xa0\x7f\xa8\x87LIG>X\x86\xa1\x01V\x9c\xcc\x08\xc5)\\(N+\xaf\x17\x1a\xc2\x0d}x\"\x06o\xb91\xd7\x04\x9b\xbc\xbc\xd0\xb1\xa0\x1e\x01\xbeVi\xa4\xa5y\xca\x1b\xcd\xc9\xfa \x08:\xba\xfe\xdcx\x0d\xf0lU!\x82\xf2q\xb0\x1a\x85O\xd8\xd5\x91:\xd6?}\x14i\x8d \x15ma\x90\xf74\xea\x8d\xa9\xd0\xd2J\x17\xe7\xee\x18r\xf4e\xa4\xa6\xcf\xa7\xe7\xb7\xe3\xd3K:\x80\x19\x13~)\x8dU\xa7[\xb6\x85\xfa\xc4\xfb=tNs\\Ne\xf9Uy\xfb\xff\xe7\xed\xeb\x9a\xdb6\xb26\xef\xf7WPX/\nm\xb6()\xc9\xc5\xbb\x90{P\x19\xc7N2\x15'\x99X3\xf1\x14\xcd\xd7\x05\x91-	\x11	p\x80\xa6>F\xe0\x7f\xdfzN\x9fn4@\xc8\xc9\xcc\xd6\xee\x85-\x02h4\xfa\xf3\xf4\xf9~\\\x1c\x85\x1bwrLF}\xd5B\xd2\xff\x16\x9d\x85\x16L\xce\xb5d\x15\xad\x14~^\x08Y\xbb\xa8\xc2;\xb6\xdb\x12mm-ek\x1d\xc8O\xcb\xc9\x8c\x81L\xf7\xc8\x05\xf3\x16\xf9\x1bp\xab3\xe3n\x82\xa0\xa2\xc4\xf5\xde\x81\xfd\xdb\xcc\xf1\x93(\xb0\x96>\x04\xe5\x07\x9b\x9a\x1dO\xba1\x8f\x96\xeb\x1c\xd0\xcbp\x07\xedj\xb8\x0ck\xe8\x1b6\xb4\xc8tz\xe8>\x15\xc7\xfd\x86\xf51\x06\xb7\xbdl\xc2\xa3\xc4\x14E~\x8f\x98\xfe\xdc\xaf\xe6\xf3\xc4\xd4q\x9f\x08\x11\xb6\xa4\xecm\xf1@\xb6-\xbd\x18\x12\xd5\x83\xf6\xfdgDu\xf2\x0c\x8d4\xaak\x00\x96\xa5\x91\x05\xdfa\xaa\xb9\xe8\x11\xc0?N\xe0\xe0\x8a\xaa\xca?H\xccP\x98\x80\x8f\xb7\xec\xfan\xf2K\xeb\xa4=\xee\xc92 z\xc8\xc7EN\xc2\xde\xfb}b2\xf2#\xfc\xbe4\x10\xb9NEz\xc7\xc2\x9e\xf6IMD\xdb>\x1e\xde\xc4j\xb9\xa9\xf5Uv\x9a\x1e\x9f\xc1o\x9eG'}\x8a\xae*\x84\xd3 \x02\xfcmU\x03\xa3\x8b\xd6gj\xd7)\x80\x01#p\xe5=\xa6\x81\x0e\x99\xc0G\xc4\xf1\x12\xcftK\x87Q\xd6n\x05\x81\x14v\xb7\xfbW\x9e#\xa1\xd1\"\x05\xcfa\xf6\xe8\x91\xba\xe1Q\xd0\xb13\xf6\xe5?\xfa\x95\x81\xac\xc43\x15\xc9\xa8\xd6\xf9\xea\xa7r\xfd\x88\xf0\xb3\xfc\xe1\x07\xda \x18&\xbd^s\x00\x15_\xfd\xccN\x0e2\xaa\xab\xfb\xf7\xdb\xbc\xc4\xfdj\xcd\xbfv\x8d~\x97\x03\x98\xf9\n\x8e\x1f\x7ff\xdfU\x17x\xf4fe\xd3Y\x87\xf2\x18\xd8n\xbf\x88o\x8afp\xd2\x93\xe1e\xe8\xd9\x98\xafV\xaf1\x7f\xddH\xf1\x11\xa4e/\x8d\x99\xf5a\x84nO<\xcf\x1f\x81\xf1g\xc8OWo\xc2\xd6?N\x89\xf0\xc0\x82\xb8\xe0LZ\x89V\x97d2`9\xc3\x1fg(?\xdf1l^\xa1\x1e\xc0\x17\xcbZa\xfb\x96\x9e\x81\x8a\xe3h\x12M7&)\x04R\xbb\x8b\xa7\xdc\x83\x0b\xc0\xa2\x9b\xe3\xf5\xba\xf3\x9b\x9fD\xd3\x8a\xcaQ\x9c~=U\xf6\xea\xbc\x00c\xd1\xa8\x8dAN$\xf6Q;\xa0\xbf\xf0\xef\x0dz\xee\x0e\xf0\xff'\x83\x17T\xfd;\xe3w\xe4\x03\x9d\xdd\x00\x86\xb3\x03\xb6\xc7\x9d\x1fpA\xfb\xff3\xe2\xf6\xf2\xf8\xec\xd5\xd8\xd0\x8bZ\xd5.\x0fB7#\xf2\xffb\x1e\xac\xbc=\x98\x87\xc21\x1a\x95c\x9d\x11\xf8\xd5\x1d\x8a\xb0\x96\xf5O\xcd\xc2\xd1\xccC\xd5\x84\x89\xe3<3V\xe5\xe8\xd7u\xc1\x8a\x89p\xae\n\x91n\x92Bd\x9f\x9f\xdd\xa0\xc1I1:\xbb`\x1a\x0d\x92\x90\x8d\xca ,\xefc\x7fbBs\xf1\xc4\xb2\xbfS8c\x82\x0b\xc1\xd3\xa2\xe1\x10\x8d\x8dP\xcen\xf2\xc6~\x15Ip{\x0d\xd7\"-\xbb\xaei\x86c\xf1\xe7\x1aN/7*8S\xdb\x16\xcb\xc8\xefe\xe4sw\x0e\x9b2\xfa\xf4\xc9\x1f\x04\x9f>E\xf0\xc7A\xa9\x1e\x1f\x19\xc7\x07\xb7\xfc2\xd5\xac\xe8-`\xbb\xeaTw\xc3z\x89\x1d\x02\x15\x01\xc4E3\x98}w\x90`\x88\xd4\xe9\xb9QXh\x1a\x0b\x8d\x07\x85W{\xcd\xab}HU\x8e\xcf^%\xbc\xce\x89\xf0X\xea\xe2W\xb3\xa7\x81G.b\xe1\xe8\xcc\xb1\x93\xf7F\x9d|\xacO\xae\xfb\xa2\xea]\x1e\xa4\x80\xf1\x8c\x0b`\xd4|\xba\x17\xaei2\xdc\xd0\x00\x1f\xdc\x80\xf8\x8d/*\xd4d\xce\x0f2\x11\xc1\xa2j%#\xa3\x8a\xac\xec\xd1\x10Ge\xeer\xa47LK\x91!7\x8e\xb7\xc8x\x8e\xd1df\x8a\x07\xfd}\x02-1\xb1K\x9b|\x9b\x189\xc2\xeb\xb1\x92\x0f\xf3\xa7\xa7Q\x04\x1b\x19\xc9gw\xf9\x9a\x99)\x97\xad\x9e,\xca\xfd\xdb\x8e+\x19f\x9d\xe9\x18\xae:\x90(\xebn\xdd\x19\x19\x914\x86\x95A\x1dd\x81\xcb`\x91\x88\xd4d\x836\x8c5\xe0s_\xbf>\xfc:\xf6\x96\x032\xe0o\x8f\xb1\xdeH^\x84\x8f\xec\xc0\x98{\xdawo@\x91Y~\xb5\x83\xe5P\xdf\xe8\x8cv\x07\xb4kq\xea\xa0\xac\xff\x18+\xc8\xedq\xab\n\x9f9R&3\xe9\xc6$\x9c*LS\x8c\x97\xe5i\x9e\xa9\x953\xb99\x04\xe4\x06\x8eR\x03v)W\xacz:fc6gamTNz\x028\xbe\xedT\x9eU\xd3\xb3\xd4\xeb\xbeI;\xa9\xaaW\xa7\xd9.\xcd\xb3\n\x91?\xafv\xce\xa7)\xb1\xd2\xac\xc7m\xd1\xab\xb6\xad\xc1\xb6#QQ\xe9q`\xe03[\x06\xbc\x9a\x7f\xd0\xb6G_'\xe1\x13D\x85Z0\xe7H\xb8$s\xb7I\xc9;@:\x0f\xca\x899g}\xa5\xf1GL3d#\xdd\xb9r80\xd8\x11\xb7\xdam\x92\xd0\x81\xd4\xd2\x9c\xfc\xf8X$\xd0_\xcc\xf3\xa0k\xaa\x87\x07\xdc-F\x1eqZ^\x08\\\x86\x85,)\x81\xe0\xe6\xa7\x14\x0ed\xbe\x1e\x9a\x0cu|\x86l\x0c\xfb\x9e\x16\x9fUn\x9d\x9eo\xc01\xba/Rp\xc1\xe2PK@\x036\xa4\x01n\xc8\xb4Ks8\xe8\x07\xf2\xfc\x12}\xc19\xb6\xef4\x8b$\x08\xf4\xbf\x88.\xaap=s\xd5\xacd\x1aJ\xc3\xbc\xb0\xb3\xa8*\xa3\x94\xb5*{\xb8\xe5<\xce8\x9d\xba\x8a\xaa\xd2eV/\xca\xc9k\xa2\xcb\x17,\xbd\xf3\x03\xfa\xd3\xba\x84\xebH\xbf\x0e?\xed\xd7\xfd\x86\x8c\x01\x12\x9c{\x9b&g\x1d\x94O\x87\x10,#\x91\x92\x1d\xa0\x9e\x9a\x97m\xfb\x06H\xddw\x0e2\x8d\xf3	s\x02\xe2T\xcb\x9b\xe0\x99O\x9fM\x05\xfcU\x80\xe0\x98\xce\x17`\x04*u\xa5rEi\x84\xde\xc8/\x8f\xfal\xdb\x7f\x0d\xae\x8f.X\xe6[M]\xfaD\xee\x87\x86\x15\x1b\xec\xdb\xaac\xdff\xe4\x93\xb2R\xc9\x8dZ\x05\x1f\x86\xa5\xb9\xb8\x82\x9f\xf1\x0d\xa3H\xc2\x1d\"x/\xb5\xbc6\xe6j\xba\x92\x89V\x9f\x01\xd5Y\xc9C\x9f\x848F\xc6N\x0f\x15\xab\xea\xec\x8b\xf4K\x19\x8c\x82\n\xa0\x1d\xa5\x0ep\xbfTP(\x0b\xd3F\xfd\x1b\x90~D\x9c\xa5\x83\x89a\x93\x87t\xc0%\xb4\xfd\x18\x00\x05\xc73\xd9 \xa0E\x9b\xebE\xda#\x06\x92|J\x96\xcfakZ\xf8>\x1e}\x8f\xd5\xc6\xd7l\x13C\x96q\xce;P\xc7\xf1\xd1r\xe6\xf0\x86\xe2\xf8\xe8!)}\xe2\xe0e\x97\x9e\x9d\xc2YV\xd2\xcds3]\xe1d\xacT\x15PFq^\x9d\x0f\xee0~d\x05\nV\x9d\xe7p\x99*\xfbi\xb3\xda\xf6\x8d\x88c.\x97\x87I\xb4\xe0\xc1f+\xff\xb5(W\xd5}\xdb\xbe\x16\xfb\"\x04|\xdb\xce\x0b\xc6y\xd3\xcf\x01\xc7]\xa9\xca%\xce?{UdM\xba\xf4`\x8d\xe8O\xe21\xf7\xaa!\xe0\x9e}k\xe1\xc0)+\x87\x02\x18\x81x\xaey$+0\xd7\xc8\xea\x1a\xc7\x15\xa2C\xfd\x938\xfe\xd6\xfa\x04\xfb	\xef\xbd\xe2\x81p\x1aN\x95?\xc4I\xf1\x84\x99\x1b\xbf\xc2\xc4\xea\x11\x10\x1eX\xdc\x96\xde\x1d\x85\xdd\x05\xba\x1b\xfc\xd1\xedlK\xb66\x03o:dGm\xdb]\x1co\x92r\xbe\x82\xd71\xcdz\x1c'H\x11f\xbb\x91\xe0/-\xc2\x0e\xfa\x93\x17\x91^\xa9\x95|n\xc0\x81\xfb8\x02W\xfa\x1a\x8a\xeb\xf9\nh\xc8\x9f{s\x0c\xff\xc8\xbe|\xd8\x06\xde@\xb9k+\x10Q\xdd\x88\xee\xf7\xb2a\x14\x9aq5_\xe7\x8b\x1b\xa0#\xc9RZ\x85\xfe\x00+\xe7t/\xce\x07\xdfOjR\x0b\xd1y4\xd0\x81p\x89\xc1\xa9\xc7\xb39*\x82\x0d\xfa\x86\x17\x1c\xbe\xb2\x87\xe4\xfa\x8e\x11m\x9f\xf3\x13\x06\xcf\x0f\x0fnw\x94\x8e\xd5Y\xc2\xd4\xbd\x0f\x0f70\xac\xff.\x86H\xe0\xd0\xd35&\xc0\xd8\xe0q\x83\xf5\x93	\x9a\x9f<\x8b\x82i\x8f\xbd\x1e G=\x06A\x82\x83\xd6\x01\xf1\xf4)\x06\xee\x91+\x86K'O\xa6PD1\x1f,\xbdR\x16\xe8\xb5\x0c<\xfdj\x99\x18D\xa4L\x11\x06\xe2\xf0F\xff\xf3\x0f\x1f\x9f\x9d\x9b,\xbc\x03\x9bU2\x8e\xe5\xd5\xb5\xc6{\x04\xd6\xe0\x9bY\xdc{c\xd4\xeb\xd9\xbaZ\x12\x0d\x93\xb7\xa1\xc1]\xbe\x87(\x98\x9d\x9c\xdf\x82&6\xfa\xc3\xbb\x1fz\xc3\x8f\xd7	X\xf1\x08\xd6\xe4\xa1s\x91O\xf8\x84EK\xd95\x8d\xa2\x95\xffz\xf6\xcdO\xef~\x86R\xb7\x16\xb6\xe2\xb7u\xb5yO\xaf\x93\x0eX?\x98\x93\x87\xcd:\x12]\xeaLo\xae\xe7:At(p\x95m\xb6\xcd\x9f\x1f/\xf2kH\xd6IDU\xd6\xba\xae\xab:\xf0L\xbe\x9d\xd1\x9d$\xfa\xbe\xbc\xcb\xd7\xc5j\xf2\xe1\xdd\x0f)\x84j\x98\xb3\x88\xc1\xfa\x11\xbd\x9d\x7f\\\xbc8\x91_\xe3g\x9d},O\xae\xe57\xccw5\xbb\xcbMa\xd8\x9e\xd2\x16\x9b\xfcZ\xb7\xb5n\xb4i\xaf\x8a5\x82\xe4\n\xf9\x1b\x17\x1d\xb7\xc4\xdc\xea\xc7k]\x8a\xd0\xea\xf2O\x93\x94Rw\xc8\xc5\xe60\xccZ\x0b\xc1\xfbeh\x9f\xaf\xdb\xf6G>\x1aK\x91\x15t\xc2\xa6\xa8q\x1a\xcd\xa3\xe9!\x02\x89q6H\x03\x11\x0f|\xc2\"\x92\x86>\xbeg'\xab\xe2*\xa9\xdb\xd6\xbdy\xa4\xd4=\xbe\x8f\xaa\xf5\xd0\x0dK\x0b\xff)C5A\xf5\xefR\xe0\x10&\x88\x1a\x959\xe0\x04R\x8c=RP\xcdf&\x11\xa99\xaf\xe7.?\xe7B\xe9\x12\xb8O\x7f\xfb\xe5{$\xb0\xacJ\x18\xe8\xb5\x98F*\x9a\x8e<\xc1Z\x83y#\x8a\xd2R\xec1\x9a,\x9e\xf2b\x8c\xa2\xb1\x11\xc6.\xfe\xed\x9f;]?\xc61\xf9}\xff\xbc\xce\x8b\x92=\x06G'@<\x15VD\x077+;a\xdd\x8fd\x10\xca\x83q\x92\x08\x94\x91Fz\x1d\xdd\xa4f\xb6/F\xda\xf9\x1e1ot]\xe4\xeb\xf1\x94q<\xb4	+\xa4\xb8\xa0\xed\x07\xbcK\xfa\xb7B\x02\xe36\x0e^\x1c\xe4\xfe\xc5\xf0k6\x16\xb1\xaaJ\xf3\xb6\xea\xe4o\x9d\x85\x1c\xa1\xb6\xba\xc3=\xf0!\xc8\x8bs\x9c\x86\x82\x14\xb8\n\xfcpa\x8cY\x81S4I\x94:\xa9\x17\x1c\xfao\xbc\xa09\xee\xda\x9br\x8e\xbe\xe1\x07\xf0\xde\xb5OYlk\xdb#\x97\xc3\x1e\xea\x00\xd1\xef\\'\xed:\xe5\"\xe4\xad\xc0\xf2\xca\xeb\x05\x7f\x07j\xa2\x12\xa8\x0c\xa8\xac\x94\x87\xe6\xc0'\xcc{j\xec\xf43\x18\xa7W\x8c|md\xf4\xb1\xfeX\x02\x0bR\xa4#E\xcb\xf1\xa2\xa0g\x89\x07\xe2\xf9\xc1\xa8\x93\xff\xf5\xc5\xe9\xc9\xb5\xfc\xce\xa8\x93\xff9{\xf9\xe2D\xfed\xd4I2\xcf\xe2\x85\xf8\xa4\xe6\xff\x1d/^\x9e\xc8\x9f\x89\xe6\xcc^f\"\x9dO>\x9a\xc5\xcbd\xfe\xdf\xf8\xf8\xe2\xa5xqr\xbd\x91\xbf0M\xfa\xf6\xcdE\xfb\xdd\x9b\xaf\xbf\x81\x94\xf8\x0e\xf7>\x9e|<9\x91\xdf\x1b\x84\xb1\xfcJ\xff\xbf0*zy\x12\xb9\xb0\xd2\xe8e$\xe4\xdb\x11\xf7\x98<\xcc\x0d\xfbgx\x98\xba\xd5\xd5\x1f\xf7\xc3#\x01\xc6*\xf27E\xdd\xe7\x8e\"\x9c\x92\x1e\xa2\xa7.\xeb\xd9y\xbd\xdd\xc6\xa9>\xd9S \x9aB[\x03\xf0\x92,)U\xe9\x13\xa8\xb4m\xf42\x92I\xe5b\xd3P\x87\xf0N\xe7F\x88t\xf8\xcc\xe9L:\xeb\xf4'\xe8\xc4H\xf2\xb5\xeb\x87Piv\xca(\xa5~5]\xef\xd7N\xd1T\xbb\x15\xd5 \x031\xb9V\x12\x93c\xe6\x8c\x9f=\xb6$\x0d\xf2\x8b\xc8\xdc\xad\xc6\x83\xf1*)\xfbR3/\x17\xd9.;Jj\x85\x00\x02:\x07\xd3\xa4 \xb0g\xc8H\x9d?})\xe4\x1a\xff\x1d\x9dA\x9dP\xbb\xc3r\x1d\x16\x9e\x9f.D\xdb\x1e5\x14\x8a\x15\xc7k\x9a\xe6\xae\xdf\xff\xeaGA\x93\x119\xff-\x7fx\xaf\x8d)\xca\xebfv\xb5\xce\x0dG\x90z\xe4o\xf2q7\x0c]\x0c\xdf\xday	\x08\x82\xa4@\xcbu\x8a<\xc4\xb5z\xda\x0b\x81\x19\xc1C\xbf\xff\xea\x00\xe7\x12P\x81p\xc7\xd6\xfb\xb7\x86,\xb7\xea\x8d\xfd\x1b\xe8\x12\xf3\xa5)\xeetz*\xd7yc\xdeU\xab\xe2\xaa\xd0+\n\x8759\x85\xc5\x86mM\x9fv\xf5:u\x95\x80\x16\xa5\xd1\xb7o.\"Y4?T\xcb|\x9dR\xb8{~Y\xedL\x9bo\xb7\xf8w\xdc\x98\xaa\xc6\xc9>\x9b\x1e\xd37\x9b\xa2*\xe9\x80\xc7Y\xdf\xde\x17+ \x86\xa5/N,5z\xc3\x11\xf6\xcbj-\xa4\x05\x84A6\xb2m]-u\xd3|\x93\x1bJ\x9c\x967\x8f\xe5\x92\x11}\x910\x15\x93F\xb9\xb1\xd7\x85e\xbbN\x1e\x8e\xef\xef\xef\x8f\xaf\xaazs\xbc\xab\xd7\xf6\\[\x9dO\x967`e\x8c\xfa\xdb\xc5\xdb\xe3\xff\x8a$8O\x04\x06S\xf4\xde\x0bc\xd1\x16,\xbb\xb4\xc5\x81\x15\xd9L\xed\xf6\x0e~F\xf2a\xb3\x1e|i\xb3\x96\x13\xcfa\xc9\xdf\x1aJ\xa7\x19\x14\xc0\x1d.\xf1[~\x973\xac\xc6\xde\xb5\xbdI\x9fP\xe7\xc9\xc7\xcb\x87\xcd\xfa\xe3\xe5\x89\xfd\xe4\xc9\xc7K\xfc=\xb1\xf5\x9d|\xbc\xc4\xdf\x8f\x97'\xb016\xdb\xaal\xf4\xdbB\xafW\xfcr\xe4n~x\xf7C\xc4\xbdp\xb7.\xf4\x83q\xcdr\xf7\xfe\xf2\xfe\xa7\x1fm\x0b\xeetm8~\x91\x9a\x18\xa5\x96m\x94\xd4\xe7	\xda\x10a\xa0\xed%\x1a\x11\xa5x\x1b\xa7f\xc3\xac\xe5\x04\xace\xda\xb1\xb4{\x19,i\xbbd\xdcT= \x8b\xdd\xde/\xaa\xdd3\xfe%&\xfb\x97Ih\xe3\xf4\xf7\nD\xdf\xf4_&\xe9\xdf%\xa0\x0c,\xd3\x0e\xa3\xe6\xcf&\xf9\x1e\n\xd7\xdf\xf2\x87\x8b:/\x9bmU\x1b\xdc\xfc\x95o\x0e>{\xc0\xd9ut\x95}\xdd`\x94\xa1\xdd	\x12K:;Y\xca\x95\xac\xe5\x8d\xbc&\xaav\xd7m\xeb\xdd\x96\x83\x1b\x1e\xa1\xb2CJ\xc4\x07\xd3\xb6wr\xd3]\xc6q\xf2\x18@D<2\xb3$\xb2\xdb\xe4Q\xa4,i\xc9\x87^\"\x00y\xa9ng\xaf\xf3\xf5\x1a\xb9\xde\x9a$\x02,\xd1d\xa37U\xfd\x18	y\xaf\xee\xe0Kkv\x0d\xa0*\xd1T\x8b\x84\xced6\xb2@\xa3z\x15\xc9\x0b\xf5\x04w\x84\xc7\xf7&7\xd8\xf8\xd7\xda\xfc\xc2\xeb\xe2;\x9d\xafzBo \x92\xdc\xb8\x14\x97O\xc8\xc6\xc4\xea\x1b\xa3~66O\xd6V \x18\xe0\x00\x1b`\x1aM\xa2\x85J\x9e}\xd4K\xbb`\xe6_,\xc4\x1eh/\x03k\x0b\xd5\xe2\x88\xaf\xd3\xa9\xe1oj\x98\xe9\x93\xf0\xa5BW\xbe^\xaf\xfb\xbd	\xdc\x18\xfc\xfa\xba\xc9\xb6\xa9w\x08\xf9\x05\x81\x06\x8d9\xe8{ \xf0\xa3\xacRH\xa7\xa9U3l\xdcb\xe4V\xdbj\x99\xe3\xdcb\x94\xe1\xbdDt[]\xac\xf4\xbbbC\xd6\xb81\x8f\xa8\xee3w\xb3\x0d\x97Sl,\xdd\xcbnv\xc7'\x88B\xdco\xc4\x85\x8b\xcd\xd3\xf3\x0b^\x11\x07\x11\x1fZ\xdc\xc3\x07i\x8e\xffI\xcc\xf0\xa6F\xfb\xa9\xfc\x12\xfb\xe5\xe0+J\xb7\xed\xce\x95\\\xc6\xf1rF\x05)i#\xf2\x9dqKIJx\xf0y\x19.\x84\xbc\x9b\xed\xea\xb5J\x12\xdd\xb6\xf4\xb3m\xf9\x0c\x11S w9\xde\xed\x9d\x91\x01\xfd\x9fF''\x11\xde\xc59\xa3\xccl\xa3\xcdM\xb5j[\xc3\x98_w\xfe\xce\x1d\xdd\x91w\xdd\x99\xac\x92\xee\x82\x98\x17\xf1<7\x14E.t\xf4n\xb6\xac\xab\xa6\xf9\xa6\xda\xe4\x05\xfc \xc7\x195\x08\xde5\x1d\xa3\x8a:#\xf9\xc2\xfe\x91\xbdJ\xd4\xdbA\x7f\xa6o\xcd\xec\xa6j\x0cb\xcd\xfa\x0fj\xba\xdf	\xe8\xfdz\x8eN\xf7\x08\xae\xb5\xbd\x8a\xe3\xbbYp\"\xc6\xf1\x01\x9f\xe3\xca\xf1\x0b\xcaI9\xf6\x12c\n\x13\x106E\xbe\x16B~\x02\xb9\x94w\xd2\xc8\x0b!\xbd\xbf\xc8\x05\xd9\xe4\x80,\x98\\;E5>m\x8fe\x11\xc7\xa7\nD\x8f\x18\x88\xe94\x8e\x07\x1a\xc6$\"z\x08\xff\xfen\x1a\xed\x9f~\xf2kyGn\x08\x0c\x1ft\xf4\x0b\x0b&\xb6\xa8\x90<\xcc~\x95|gd\x14\xf5_\xca\x9e\x19\x97S\xa8\xaa\x99\xe8Z.\x81\\\x04:c\xc4\xef\xb2\x0c\x10\xa1\xdc \xda?\xbe\x1d?\x18\x19M#\xe2}\xb9\x85\x96]\xbebA[\xf0\x82\xa4Y\x08\xa6\xab\xd3\xec\xf8\xe3\xc6\x16\xc4\xa7\xae\xa6*y\xcf\x03p%\xb2(\x8e\xd2(\x8b\xc4\x94'\x8e\xdd#\xb9\xbcU@\xc3,\xb4\xbc\xc1\x89u\xa5\xae|\xeb~22zq\x86L\xbc\xe3\x15F\x9fT4\xbd5\xd3\xe9\xb4r\xfb\xf3\xca\xfe,\xae\x1c\x1bH9!B\xbep~\xb5\x88\xe3\x8b\xd9\x90h&\xd1\xf7W\xc7\xee\xa5\xe3\xf7E\xb9\xd4\x91<x\x13\x1aV0\x95\x9f\xab\xe4\xc7\xaa\xd4\xc8\xc9\xb4\xbc\x89\xba\xd2p\x16\xe8&\xb8[*\xac&\x1f\xcc\xaf	/\xc5xsy\xad\x1d\xe3\x8dH\xf6*\x10r\xec\x85\xaf\x89Q\x8cxF\x1d\xdb\x8f\xe60\x0b9\xef?Yd\xcf>\x99B>8R\xaa_U\x86\x13l\xfa\xc2L\xa3\xf3\xc9?\xd5\xe9\xec\xf4,J\xa3H\xa4]5\x90.0S7\xd4\x87F\x8c4\xb3\xe8\x1e\x13\x00.\x11\x0c\x8b\x03\xf3^\x97+\x97Q*\xbcg\xcd\x8d\x8f\xf2B\xde\x89\xb6\xbd\xf1F\xdc\x0b&\xedT\xc9\x0e@\x0bUm\"y	\xdd,\xed(\x1b\xfa\x8a\xd1\xa2\x80\xf4\xbbY\xb3#\xdd)\xee \x0fGrg\xd5\x830r}2\xc9\xaf\x8e\xbc\x10\x17q1\xeb8\x10u\x86(\xe8\xcd\x80j\xe8r\x15\xc9\xf9\x85\xbc[\xf4\x08\xd2\xdd\x8cX\xff8>}uG\xe1D\xd5\x0e\xbc\xd4\xaa\x1fT\xe3\x0f.\xf1\xe4\xfa\x11qap	\xfeMa\x89\xf9\x0d\xc2\x10\x97\xb3\x06\xf2P.\xd7\x81\x92\x94\x0eTsSW\xf7\x13}\xbeN\x8e\xcf\xc0jZG(\xba\x8a~\xac&\x9e\xbf\x0ce\xf8\xf5\xb3\x06_e\xceo\xda6\xa1`\xc1U\x1c\x0f\x02}V\x18.\xb6\x89l\x154\x87\x91\xec\x0d\xd6\xe9+\x9d}\x95B\xbe\xff\xe2\xf4\xf4\x15\xa2\xb6\xf5\xab/OO\xdb\xf6\xcb\xd3\xaf\x10\x04\x80\x84zI\xa3\x9e\xf5\x8af\x94\x19^\xf0\x8d\xdc)\xdd\xadCf\xea\xa2\x97\xd0\x02\xec \xd7\xee\xbc5\xd7'\x84@\x1a\x1c @;\x06\x05\x07\xf2\x01\x0b9\xd8b\x82\x96Q\xdd!\xd56\xe0U\xb0H\xe3\x18\xff[zW\x0b\xf1\xb4\xf3\xa2w!\xce/k\x9d\xdf\xe2\xd8C[\x8arR\x8a\x8a\x9aE\n\xc1\x0e\xfc\xd9&\xd4=\xc2\x13\xe8\x1d;\xa9f^\x80q\xa4\xdc\xb0\x0b\xf1T\xa9\x82k\xcc	\x1e\xa7\x10{\xca\x90\x91\xe3\x0b.\xc2fR\x1d)\xfaF\x1cwM\xa9\x84,\xe7\xd5b\x9f\xdc\xc9\x0bY\"E\xec`\x80\xbd1\xc5yw\xca5\xd8\xeee8\xb8|:\xd0H,\xe7g\x0b\x1a\x8c\x1c\xed\x0f\x9b,\xd6\xf3|\xc8^\xf6\xba\x94/\xce+\xb5t\xd3\xc23Va8\xf5\xcc\x89wV4\x9cWP\x19\x94\xf3\x91\xfb\xc4\x97\x1e\xed\xe2\xb8\x86\xd5\x12M|KJG+\xf5\x047\x10\xfc\xed\xbb@\x96\xfcJ\x06\x9f\xc7g\xedj\xa905^\xe5m)\xdc.\x8ewp\x0d\xb4\xd3\x93\xe4j=\xdf\xd1\x84T\x8b\xb6]\xcf\xa3\x97p\xbe]\x04\xf8\xc5k\xd4\x974\xaa\x08\x92\xba\x8a\xf9\xd9\x02\xf5\xc7qP\x01t.]\x1dt%\xc4\xd3\xd1)\xc2c3\x14+\x16)\x85\xf0\x03\xc5\x98B\xb2QF.\xfd\x8c6\x98\x80`\x81Q\xe1\x1c\x9f\xcf\xe3X\xcf#\xda\xf3M\xb4\x10F\xe5\xc0\xa8\xa0\x8e\x81V\xd8\xeb\x8eBXf\xf8	|\xb6N{\x86\x12I\xe4/\xcda\x1a\xf8\xb1\x9a\xd8U	\xe5\xc7\xe4\xaa\xae6\x93h\x8a\xb10\x15\xc6`\xbf\xdf\xf7\xebaZ\x1aI\x0c=`\xf7\x93;\xd9\xc8\x0b\xa4H.\xb2dpD';u1\xb6\x03\x7f\xc8\x1b\xe3Oe\xb2w'\x07g\xb2\xda	\xf9\xdc\xfb8}\xddk|n+\xe46\xfe\xc2R\x9a\xb6\x8d\xa0\xfd\x04\xa9\xb0\x9cZFYF\x98\xb2D)\x13${\xd7l\\;\xd2\x049\xdbh\xbc\x08M\n=\x94\x85\xc2\xf2h\xf8\xc4\x00K\x95\xab\xb5D(8\xf0P\xd7*rCJ^\xda\x1a\xc91p\xce\xa0\x96]\xa3\xb4\xffI\xd8L\xb0\x0b\xae!X\xc8\"{\xe8%\x18{\x94\xf3J\xae\xe5\xc5B\xa4\x0fa\x86\xb1G\x9c4k\x99/\xbaJ!f%\xf7$V\x13\xe1\xeb\x9fQEfO)\x9e\xa6\x94\xae\xde\xd86\xe2\xd0\x92EV\xa5\xa8\xee\x120\x8e\xfe\xe3\x17\x12\x89\xeb\x90\xf3cp\xdc\xbd\xe6\xe3\xd4\x1fy\xc7\xc7\x8e\xb1n\xdb\x81\xe1\xde\xb1\xd5\x15y\xae9\xa1\xf8\x82\x84_hf\x9e	\xe7\x9a\xdc\xba\xb0\x12Y\xca\x88\x149\x82\xdeyO\xfa\xa8\xde[\x07\xef\xf0\x18\x98\x0e\x14\xb6\xe7N\x06?H	\xcc*\x13:\x92iY\x88\xa7\xdby\xb1P\xcff\xad\x80\x81\x8a\x8e\x93\xbamK\x04\xe2\x07\xf0\xfbV\x9d\xd2%\xeb\"=\x92\x96\x90\xf4\xd2B:\x9a\x94\xd6\xbcG$\xef\x99\xb4D$\xda\xd0\xe0DNC\xb6\xcd\x0e\xfdY=\xd3cLe\xefcV\xc3\xe9?\xe8\x86@\x12\xd7\x1dh#\xcf\xbc\xbe\xf2\xac\xafY\x83\xc2g\xc2ou\xe3,\x9e\xf6{\xd9Q\xda`\x02`\x86\xef\xb0\xb0iB\x08\x01\xb4g\xd3\xba\xaf\xf3\xed\xd7\xeb\xf5\xf3\xa9l\xd9\x9f\x00\x8b\xcd\xdax\xe0\xff\xe4}~q\xb8C\xa9u\xcb)\x83\x0f\xf0\xca\xc5L\xff39\x15\x01X\x1f\xbf\x16\xb8\n!\x14&\x04\x1dL\xb8\x08\xf0\xf1zF#\x0e\x1f!7\x00>\xb24vF\xe3\xac\xcd\x04\x7f(\xb4\x1a\xb9\xeb\xf4\x0dz\xef\xe2\x98\xd9\xc5\x9c\xd5\"\x18\x88\xef\xcb2\x1c\xc1n\xd1o`t\x1a\xf7\x92v\xd6+\xff~\xd2s\x89\x16\x9f\xf7\xb5\xf7\x9e\xf58/\x1d7\x95 \x94\xdb\n\x7f\x99\x99\xf1\x0c%\x142\xc9-/\xc9;\x1dO\xba\xd6z\xe3\xc5&@C\xfa\xfd6\x7f\xbd^'e\xd6\x0b\x05\x11)\"\x04\xf6rW\xf6\xbf0\x88\xb9\xb3\x13\x08w\xcc\xb22ItY\xad\x1e\xa3CH`\xee\xa0\x13(\x89\x86\xb1\x99\xd0\xc1\xd5\x8b\xbd\xcf\xc5,9\xecs\xdb\xe8\xdd\xaaj\\\xb2\xa5\xc3&\x1c\x0d\n\x12\xdc\x14\xc3'\x8e?\x1a\xab\xe4(\xd1!\xcc\x1b\xd8>{\xf9\x1d\xa5\x97\xfd\x0cp\x02\xd9\x86C\xed\xf1\xec\xe1&\xc8S \x9ep\xca\xf3\x92+\xf5\xfd\xe4\xf5\xec\xc3\xbb\x1f\xbe3f\xcb\xa2Vw\xf4\xef\xad2\xf8oF=\x9dR.\x81\xb3/\xbe\xf82\xfd\xe2\xf4\xab\xbd\xfc`:m\xb0\xffH\"\xce\x1fg\xcb\xaan\xd4\xd1\xd1\x07\x13\xc7\xd1}an^\xd7z\xa5KS\xe4\xeb\x06\x8e\xa9\x1f\x8c|\xa4\xd6\xa9\x0f\x86\x8aqc\xbd\x90\xd1\xc96\xec\xe0P\xc9\x1c\x1c\xa5\xad\xb9mQ\xf1Q\x11\xaa\x8e\x98\xb1}jz(\xc7\xa1IJ\x15\xdc<8+\x10\xd0eR\xd0\x89.\x0b\xe8\x05da\x85.\\5\x9a\xdc)e1\xdb\xe6Ms_\xd5+!\xe9m\xcbrv\xb9.\xc3\x9b5\x8cT\xc1\x8dy\xb9\xe8\x12s\x16^\x90@\x9a\x94\xa1\x96t\xec^\xd2\xbd\"d\xaf\xabm\xab\xe7\xd1\x87c\x9e)\xbd:\xc6\xa2\x8d\x10\xce>z_E\xfd\xa9\x85\xbb\xa8\xa8\x0f\xc5j\xebk \xce+5\xb2\xa3\xfc-\xf1\x04\x1e\xb5R\xb9\xaagU\xb9\xaer\xa4\x88\xaaJ\xe2\\\xe8\x17	\xd4\xf4\x8b\xc5O\xfaM\x820\xb8\x17\xbd\xbc\xc9\xcbk\x0b2,Y\xde&\x06\xaaf\x11V\xa4\xcc\x06\xd1]\x17\x9a\xe1U~5\xf3@\x99IN%\x97\x14\xa9I\xdc}\xe9~\x80I\xc2\x83\xbf\x99\xb9\xbb\x85\x14\x16c\xc5$\x9d]\xd0V$\xb5\x97\"X\x8dFOD\xa7\xcc\n\x1a\xe2K\xea\x07\x93=]\x16e^?\xa6]\x05\xfb\xf4	/\x07wPp/\xeb\xd9\xa8\xfe>Ad\xe0^\xfaQ\xad\x12!\xf3\xc1\xd8\xba\x11\xad\x12\xd7s\xe6Y\x8eT7\xf6Y7\x0by::\xf6\xc1d\x82\xc7\xad\x03\xc9\x1b\xa2z\x90\xb7\xa3?\xedyBt\xb7\xc2\xf7i\xb2\xbc\x9a\x18\xbb.)zJ+k:\xb6\x99\x92\xfa\xba\x86\xca\xe9\x1a\xf6\x07*x\xfaL\x958<\x91\x9e\xb5\xabk\x0c9\xa8\x07\x1b\x02\xa7\xbe?\x9d\x90\x86\xbd\xd8\x1aem\xd9=k\x15\xeb\x98\xd2'[$\x8d\x06\xd6J9\xe9\x194\x9f\xb9\xaf\x97\x9b\xd1\xfb\x0f\xc7\xdd\x93\x9e\xdd\x93\xbfv\xf2\xf12\xc9R\xd4\xda\xa2\xa0\xb0\xb7\xc9\xd8\xf9\x07\xf8\xa8\xeep\xebsMB\xea\xd1\xc1\xf2\xfc[X\x83\xd7nh\xafL\xe5_\x18.y8\xa8 \x8f\x8a8\xc3n0;\"}\xf8\x0d\x1f\xe0U\x80\xca\x96a}\x94a\x87\xba\x8c\x00\xd3\xe63\xf4\xbaV\xb7I\xf4\xca\x96\xfd\x13\xd2\xba \x96\xb3\xf72\xec~\x84\xd5\xb1M\x9e\xd8\xd6\x9d\xba\x02\xaf\xed\xb5l\xeaeZ\x82\xb0\xef\xc5\xac*\x93\x08\xa4j\xc2\x02V\xe8G&\x9ej\xe7\xe0\x08,\x12\x18\xa7\x90\xc9\xcc\xb8-\xe6\xe3z\xb2\xafN\xbf\xa2\x03\xd0^b@\xde\x90\x9a\x92\xb9\x1eb\xee\x12\xa0\xae\x88\x91u]\xc4q\x91t\xde\x93\x7f7\xf2[\x03\x97\xb6\x7f\xc03G\x89\x8fY\x92\xa9\xb8}!\xda\x8f\x99\xf5\x9c\x0c\xd6-\xc4\x98m\x1a-\xd9(j\xcd\xdc[g#M\x0f\x98\xb6o\x8d\xf5\xa0&\xa1\x8a21W\xd3\xe8\x93\xd5\x83;>\x13<\x0e{\x9c\xe8\xd1\xf5\x83\x8f\x92%|\x1b\xc9Q\xb7d\xabgS\x94\x94L\xcf\xa8d\x1c'\xff`\xad\xbe\xc6\xd0\x8b,\xda\xd5\xebh$%\x03\xeb\xb9\xa1\xca\xe8\xf6\xee\x7fj\xb9\xe8\xbe\xc9\xf6\x85\x08\x7f#\xd2A\xc1\x0eAm\xb3\x13\xe9\xe4\x1b\xe2\xdfy(j\xd7|7\xa2j\x93\x0c\xee\x88lp#\x11\xe9\xe0\x8e\xcc3 e\"\xf8z\xe1\xcd\x13\xff\xb0\xe6\x89i-\xd2\xc18\xd1\xf8\x04V\x107^\xcep\xc1%\xc9_\x11\xbe-\xa1r\x8c\xf7\x1d\xcdN\x14\xc8\x9d\x9eDPf9Z\xebI=\x8d&\xf7y3)+3\xc1\x02\x82\xbb\x9cD\xb6\xef}\xa0\xf2\xc2h(\x1a\xa6H\x16\xea5\xb2\xea\xe2\xbf\xb0\xe6J\xf9\xf0\xcc\xbd,\x9d\xf15(\xe0iK\x91\xdd&\xaf\x05\xef)\xc49$\xb5H\xa9\xbaB\"\xb18u\xbe7t\xca\x0c\x86\x12\x0bx\xd7\xdc@U\x8a\xac\xa6\x88,\xc6\xf6A\xb3a\xe9q)\x07\xf7\xc2K\xea\xe4(n\xad\x98\xdf]Pv\x0bj\xabJ\x92\xbf\xc3\x11\xad\x80\xe2\x017\x88T\x8f\x14L\xb0\xea \"\x88\x00\x87?z\x053\xd9\x9f^\x9d\xd8?\xe1E$\x81\xf8\xfcw\x13\x88	\x8e\xf3\x96\xec\"BU\x0c\xf3v<\xe3\xb0\xa5\xb3\xf9\"M|\xa4\xb1\xb7\x98A\xebo\xf5\x05\xa0\xcf\x08\xbc\x19\xebe\x86\xe0\xba\xe4\x0f\xf7Sp\x15,\x94&\xd1e\xde R\x84}\xa7\xbcS7\xbb?\x8d\xd08!R\xa3\xde`*\x8e\xca8\x9e/dR\xa8o\xac\x83\x84\x16\"\x9b\x9b\xc1\x17\x80#(\x16iR\xa8{\x9bd\xd8\xc8\x8af\xb6\xe2A\x8b\xe3\xdb\xa4rk&\xc1\x10nt}\xad\x13\xf8\xfc\x06C,\x84u\xfe#\xda\xc3\xde\xaf\xa0\xeb\x07\xc3\xec\x0b\xc9\x9c\xa4q\xb2\x06xs\xe8\xc4{\xa7\x1e\x9f\xbdjH3\x8346\xac\xba\x06\x04#Dt{u*q\x0d\x995\xe3\x99`W\x99\xd4\x8c\xa4\x92\xc5|\x15*\xfa\xf9\xa7\xf7\x17\x91\x90\xa7\xaf\xf2\xae\x7f\x87\x1a\x17d\xc6\x1c(]\xc8\x11\xc9\xa9C9/|\xd7\xb7\xde.\x94\xf9\x0c\xa5\x93:\xc3i\xb9*\xee\xfe\xe43\xa0%\xc1\n\xc4\x84P|,v!\x0e-\xb7u\xcb8\x0eG\x0dy\xba\x87\xb2q\x19\xa6%\xac\xda6\xd0\xb1\x83\x95\x05\n\x08\x12\x01;\xf1\xd8\xb9.\xceYu\x07\x8b\xb8\xec\xd4xr\xa0\x03\x0c\xb5\x89==#_\xc16\xd6\xd3\xb3\x19\xce$\xd8\xcb\x94\xe4i\x1e\x9a\x80\x83\xdeH\x97\x07\xb8'es\x16\xc5`\xa9\xf4\xf4\x7f\xb5\xde&.s_\xf0M_\x04\xfe\x9b\x9a\x81HxN\xd1_+\x8dS<\xc8O\xf4\xf3\xf9D=\xdeb\xe2\xb3h;\x08\xc1\x08\xd60\xc4\x8d\xca+\xb8\x1cE`\xd5\x8b%\xce\xabu\x07\xbf\x17\xa0\xc1;\xb0|$tTKnC\x82\xdd\xe8\xab\xc6x\xc3\x8c\xe1o\x00\x19/\x122\xe9\xa1\x18\xae\x91\x9a\xb5x\xa04k\x94\x96\x1b\xd1\xff\xd5t\x17\x9e\xbf\x00\xf7\x15Y\x92\xab\xa4VK\xdf\x88D\xc0\xd3d+\x0b\x05\x7f\xfb+\x04\x91\xf4\xf0s+\xe0\xe7\xca\"\xbc\xb5\xc3-\xc0%p\x18=\xabt\xb4,\x037\xcd=v\x9bKS\x8c|m`)\xae\xf0\xd7^\x1d#\xb3\xcbv\x9awE\xd01*\x83\x1f\x84$we\x8eA\x8b\xaf\xcc\xb4\x102B\xc8\xeb5t\x0e&33\xbap\xdf\xbd\x12\xe9\x92\x86\xe7J\xec\x07Z\xc7j0\x976\x12x\x98\x9f\xc0\xf1\x0f\xfe\xe4\xb3\x892\xd2g\x94Z<Q3\xbfV\\\xf4>v%\x915\x8cE=\xcc\x8aPg\xf5s\xb8\xc7\x99\x0b\xc3\x1f\xc31\x94\xa5\xaa\xfb\xba\xce0^Q>\x01\x19\x05\x1e*\xdbi9\xdb\xe6\xd7\xfa\x1f\xb6Q\x12\x03\x98\x02K\xe1\xca\xf0\x93\x0f\xf6	\\\xd2\xf1\xd2\xa9-r\xbawA\xfb\xd2\xad\x8an\xc0h\xbc\xb8#\xacJ\x94&\xec\x9d,T\xbf2\xf0j\xddb\xb4\xeb\xb6\x0e\xf7\x880\xcf\xf7\x95\x0cWO\x8c\x9a\xe7\xf7\xc3\xc1\x00H\x0c\x97}\xfc3)\x07!\x93\xac8`\x8aOC\xa7\xb7\xc5\xceS\x08\x1c\x06S\xd0\xb6\xf6\xf7\xa0,\x92#t\x9b\xd5\xef\xb5\xa0\xcd\xba\x9ft	vl\x88\xea#(\x00IA$\xc0\xb7\x9e\xf6\xd7\x01N\xf9E\xb5\xf5H\xe6\xd0\na&\x0e\n\x01\x81\xac+\xe5l'4\xdcv\x13\x15\xf4\xbf\x1fc\x8bCuQm\x81\x8f.\xec\x84\xf0FB \xff\x95\x19\x16\xc5\x07\xa8,\xb2c\x85\xe3\x19.\x80\xdf\x8d\x1b	&\xcb\xbe\xdd\x0d\xfd\x1f\x18\xd5\xde\x8b\xfc-\xdd\xb6\x85\xf6\xe9\xd3\xb0\x01\x9f:L\xb64\n\xd6r$=\x86[\x1a\x05\xab?\x84\xf04.\xb8\xaaR\xbd\"`q\xcf?s\x10q\n\xce\xd1\x83\x00\xab\x1ca\x85Y\xadt\xfa\xbf\x87k\x00\x82H\xb0G\xc5\x08\xd4s\x9d\xd5\xb0\xfa\xc2\x99\xf1\x1c\x94\xc1u#\xa9\xb2:\xdc\xaci)\xab\xacL\xebpk\x0bN\xc9\xb6\xc7\xc1-\x0f\xa8Y0ls:@\xf8\xd4\xe8\x9d\xc1e\x0f\xf8\xb7\\Xx\xd7m\xf1\xa0\xd7?\xf3\xb2\x0f\x8b\xf7\x91]'\xc6\xa2\x18\x97B:Hy\x03_`-\x82\x83e^.\x08z55{\xd1\xb5\xe8\x89Q\xbe=*1-\xf0\x94\xc1V\x82Y\xcbe\x83&R7\x9e<R+\xf1\xf4\xd14wZ\x99\xb4\x91Q\x94F\xd5\xce\xd0\xed\xe0\xfd\x1a\x99\xd2iv\xab\x85\x1a\xd1#wb\x10\x13a\xb0\x8fAV\xe7\x8e\xa5\x876\x01\x11\x0cd\xafGn\x1f\xfc5\x99\x83}K\x1d(\xaf8\xff#\x0b\x87G\x90\x16\x0f*\xaa\xba3\xda\xf6Bdz\xee\xfb\xb9H\xb5'VC\xaa5\x8f\x96D:\xa9X\x7f\x0d\"S\x8c\x1eR9\xe9q\xd44\x11C\x12?\xab\xf5\x1a\xef\xcb\xbaw\xe5\n8\xb8b[\xa0\x7f\xd5}\\\xb8\xa0\x14\xd0\xd6\xccmtl\xbb\x0e\x89\x11C\x80\xb4\xe8\xb2\x91e\xe6\xd2\xc4HJ\xec\xd9-\x8e\x08Y,&\x14\x15<\xe1`\xe1\x89Kp1\xa9uS\xfcK\xc30Y\xad\xd7\x93\xe5\xbaX\xdeNV\x97k\xfbcS\xed\x1a\xbd\xaa\xeeK\xfbk\xb7\xb5\x7f!\xb9\xda_\xd0\xd4\xf3\xaf\x9d\xb1?4\x927\xdb\x9fk\x9d\xdfiD[\x94\xd7zb\xc36'6\xdcsr\xab\x1f\xa9\xde[\xfd\xb8\xadu\xd3\xe0\xc7n;a?\xf9\x8d.w\x91K'1\x89zyBK^\x7f\xe5p\xfd\xf1\x128=\xc0\xb1\xcd\x1c\xef[\x12[\x84L\x95l\xd4s\xc6t\x97O;\xb0\xac\xde\xa0k\xe9\xe8\x17\xa8\xba\xae\xaf\xd8\x9f]w\x13\x98\x9d\x0e\xab\xbb,\xca\xd53)V=k\xae9\x0e\x1d\xf3'w\xe5\xc1+\x1d\xb7\xcdG\x83\x7fC\xec\xa5\xcb\xee\xf0;\xe0\x10\x01\x92\x03\xbe\xf1\xcc[\xfe\x1d\xa46xf8\xe9\xf3\xd1\xcb\x97\x11\x8f%n\x18$\xe9\xc2=\xe9Ft[W\x0f\x8fj\xd4\xe0d\x95\xa0\x07\xba/\xb0\xab\x80\xa62\x0bXU%%\xc6\xdd$\x01\x9e\xa2j,\x8b\xea\xdb%\xbf\x10\x90\xb0}\xc7}\xeb\xb5\x13\xd08\xda\xbbv!\x06\xc3\x1a`[\x10\xb3\xeb]\xb1R:\xfc\x03=\x11.\xa7SY@\x9e\xb9\xa9\xd6\xab_`\xaa	z$\x9e\x10\xc2I&\x82_\xf3\xc2L\xa7)_\xc1\\\x8e\x9787\x8e\xea\x85A:-\x08|5T\x10Js\xeb\xa33\xd5\xd7\xf4\xee[\xfe\x90\xda\xd0\xa5M\x9f\xa1\x1e\xe4\xedl\x99o\xf4\x1a\x91\x06\xea\xef\xf2\x96t\xae\xea\x9e\xde\xbf\xf7\xb1\xe6\xf4\xca\x8f\xbb\x8d\xae\x8be\xaf\xc9.3\x14\xdeJ\xb4#\xb1\x893'\x81\x12\x87\x0e\xca\n\xbc\xdcQ\xd1\xfc\x98\xff\x98\xe8\x10F\x1d\x06r\x19\xb9\x9a;\xc5\xccJ_\x15\xa5\x8ec\xfbw\x96oV\xeew\x12\xd9\x88\xe0H\xce\x17\xf2p\xcan\x99\xd7\xff+\xc2\xe7\x7f\xfb+J\xca\xbf\xe0\xf7\x0bn\xe3\x04=|]\x95W\xebb\xd9O\xca\xc3\xcf_\xcf^\x008)\x8e\x13\xfc\xfa\x8b!\xe0\x0dW\x97\x7f\xc2\x97\x7fE:\x8a=Vmw\x0f\xaf\xdd\ny\xbb\x17\xe7\xff\xe3\xff\x0c\x00PK\x07\x08Q\x86\xe4[\xbd|\x00\x00QX\x01\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd2\x8bQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00script.jsUT\x05\x00\x01\xbc\xb0\xd3j\xecZ[\x8f\xdb\xb6\x12~\xdf_1PS@\xce\xda\xb2w{\x9a\xa0q\x1d`si\x92\xa2\xb9 \x9b\xa0-\x82 \xa0\xa5\xb1\xc5\x86\"\x05\x92\xf2%\xc1\xfe\xf7\x83\xa1DY\x92o\xbb\x9b\xf3ppN\xfc\xb0k\x91\xd4\xcc\xc7\x99\xe1|\xe4\xd0w\xc2D\xc5E\x86\xd2\xf6\"\x8d,Y\x87\xb3B\xc6\x96+\x19\xf6\xe0\xeb\xc9	\xc0p\x08\xefRn\xc0\xb7\x83)\xa6\x19\xb7\x06l\x8a\x10\xe7\x05\xe4\xa8c\x94\x96\xcd\x11\x8a<a\x16a\xa6t\x06\x85\xe1r\x0e\x17\xbf_\xfcU\nYr\x9b\xaa\xc2\x82\xc6\x84k\x8c-\xf5Z\xe5\xa4\x0cI\x0c\xca$W\\\xda\xe8\x04\xe0N\x18\xfc\x10\xe7\xc5 \x8fm\xd0\x8bJ\x85\x1b`H\xc8\x00\x000\xca5.P\xda'8c\x85\xb0aoL\x88\x01\x16L\x97 &p'\xb4)7\xbdq\xdd^h\x01\x13\xd7\x1b1ku\x1807\xad\xc0\xbf{'b\xff\xb0U\xf8\xd5=\x00\xd8u\x8e\x0f x\xf3\xfa\xf2]\xd0\xaf\xda\n-\x1e\xd0\x1f\xff\x9c0\xcb\x1e\x94\"\x0dj\xce\x04\xff\x82a\xcf\xf7\x9a\"\x8e\xd1\x98\x07\xb5\x05C\x1a\xef\xe7@\x1f&P\xdb\xb2u\\\xbdt\xe5\xfe_\xb9g\xfa{\xcc\x11\x19fJ\xaf\x81	\xa1bV\xf6\xf2/\xb7wH\x86\xd9\x96C2\xcc\x06$\xf4\xbbG\xae\xe5\x91\x84\x9b\xcf`S\xad\x8ay\x9a\x17\xf6\xd6\xaepr\xba\xbe\xa0\xc6A6\xcd\xcdwg\\\xcb\x19\x12\xedR\xe9\xcf \x14Kn\xed	\x89v\xcb\x11\x12\xed \x9b\xf2\xff\xdb4\xe5}\x8eZ+\xddHq\xabTo\xbbp\x95\xeaH\xa3\xc9\x954\xf8\x0eW\xf6\x90;c%\x8d\x85X	\xa5\x1f	\x16\x7f\x86	\x04?\x8c\xdc'\x18\xb7\xfb\xffL\xb9E\xd7?s\x9f\xa0+\xe0-&\xae;\xf9\xf9\xa7{\xdb\xaf_dS\xd4\xd5\xeb\xd3\xd9v\xff3\x8d(]\xff\xbd\x11\xfb\xe5\xec~K|^\xbcD\x8b\xfaO\x9e\xd8\x14&\xf0\xf3h\xbc\xd5\xf7\x1c\xf9<\xb50\x81\xb3\xd1y\xab\xf7\x19\xcba\x02?\x8d\x9c<o9H4[>~\xf3\xbee}\n\x88\x98\xc9\x0530\x01O\xd8\xd1\x1c\xedS\x81\xc4\xdd\x8f\xd6/\x920 \xc6\xcc\x94\xe4V\xe9\xa02-\x9fAX\xbeH\xa3\x1f+i\xc9\xec\xb5c\x9c\\\xbb\x82	l\x0d\n\x83\xf3\xa4^\xf4ni<\x16X\xc3\x88*\x01\xb1]E3.\xc4\xa5]\x0br\xc1\xc6\x1f\xe3\xce\x88\xb7\x18\xdbp\xd4\x87Q\xdf\x8bX\x92\xcd\xea\xa7\xd4Y\xa9\xa5\xf09\x93\x89\xc02\xb4 Cc\xd8\x1c\xbdb\x9a\x18\xc5\xbc\x9aU\x86\x9aL&\x10\x18\xab\xb9\x9c\x07\x9b	\xeeA\xe8\"j\xdc\x1e\xa3$\xf9(8\xffW\xbe\x82\x0b\xcaRA{\x00Y\xeeB\xf0\xb9\x0b\x05\xda\xf0\xa0\xee\x8c 5\x14\xd6\x0eQ{\x9a0\x84\xf3\xceT\xa9\xa9^\x00\x00\x1am\xa1\xa5\x7f\xbej\x98\xe1	\x05X\xc6%B\xac\x94N\xb8d\x16\x0d\xa8\x99KJ3\xae\x8d\x85\xc7o\xdeCF\xc3\xbcy\xc8\xb1\xc62m\xff\x82I\x1d\x01%\x92\x018|\x91@9\xb7)\xdc\xed\xc4\xf0i\xbb{\x00g\xbdr\xcc3\x96\xf7z\x04z\xdc\xd5\xf1wCG5\xb7A'\xfa\xab\x17\xab7gJC\xc8a\x02\xa31p\xf8\x15\x1a\n\xc7\xc0OO\x9b\xfe\xa3\x99P\x80V\xb39\x05\x0ew!\xf4\xd2=\xe6\n_\xad\xc1\x19\xce\x8d\x80\xa9\xd2	\xea\x08\xfe@c V	\x82M\x99t.\xa5]O\xd8\x8bn\x1e+>\x9eW\xfd\x12\xd7\xdf\xfd\xb6\x157\x8f\xcf;a\xed\x80\xfd\xc6\x85\x00.\x9d\x03QZ\xaei\xfffQ\xbb=\xb2kuQ\n\x19\xb3q\xea\x18(\xc5\x92\xba\x04.Pl\x00\xd3* \xe3}\xe0\x1f\xe1\xe1\x04~\x195-\xb7'\xf6\xdfb\xb2\x99\xcd\x15\xa00\xd8\x95s\xff:r\\\xda\xdc\x92tT\xbd\xcb\xa6\x8d\xd7N\xba`K\xc3\xc2)\x9cy\xe3\x96\xdf[\x06\x86\x01\x9co\x9a\xaa\x0c;\x80\xf3\x8e\xa1\xdf0.\xad\xb3\xde\xd9h\xf4#\x0cJ\xfbA\xce\xb4\x85%e\xa9\xa8<\xdb\xcc\xf9\x02\xcb\x03\x0d\xcfr\x8d\xc6\xd0\xfe\xa1Zan]\x99\x1a\xe6p\x08\x84\x92\xbcR\xe40\xd3*s\xefM\x95\xb5*;\x12J\xad\xc4\xf8\x1f\x9a1\x0c\xa0\xf2\\g\xee\x17I\x02\x94\xb3\n&@#\xcd\x8a\x0ei\xb6\x9a\xd9\xaeh\xda\xe9\xaf\x03\xa9\xf2\xec\xde\xadS%\x01{\xc4\x0c\nJk\x13\x082\x9e$\x02\x0f\xe5\xd3\x0f\xfccd\xd5\xa5K\xf0a\x0fN!\xf81\xe8\x03Y\xcd\xdb\xa44\x93K\xb4u\xdct\xec\xd5L\xb9\xd5	\x8bv\x1c\x0dB\xcf0k\x11\xfa\xbd&g\xfb\xce6\xa3w\xc9\xfb%f\xb7 o:]}'\xef\xff-\xf2\xf6\xdc\xd5%\xe0V\x90mq\xeaz\x17\x9d\xb6C\xafM\xa77\xa7\xba\xe3+\xdd\x8fp\x9e_\xf5a\xddo\xa3\xeew\x115\xd1\x94i\x97[*GTy\xb6#v\x7fZl+.S\xff\xba\xfc\xd7\x02@\xd9\xbe\x0b\xa2\xcd\x00\x9e\xd4\xa2\xc2`\x92\xc7v\x9b!w\xa2i\xf0c\x87\x1d\x9b\x82\xee\x1f\x17\xd4\"\xc8J\xd4\x11\xdd-r\xbc:i\xa3<j\x91\xbb\xd0B9$\xd2;l\xa2\xa34a,\xb3\x877\xfb-~h\xae\xc5\x16;\x9c\\\x8f\x1cvQC\xca\xe4\x9c\xcbykL\xbdX\x83\x11\xbc|D<P\x06Ig\xae\xa7p6\xaa\xb7\xd8[;\xf4\xc8*\xcb\x041I%\xa3!\xc0\x19\xf4\x90\xcc\x93ks\xd9\xb6^\xef\xa0\x06\x87y\xe0\x0d\x0e\xdb\xa1{\xd8\x0c\xef-[:\xd2;\xa2\xb6=\xdb\xb3\xd1-\xd4\xa0L\x0e(a\x0b\xc6\x05\x9b\n<`W\x18\x1c\xd6\xbc\x8b\x97Q\xebK\xd4\x0b\xd4O\xd4\xd2\xcd\xf6\xbdtZ\xac\xa2\xf3\xb4\xc4\xd8\xd2\xd7\xcb8]\xa2\x06\xe3FF\xc1\xee\xb2\xa9F\x99\xa06\xc0@\xe2\x12\x0cZ\xda\x0f\xd1	\xaa\xb0\\pS\xc6\xbe\xdb(\x9a\xa8y0/\xcbF\xdd\xa3\xb9/UW\xdc=\x981!\xa6,\xfe\x1c\xf4\x9cw\x9ae\xab\xd6\xc9~\\O\xf0z\x00\xab\x02o\xbd \xeb\xd1%\xac\xee\xa6\xc3\x17l\xf7\xc1\xfa\xfd\xf2\xf5\xab\xa8<.\xf3\xd9\xba|\xb5\x01\xb3\x16W6\x0d\x87\xf0\xde\xa9\x81\x8c\xad\"_ev\x0e\xa8p-\x98(\xd0\x9fi\\\x89\xa9\x05\xc3\xf0/8\xe02/\xa8P\xe6\xeaY_\x83\x8c\xad\x82\x07\xd0\x8e\x9a\xaa\xe0}\x03\xc7Q%\x14^\x0c_\xef\xb5\xcc\x13n>o\xed\xc7\xa6*!\x9a%+\x91\x00o&\xb0\xd4\xe1\x8b'\xf4=\xc2,\xb7\xeb\xb0j\xd9ypMp\xc1c4\xbb\x0f\xb0\xb4\x05\xa0\xe2Ss\xe4\x07\xfe\xd1/ \xea\xd6jYB\xf9\xd5\xea\x87^7\xc0\x87$\x92,\xc3>$\xeeb\xe6S6\xcd\x0d=,5\xb7X?\xb9.\xc1,\xcax\xfd)k\x0c\xd8\xb4}\x8cfJ?eq\xba\xb9AYx[\xd0G\xabe\xc4\xf2\x1ce\x12:\x10\xc9C\x1f\xbb\x0b\x1f\x12\xbe\xd4Z[\xa5\x1a\xaf\xd5\xb27\xee\xec\xa3\xaf\xe76_\x90\xe5\xd2\xa2\x9e\xb1\x18\xf7\xfa\xef\x15V\x8b\xa8\x82\xdcq\x9f+\xc0~\x83\xf7j\x00\xd7q\xe0f\xf0\xcd}\xb8\xaa}f7_\xf5\xea\x93+o\xf9\xf6\xf2\xe1\xbf\xc5ct\xb0-s)$\x94v\xab\x1a\x1c(I)\x00*\xb3\xb7\x9df\xea,\x1dz\xc8\xc7Rd+\xb7wr\xe5\xae\xbec\xb9m\x9f<Jj\xfb\xe4\xed\xca\x02\xed\xb5\xbf'\xd8\x9a\x83v\xe7-S\xa5hs\x1d\x92\x89\xf3\xe2\x8d\x12bc\xbb\x9d\xb7\x91\xcf\x9ev.#\x03\xbaB\xad\x9b(\xb0\xdf\x95#\xff1J\x06[W-5\x95u\xca\xf7\x1b\xe75\xefW\xda\xf5\xe9\x0c\xb3\xdb!\xcc0\xbb)\xc2\x97\x98\x1dC\xb8\x0d\x90\\y;\x84\xf4\xe6M!\x12\xbd\xdc\x1c\xa3D{;\x88\x12\xedM\x11\xbeB{m\x80\xc3!\xbc\xc5\x18\xf9\x02\xfd\xea\x06\xc3\xb2\\\xa0\xd9\x94\xba\xdcU\x16\x18\xab\x91e\xc0\\\xd9l\x0d\xa9\xcb/\x11\xbcK\x11\xa6Z-\x0dj\xd0Xm\xcd\xa8z6\x1cR\xda\xa0\xebj\xca$\xbc\xac\xadUB\xb8\x01\xa1\x8c\x8d\xe07J+\xb4M\xa1\xcd\\\xae\x84\xf0\xc5\xcf\n\x8c\x01%c\xa4_\x1c\x80!\xe1I)\xb8\x92\xe6\xf5&\n\x0dHe\xc1\x14y\xae\xb4\x85r\xc5\x0f.QZxJ\xe8]\xca\xa2\xf3\xe1\x92\xcbD-#\xd7x\xa9\n\x1d\xd7\x97vD3\x15\xbc\x89\xdb(6\xc6\x84\xc1\xb0\xec\xf2l]>E,I\xdc\xa8?\xb8\xb1(Q\xbb+\x99\xa0\x0f\xdb\xbf\\\x80\xca7\xb4\x9bt[\xb1\x9ci\x83!F\xe4\xd3^T\xef\x107\xc4\xbbW\x85[V\x07TP\xd6\xfbF\x15\xe5\xba8\xa0\x83\x16\xc1\xb7*q\x91}@\x07\xed\x03n\xa4BI\xc7\xa60i\xa4\xb5\xf1I\xe7(n\xd0\xbe R_0\x11V\xb9\xb7O\xe7f\x7fnl\xf6W\x99oo\xbfO<{\x07T\xab\xbe\xd1\x7furr\xd5\x1b\x9f\xfc{\x00PK\x07\x08\x96\xf9:M\x9d\x08\x00\x00\x86#\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd2\x8bQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00theme.cssUT\x05\x00\x01\xbc\xb0\xd3j\x9cTO\x8b\xdb>\x10=\xff\xfc)\x06\xc2\x8f\\\xec%\x81.,\x0e=\xf4\xd4\xf6\xda\x7f\x97\xd2\x83lMl\x11I#\xa4\xf1n\xd2\x90\xef^\xech\x8bd\x87\x85\xf4\xa8\xd1\xfcy\xf3\xf4\xf4z6\x1a\xce\x05@#\xdaC\xe7i\xb0\xb2jI\x93\xaf\xe1\xa5W\x8c\xbb\xe2R4$OS\x0e\xe3\x91+\xa1Ugkh\xd12\xfa]\x01\xf0\xa2$\xf75<m\xfe\x1fOF\xf8N\xd9\x8a\xc9\xd5\xb0I\x02\x1a\xf7\\\xc3\xf6\x9a\xb4'\xcb\xd5^\x18\xa5O5\xac\xbfPCL\xeb\x12\x82\xb0\xa1\n\xe8\xd5~\x1c\xdb\xa3\x90\xe8\xa7\xc1NH\xa9l\x17\xbb><\xa2\xd9%\xd1\x86\x98\xc9$\x17\xcb]\x1a-\xda\xc3X\x93\xef\x06\xd0\x90\x97\xe8#\xd4\xeb!\xf6\x9b\x10W^H5\x84\x11\xb8;.S\xbc\xea\xfaeN\xbe\xddW\xec\x08\xe1\xfb\xe7u	\xdfDOF\x94\xf0\x11->\x8b\x12~\xa0\x97\xc2\x8a\xf9\xe2\xab\x96,\xa3e8\xcf\xf8\xdc\xa2I(~];F\xa7\xa9A\xfd\xc6\x1a\xb6O\x8e\xaf\x8d\xdcP\x19\xb2\x8a\xc9W	\x9d\xb3\x06\x91\xb7K\xb12h\xee\xca\x97*\x1cf\x05%\xac,\xf2?w\xc9\xcbS\x06\xc6\xe7\xa8A\x0cL\x89\xaa\xa6\x07XD\x17\x82H\xb9y\xe7x\x81\x1dX\x96\x90\xe1\x00\xeegPX\xe6\xab\x01\xf7\x996\xa3\xc0siF|S\xf4R<\x04lY\x91\x85\xf3\x12\xeb\xacp\xf6\xb8Q\x99\xf1\xabq\xafl\x12\x0d|\xd2XC \xadd\x12\x9eK?\xf6\xc8\xd5z)\x94u\xc3(\xb5\xff2\x9e\xffR7\xabzt\xc7%\x9e\xad;\xbe1\xf7\x860\xa7\x99?\xf9\xe4\xf0}\x18\x1a\xa3\xf8\x17\x9coe\xde\xb2\xa5\x95\x94r\xec\xb1'\xe2h\x0f\xaf06Ww\xb9\xd7-nX\x00\x93{\xf3\xff\x8f\xf7\xb7?\xff\x1d\xde\x93\xf9\xc4\x07\xaf\x84.\xe1\x13\xeagd\xd5.L\xe1\xcf\x00PK\x07\x08Z\x9d\xe7\xc6\xd7\x01\x00\x00\xa9\x05\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00z\x8cQ]\x19\x95\xb9\xd1\x96\x1d\x00\x00\x04o\x01\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00api/openapi.jsonUT\x05\x00\x01\xf8\xb1\xd3jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd2\x8bQ],\xdd\xf4\xda,\x03\x00\x00\xc1	\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdd\x1d\x00\x00index.htmlUT\x05\x00\x01\xbc\xb0\xd3jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfa;\x03OQ\x86\xe4[\xbd|\x00\x00QX\x01\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81J!\x00\x00jquery-3.4.1.min.jsUT\x05\x00\x01i8E]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd2\x8bQ]\x96\xf9:M\x9d\x08\x00\x00\x86#\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Q\x9e\x00\x00script.jsUT\x05\x00\x01\xbc\xb0\xd3jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd2\x8bQ]Z\x9d\xe7\xc6\xd7\x01\x00\x00\xa9\x05\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81.\xa7\x00\x00theme.cssUT\x05\x00\x01\xbc\xb0\xd3jPK\x05\x06\x00\x00\x00\x00\x05\x00\x05\x00R\x01\x00\x00E\xa9\x00\x00\x00\x00"
 	fs.Register(data)
 }
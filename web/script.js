@@ -36,6 +36,45 @@ $(document).ready(function() {
     });
   });
 
+  // This function submits the disk throughput update form using AJAX
+  // without redirecting to the /disk endpoint.
+  $("#disk-mbps").submit(function(e) {
+    e.preventDefault();
+
+    var form = $(this);
+    var url = form.attr("action");
+
+    $.ajax({
+      type: "POST",
+      url: url,
+      data: form.serialize(),
+      success: function(data) {
+        alert(data);
+      }
+    });
+  });
+
+  // This function submits the network load update form using AJAX
+  // without redirecting to the /net endpoint.
+  $("#net-mbit").submit(function(e) {
+    e.preventDefault();
+
+    var form = $(this);
+    var url = form.attr("action");
+
+    $.ajax({
+      type: "POST",
+      url: url,
+      data: form.serialize(),
+      success: function(data) {
+        alert(data);
+      },
+      error: function(xhr) {
+        alert(xhr.responseText);
+      }
+    });
+  });
+
   const colorBlack = "#000000";
   const colorWhite = "#ffffff";
 
@@ -164,45 +203,122 @@ $(document).ready(function() {
 
   const errServerDown = "Unable to connect to Schwer server.";
 
+  // This function renders a new set of CPU utilisation levels.
+  function updateCPU(data) {
+    $("#cpu-monitor-fallback").text(data);
+    drawCPU(data);
+  };
+
+  // This function renders a new set of memory stats.
+  function updateMem(data) {
+    $("#mem-monitor-fallback").text(JSON.stringify(data));
+    drawMem(data);
+    // Update max. allocatable memory value in the form.
+    $("#mem-size-input").attr({"max": data.available});
+  };
+
+  // This function renders a new set of disk I/O stats.
+  function updateDisk(data) {
+    var body = $("#disk-monitor tbody");
+    body.empty();
+    for (i = 0; i < data.devices.length; i++) {
+      var d = data.devices[i];
+      var row = $("<tr>");
+      [d.name, d.read_mbps, d.write_mbps, d.read_latency_ms, d.write_latency_ms].forEach(function(v) {
+        row.append($("<td>").text(v));
+      });
+      body.append(row);
+    }
+  };
+
+  // This function renders a new set of network interface stats.
+  function updateNet(data) {
+    var body = $("#net-monitor tbody");
+    body.empty();
+    for (i = 0; i < data.interfaces.length; i++) {
+      var d = data.interfaces[i];
+      var row = $("<tr>");
+      [d.name, d.rx_mbps, d.tx_mbps, d.rx_errors, d.tx_errors].forEach(function(v) {
+        row.append($("<td>").text(v));
+      });
+      body.append(row);
+    }
+  };
+
+  // This function renders the server down message on all monitors.
+  function serverDown() {
+    $("#cpu-monitor-fallback").text(errServerDown);
+    drawCPU(errServerDown);
+    $("#mem-monitor-fallback").text(errServerDown);
+    drawMem(errServerDown);
+    $("#disk-monitor tbody").empty();
+    $("#net-monitor tbody").empty();
+  };
+
   // This functions updates CPU utilisation levels.
   function cpuPoll() {
-    var fb = $("#cpu-monitor-fallback");
     $.ajax({
       type: "GET",
       url: "/cpu",
       dataType: "json",
-      success: function(data) {
-        fb.text(data);
-        drawCPU(data);
-      },
-      error: function() {
-        fb.text(errServerDown);
-        drawCPU(errServerDown);
-      }
+      success: updateCPU,
+      error: serverDown
     });
   };
 
   function memPoll() {
-    var fb = $("#mem-monitor-fallback");
     $.ajax({
       type: "GET",
       url: "/mem",
       dataType: "json",
-      success: function(data) {
-        fb.text(JSON.stringify(data));
-        drawMem(data);
-        // Update max. allocatable memory value in the form.
-        $("#mem-size-input").attr({"max": data.available});
-      },
-      error: function() {
-        fb.text(errServerDown);
-        drawMem(errServerDown);
-      }
+      success: updateMem,
+      error: serverDown
     })
   };
 
-  // Poll CPU and memory once per second.
-  setInterval(cpuPoll, 1000);
-  setInterval(memPoll, 1000);
+  function diskPoll() {
+    $.ajax({
+      type: "GET",
+      url: "/disk",
+      dataType: "json",
+      success: updateDisk,
+      error: serverDown
+    })
+  };
+
+  function netPoll() {
+    $.ajax({
+      type: "GET",
+      url: "/net",
+      dataType: "json",
+      success: updateNet,
+      error: serverDown
+    })
+  };
+
+  // Receive monitor samples from the event stream as they happen. The browser reconnects
+  // on its own if the stream is lost. Fall back to polling the monitors once per second
+  // if the browser does not support Server-Sent Events.
+  if (window.EventSource) {
+    var stream = new EventSource("/stream");
+    stream.addEventListener("cpu", function(e) {
+      updateCPU(JSON.parse(e.data).data);
+    });
+    stream.addEventListener("mem", function(e) {
+      updateMem(JSON.parse(e.data).data);
+    });
+    stream.addEventListener("disk", function(e) {
+      updateDisk(JSON.parse(e.data).data);
+    });
+    stream.addEventListener("net", function(e) {
+      updateNet(JSON.parse(e.data).data);
+    });
+    stream.onerror = serverDown;
+  } else {
+    setInterval(cpuPoll, 1000);
+    setInterval(memPoll, 1000);
+    setInterval(diskPoll, 1000);
+    setInterval(netPoll, 1000);
+  }
 
 });